@@ -0,0 +1,266 @@
+package main
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/espn"
+)
+
+// cacheCommand builds the cache command, for inspecting and clearing the
+// raw ESPN responses scrapes save
+func cacheCommand() *command {
+	return &command{
+		name:    "cache",
+		summary: "Inspect or clear the cached ESPN responses",
+		subcommands: []*command{
+			cacheListCommand(),
+			cacheShowCommand(),
+			cacheClearCommand(),
+		},
+	}
+}
+
+// cacheRow is a cached response in list output
+type cacheRow struct {
+	URL          string `json:"url"`
+	Endpoint     string `json:"endpoint"`
+	Size         int64  `json:"size"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+	FetchedAt    string `json:"fetched_at"`
+	ExpiresAt    string `json:"expires_at,omitempty"` // Empty if it never expires
+}
+
+func cacheListCommand() *command {
+	var endpoint, format string
+	return &command{
+		name:    "list",
+		usage:   "[flags]",
+		summary: "List cached responses, most recently fetched first",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&endpoint, "endpoint", "", "Only list one endpoint: scoreboard, summary, teams, team, roster or athlete")
+			formatFlag(fs, &format)
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 0, "no arguments"); err != nil {
+				return err
+			}
+			if err := checkFormat(format); err != nil {
+				return err
+			}
+			db, err := env.openDB()
+			if err != nil {
+				return err
+			}
+			responses, err := db.Queries.ListAPIResponses(env.ctx)
+			if err != nil {
+				return fmt.Errorf("error listing cached responses: %w", err)
+			}
+
+			rows := []cacheRow{}
+			var size int64
+			for _, response := range responses {
+				if endpoint != "" && response.Endpoint != endpoint {
+					continue
+				}
+				rows = append(rows, cacheRow{
+					URL:          response.Url,
+					Endpoint:     response.Endpoint,
+					Size:         response.Size,
+					ETag:         response.Etag.String,
+					LastModified: response.LastModified.String,
+					FetchedAt:    response.FetchedAt,
+					ExpiresAt:    response.ExpiresAt.String,
+				})
+				size += response.Size
+			}
+			if format == formatJSON {
+				return writeJSON(env.out, rows)
+			}
+			if len(rows) == 0 {
+				fmt.Fprintln(env.out, "No responses are cached")
+				return nil
+			}
+
+			now := time.Now()
+			w := tabwriter.NewWriter(env.out, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "Endpoint\tSize\tFetched\tExpires\tURL")
+			for _, row := range rows {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", row.Endpoint, formatBytes(row.Size), row.FetchedAt, expiry(row.ExpiresAt, now), row.URL)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			fmt.Fprintf(env.out, "\n%d responses, %s\n", len(rows), formatBytes(size))
+			return nil
+		},
+	}
+}
+
+func cacheShowCommand() *command {
+	var pretty bool
+	return &command{
+		name:    "show",
+		usage:   "[flags] <url|path>",
+		summary: "Print a cached response body, e.g. 'cache show summary?event=401671744'",
+		flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&pretty, "pretty", false, "Indent the JSON")
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 1, "a URL, or a path after the ESPN base URL"); err != nil {
+				return err
+			}
+			db, err := env.openDB()
+			if err != nil {
+				return err
+			}
+			response, err := findCachedResponse(env, db, args[0])
+			if err != nil {
+				return err
+			}
+
+			body := response.Body
+			if pretty {
+				var indented bytes.Buffer
+				if err := json.Indent(&indented, body, "", "  "); err != nil {
+					return fmt.Errorf("cached response for %s isn't valid JSON: %w", response.Url, err)
+				}
+				body = indented.Bytes()
+			}
+			if _, err := env.out.Write(body); err != nil {
+				return err
+			}
+			if len(body) > 0 && body[len(body)-1] != '\n' {
+				fmt.Fprintln(env.out)
+			}
+			return nil
+		},
+	}
+}
+
+// findCachedResponse finds the response cached for a URL, or for a path
+// after either ESPN base URL. Failing that, it looks for the one cached URL
+// containing the argument.
+func findCachedResponse(env *env, db *data.DB, arg string) (*sqlc.ApiResponse, error) {
+	candidates := []string{arg}
+	if !strings.Contains(arg, "://") {
+		config := espn.DefaultConfig()
+		path := strings.TrimPrefix(arg, "/")
+		candidates = []string{config.SiteURL + "/" + path, config.CoreURL + "/" + path}
+	}
+	for _, url := range candidates {
+		response, err := db.Queries.GetAPIResponse(env.ctx, url)
+		if err == nil {
+			return response, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("error getting cached response: %w", err)
+		}
+	}
+
+	responses, err := db.Queries.ListAPIResponses(env.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing cached responses: %w", err)
+	}
+	var matches []string
+	for _, response := range responses {
+		if strings.Contains(response.Url, arg) {
+			matches = append(matches, response.Url)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no cached response for %s; see 'gridirongo cache list'", arg)
+	case 1:
+		return db.Queries.GetAPIResponse(env.ctx, matches[0])
+	default:
+		return nil, fmt.Errorf("%d cached responses match %s, e.g. %s and %s", len(matches), arg, matches[0], matches[1])
+	}
+}
+
+func cacheClearCommand() *command {
+	var (
+		endpoint string
+		expired  bool
+	)
+	return &command{
+		name:    "clear",
+		usage:   "[flags]",
+		summary: "Delete cached responses, so the next scrape downloads them again",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&endpoint, "endpoint", "", "Only delete one endpoint's responses")
+			fs.BoolVar(&expired, "expired", false, "Only delete responses that have expired")
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 0, "no arguments"); err != nil {
+				return err
+			}
+			if endpoint != "" && expired {
+				return fmt.Errorf("-endpoint and -expired can't be used together")
+			}
+			db, err := env.openDB()
+			if err != nil {
+				return err
+			}
+
+			var deleted int64
+			switch {
+			case endpoint != "":
+				deleted, err = db.Queries.DeleteAPIResponsesByEndpoint(env.ctx, endpoint)
+			case expired:
+				deleted, err = data.NewResponseCache(db).DeleteExpired(env.ctx, time.Now())
+			default:
+				deleted, err = db.Queries.DeleteAllAPIResponses(env.ctx)
+			}
+			if err != nil {
+				return fmt.Errorf("error clearing the cache: %w", err)
+			}
+			fmt.Fprintf(env.out, "Deleted %d cached responses\n", deleted)
+			return nil
+		},
+	}
+}
+
+// expiry describes when a cached response expires
+func expiry(expiresAt string, now time.Time) string {
+	if expiresAt == "" {
+		return "never"
+	}
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return expiresAt
+	}
+	if !t.After(now) {
+		return "expired"
+	}
+	switch left := t.Sub(now); {
+	case left >= 48*time.Hour:
+		return fmt.Sprintf("in %d days", int(left.Hours()/24))
+	case left >= time.Hour:
+		return fmt.Sprintf("in %dh", int(left.Hours()))
+	default:
+		return fmt.Sprintf("in %dm", int(left.Minutes())+1)
+	}
+}
+
+// formatBytes formats a size in bytes, KB or MB
+func formatBytes(n int64) string {
+	switch {
+	case n < 1<<10:
+		return fmt.Sprintf("%d B", n)
+	case n < 1<<20:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	}
+}
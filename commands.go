@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+)
+
+// errUsage means a command was called wrongly and its usage has been printed
+var errUsage = errors.New("usage")
+
+// command is a gridirongo subcommand. A command either has subcommands or
+// runs itself with the positional arguments left after its flags.
+type command struct {
+	name        string
+	usage       string // Arguments after the command name, e.g. "[flags] <name>"
+	summary     string
+	flags       func(fs *flag.FlagSet) // Defines the command's own flags
+	seasons     bool                   // Accepts the shared -seasons flag
+	run         func(env *env, args []string) error
+	subcommands []*command
+}
+
+// env holds the options shared by every command
+type env struct {
+	ctx     context.Context
+	dbPath  string
+	seasons string
+	out     io.Writer
+	db      *data.DB
+}
+
+// openDB opens the database, once, for commands that need it
+func (e *env) openDB() (*data.DB, error) {
+	if e.db != nil {
+		return e.db, nil
+	}
+	if e.dbPath == "" {
+		e.dbPath = defaultDBPath
+	}
+	db, err := data.NewDB(&data.DBConfig{Path: e.dbPath})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize database: %w", err)
+	}
+	e.db = db
+	return db, nil
+}
+
+// close closes the database if a command opened it
+func (e *env) close() {
+	if e.db != nil {
+		e.db.Close()
+	}
+}
+
+// find returns the subcommand with a name, or nil
+func (c *command) find(name string) *command {
+	for _, sub := range c.subcommands {
+		if sub.name == name {
+			return sub
+		}
+	}
+	return nil
+}
+
+// flagSet builds the command's flags, including the shared -db flag and,
+// for commands that take it, -seasons
+func (c *command) flagSet(env *env, path string) *flag.FlagSet {
+	fs := flag.NewFlagSet(path, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	addSharedFlags(fs, env, c.seasons)
+	if c.flags != nil {
+		c.flags(fs)
+	}
+	fs.Usage = func() {
+		c.printUsage(fs.Output(), path, fs)
+	}
+	return fs
+}
+
+// addSharedFlags defines the -db and optionally -seasons flags on fs. They
+// can be given before the command or after it.
+func addSharedFlags(fs *flag.FlagSet, env *env, seasons bool) {
+	fs.StringVar(&env.dbPath, "db", env.dbPath, "Path to SQLite database")
+	if seasons {
+		fs.StringVar(&env.seasons, "seasons", env.seasons, "Comma-separated list of seasons")
+	}
+}
+
+// execute runs the command, or the subcommand named by the first argument
+func (c *command) execute(env *env, path string, args []string) error {
+	if len(c.subcommands) > 0 {
+		if len(args) == 0 {
+			c.printUsage(os.Stderr, path, nil)
+			return errUsage
+		}
+		if args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+			c.printUsage(env.out, path, nil)
+			return nil
+		}
+		sub := c.find(args[0])
+		if sub == nil {
+			return fmt.Errorf("unknown command %q; run '%s -h' for a list of commands", path+" "+args[0], path)
+		}
+		return sub.execute(env, path+" "+sub.name, args[1:])
+	}
+
+	fs := c.flagSet(env, path)
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage // The flag package has already printed the error and usage
+	}
+	return c.run(env, positional)
+}
+
+// parseInterspersed parses flags given before, between or after the
+// positional arguments and returns the positional arguments. Everything
+// after "--" is positional.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		if len(rest) < len(args) && args[len(args)-len(rest)-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// help prints the usage of the command named by args
+func (c *command) help(env *env, path string, args []string) error {
+	for _, name := range args {
+		sub := c.find(name)
+		if sub == nil {
+			return fmt.Errorf("unknown command %q", path+" "+name)
+		}
+		c, path = sub, path+" "+name
+	}
+
+	var fs *flag.FlagSet
+	if len(c.subcommands) == 0 {
+		fs = c.flagSet(env, path)
+	}
+	c.printUsage(env.out, path, fs)
+	return nil
+}
+
+// printUsage prints the command's usage line, summary and either its
+// subcommands or its flags
+func (c *command) printUsage(w io.Writer, path string, fs *flag.FlagSet) {
+	if len(c.subcommands) > 0 {
+		fmt.Fprintf(w, "Usage: %s <command> [arguments]\n\n%s\n\nCommands:\n", path, c.summary)
+		width := 0
+		for _, sub := range c.subcommands {
+			width = max(width, len(sub.name))
+		}
+		for _, sub := range c.subcommands {
+			fmt.Fprintf(w, "  %-*s  %s\n", width, sub.name, sub.summary)
+		}
+		fmt.Fprintf(w, "\nRun '%s <command> -h' for details on a command.\n", path)
+		return
+	}
+
+	fmt.Fprintf(w, "Usage: %s %s\n\n%s\n", path, c.usage, c.summary)
+	if fs != nil {
+		fmt.Fprintf(w, "\nFlags:\n")
+		fs.SetOutput(w)
+		fs.PrintDefaults()
+	}
+}
+
+// requireArgs checks a command got exactly n positional arguments
+func requireArgs(args []string, n int, what string) error {
+	if len(args) != n {
+		return fmt.Errorf("expected %s, got %d arguments", what, len(args))
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// depthEntry is a depth chart slot with its player and team names filled in
+type depthEntry struct {
+	league.DepthChartEntry
+	Week   int    `json:"week"`
+	Team   string `json:"team"`
+	Player string `json:"player"`
+}
+
+// depthCommand builds the depth command, which prints a team's depth chart
+// or the slots a player holds
+func depthCommand() *command {
+	var (
+		season int64
+		week   int64
+		format string
+	)
+	return &command{
+		name:    "depth",
+		usage:   "[flags] <team|player ID>",
+		summary: "Print an NFL team's depth chart, or a player's places on it",
+		flags: func(fs *flag.FlagSet) {
+			fs.Int64Var(&season, "season", 0, "Season of the chart (default: the latest scraped season)")
+			fs.Int64Var(&week, "week", 0, "Week of the chart (default: the latest scraped week)")
+			formatFlag(fs, &format)
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 1, "a team abbreviation or player ID"); err != nil {
+				return err
+			}
+			if err := checkFormat(format); err != nil {
+				return err
+			}
+			db, err := env.openDB()
+			if err != nil {
+				return err
+			}
+
+			if season == 0 {
+				seasons, err := db.GetSeasons(env.ctx)
+				if err != nil {
+					return fmt.Errorf("error getting seasons: %w", err)
+				}
+				if len(seasons) == 0 {
+					return fmt.Errorf("no games have been scraped yet; run 'gridirongo scrape all' first")
+				}
+				season = seasons[0]
+			}
+			if week == 0 {
+				week = league.LastNFLWeek
+			}
+
+			teams, err := db.Queries.GetAllNFLTeams(env.ctx)
+			if err != nil {
+				return fmt.Errorf("error getting NFL teams: %w", err)
+			}
+			names := make(map[string]string, len(teams)) // Team ID to abbreviation
+			var team *sqlc.NflTeam
+			for _, t := range teams {
+				names[t.TeamID] = t.Abbreviation
+				if strings.EqualFold(t.Abbreviation, args[0]) || t.TeamID == args[0] {
+					team = t
+				}
+			}
+
+			var rows []*sqlc.NflDepthChart
+			if team != nil {
+				rows, err = db.Queries.GetTeamDepthChart(env.ctx, sqlc.GetTeamDepthChartParams{TeamID: team.TeamID, Season: season, Week: week})
+			} else {
+				if _, err := db.Queries.GetNFLPlayer(env.ctx, args[0]); errors.Is(err, sql.ErrNoRows) {
+					return fmt.Errorf("no NFL team or player %q", args[0])
+				} else if err != nil {
+					return fmt.Errorf("error getting player %s: %w", args[0], err)
+				}
+				rows, err = db.Queries.GetPlayerDepth(env.ctx, sqlc.GetPlayerDepthParams{Season: season, Week: week, PlayerID: args[0]})
+			}
+			if err != nil {
+				return fmt.Errorf("error getting depth chart: %w", err)
+			}
+			if len(rows) == 0 {
+				return fmt.Errorf("no %d depth chart for %s; run 'gridirongo scrape depth' first", season, args[0])
+			}
+
+			entries := make([]depthEntry, len(rows))
+			for i, row := range rows {
+				entries[i] = depthEntry{DepthChartEntry: league.DepthChartEntryFromRow(row), Week: int(row.Week), Team: names[row.TeamID]}
+				if player, err := db.Queries.GetNFLPlayer(env.ctx, row.PlayerID); err == nil {
+					entries[i].Player = player.FullName
+				}
+			}
+			if format == formatJSON {
+				return writeJSON(env.out, entries)
+			}
+
+			w := tabwriter.NewWriter(env.out, 0, 0, 2, ' ', 0)
+			if team != nil {
+				fmt.Fprintf(env.out, "%s depth chart, %d week %d\n\n", team.DisplayName, season, entries[0].Week)
+			} else {
+				fmt.Fprintf(env.out, "%s depth chart places, %d\n\n", entries[0].Player, season)
+			}
+			fmt.Fprintln(w, "Team\tSlot\tPos\tDepth\tPlayer\tWeek")
+			for _, entry := range entries {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%d\n", entry.Team, entry.Slot, entry.Position, entry.Depth, entry.Player, entry.Week)
+			}
+			return w.Flush()
+		},
+	}
+}
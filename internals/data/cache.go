@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/espn"
+)
+
+// ResponseCache keeps raw ESPN responses in the api_responses table
+type ResponseCache struct {
+	db *DB
+}
+
+var _ espn.Cache = (*ResponseCache)(nil)
+
+// NewResponseCache creates a response cache in db
+func NewResponseCache(db *DB) *ResponseCache {
+	return &ResponseCache{db: db}
+}
+
+// Get returns the cached response for url, or nil if there isn't one
+func (c *ResponseCache) Get(ctx context.Context, url string) (*espn.CachedResponse, error) {
+	row, err := c.db.Queries.GetAPIResponse(ctx, url)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting cached response: %w", err)
+	}
+
+	response := &espn.CachedResponse{
+		URL:          row.Url,
+		Endpoint:     row.Endpoint,
+		Body:         row.Body,
+		ETag:         row.Etag.String,
+		LastModified: row.LastModified.String,
+	}
+	if response.FetchedAt, err = time.Parse(time.RFC3339, row.FetchedAt); err != nil {
+		return nil, fmt.Errorf("invalid fetch time for cached %s: %w", url, err)
+	}
+	if row.ExpiresAt.Valid {
+		if response.ExpiresAt, err = time.Parse(time.RFC3339, row.ExpiresAt.String); err != nil {
+			return nil, fmt.Errorf("invalid expiry for cached %s: %w", url, err)
+		}
+	}
+	return response, nil
+}
+
+// Put saves a response, replacing any earlier copy
+func (c *ResponseCache) Put(ctx context.Context, response *espn.CachedResponse) error {
+	err := c.db.Queries.UpsertAPIResponse(ctx, sqlc.UpsertAPIResponseParams{
+		Url:          response.URL,
+		Endpoint:     response.Endpoint,
+		Body:         response.Body,
+		Etag:         sql.NullString{String: response.ETag, Valid: response.ETag != ""},
+		LastModified: sql.NullString{String: response.LastModified, Valid: response.LastModified != ""},
+		FetchedAt:    cacheTime(response.FetchedAt),
+		ExpiresAt:    sql.NullString{String: cacheTime(response.ExpiresAt), Valid: !response.ExpiresAt.IsZero()},
+	})
+	if err != nil {
+		return fmt.Errorf("error caching response: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired removes responses that expired before now, returning how
+// many there were
+func (c *ResponseCache) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	deleted, err := c.db.Queries.DeleteExpiredAPIResponses(ctx, sql.NullString{String: cacheTime(now), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("error deleting expired responses: %w", err)
+	}
+	return deleted, nil
+}
+
+// cacheTime formats a time the way the api_responses table stores it, so
+// stored times sort and compare as strings
+func cacheTime(t time.Time) string {
+	return t.UTC().Truncate(time.Second).Format(time.RFC3339)
+}
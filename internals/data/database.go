@@ -216,6 +216,12 @@ func NewDB(config *DBConfig) (*DB, error) {
 
 	log.Println("Successfully verified nfl_players table exists")
 
+	// Apply migrations added after the base schema
+	if err := applyMigrations(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
 	// Create sqlc queries
 	queries := sqlc.New(db)
 
@@ -226,6 +232,64 @@ func NewDB(config *DBConfig) (*DB, error) {
 	}, nil
 }
 
+// applyMigrations runs every embedded migration after schema.sql that hasn't
+// been applied yet, in file name order, recording each in schema_migrations
+func applyMigrations(db *sql.DB) error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version TEXT PRIMARY KEY,
+		applied_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`)
+	if err != nil {
+		return fmt.Errorf("error creating schema_migrations table: %w", err)
+	}
+
+	entries, err := migrationFS.ReadDir("migrations")
+	if err != nil {
+		return fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	// ReadDir returns entries sorted by file name
+	for _, entry := range entries {
+		version := entry.Name()
+		if version == "schema.sql" || filepath.Ext(version) != ".sql" {
+			continue
+		}
+
+		var applied int
+		err := db.QueryRow("SELECT count(*) FROM schema_migrations WHERE version = ?", version).Scan(&applied)
+		if err != nil {
+			return fmt.Errorf("error checking migration %s: %w", version, err)
+		}
+		if applied > 0 {
+			continue
+		}
+
+		migrationSQL, err := migrationFS.ReadFile("migrations/" + version)
+		if err != nil {
+			return fmt.Errorf("failed to read migration %s: %w", version, err)
+		}
+
+		log.Printf("Applying migration %s...", version)
+		tx, err := db.Begin()
+		if err != nil {
+			return fmt.Errorf("error starting migration %s: %w", version, err)
+		}
+		if _, err := tx.Exec(string(migrationSQL)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to apply migration %s: %w", version, err)
+		}
+		if _, err := tx.Exec("INSERT INTO schema_migrations (version) VALUES (?)", version); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("error recording migration %s: %w", version, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("error committing migration %s: %w", version, err)
+		}
+	}
+
+	return nil
+}
+
 // Close closes the database connection
 func (db *DB) Close() error {
 	return db.DB.Close()
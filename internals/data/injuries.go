@@ -0,0 +1,9 @@
+package data
+
+// Injury designations stored in nfl_injuries, from NFL injury reports
+const (
+	InjuryOut          = "Out"
+	InjuryDoubtful     = "Doubtful"
+	InjuryQuestionable = "Questionable"
+	InjuryIR           = "IR" // Injured reserve
+)
@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+)
+
+// Statuses of a job in the scrape_jobs ledger
+const (
+	JobPending = "pending"
+	JobDone    = "done"
+	JobFailed  = "failed"
+)
+
+// Job is a unit of a scraper's work, like a week of games or a team's
+// roster in a season
+type Job struct {
+	Scraper string
+	Season  int
+	Unit    string
+}
+
+// ResumeJobs adds any jobs the ledger doesn't have yet as pending, and
+// returns the ones that aren't done, in order
+func (db *DB) ResumeJobs(ctx context.Context, jobs []Job) ([]Job, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	queries := db.Queries.WithTx(tx)
+	for _, job := range jobs {
+		err := queries.QueueScrapeJob(ctx, sqlc.QueueScrapeJobParams{Scraper: job.Scraper, Season: int64(job.Season), Unit: job.Unit})
+		if err != nil {
+			return nil, fmt.Errorf("error queueing %s %s: %w", job.Scraper, job.Unit, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	statuses, err := db.JobStatuses(ctx, jobs)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Job
+	for _, job := range jobs {
+		if statuses[job] == nil || statuses[job].Status != JobDone {
+			pending = append(pending, job)
+		}
+	}
+	return pending, nil
+}
+
+// JobStatuses returns the ledger entries for the seasons of jobs, keyed by
+// job. Jobs that have never been queued are missing.
+func (db *DB) JobStatuses(ctx context.Context, jobs []Job) (map[Job]*sqlc.ScrapeJob, error) {
+	statuses := make(map[Job]*sqlc.ScrapeJob)
+	seen := make(map[int]bool)
+	for _, job := range jobs {
+		if seen[job.Season] {
+			continue
+		}
+		seen[job.Season] = true
+
+		rows, err := db.Queries.ListScrapeJobsBySeason(ctx, int64(job.Season))
+		if err != nil {
+			return nil, fmt.Errorf("error getting %d scrape jobs: %w", job.Season, err)
+		}
+		for _, row := range rows {
+			statuses[Job{Scraper: row.Scraper, Season: int(row.Season), Unit: row.Unit}] = row
+		}
+	}
+	return statuses, nil
+}
+
+// FinishJob records a job as done, or failed if jobErr isn't nil. A job
+// interrupted by ctx being cancelled is left pending.
+func (db *DB) FinishJob(ctx context.Context, job Job, jobErr error) {
+	switch {
+	case jobErr == nil:
+		db.recordJob(ctx, job, JobDone, nil)
+	case errors.Is(jobErr, context.Canceled) || ctx.Err() != nil:
+		return
+	default:
+		db.recordJob(ctx, job, JobFailed, jobErr)
+	}
+}
+
+// DeferJob records a job that succeeded but has more to come, like a week
+// with games still to be played, so it stays pending for the next run
+func (db *DB) DeferJob(ctx context.Context, job Job) {
+	db.recordJob(ctx, job, JobPending, nil)
+}
+
+// recordJob saves a job's status, logging rather than failing the job if
+// the ledger can't be written
+func (db *DB) recordJob(ctx context.Context, job Job, status string, jobErr error) {
+	params := sqlc.RecordScrapeJobParams{
+		Scraper: job.Scraper,
+		Season:  int64(job.Season),
+		Unit:    job.Unit,
+		Status:  status,
+	}
+	if jobErr != nil {
+		params.Error = sql.NullString{String: jobErr.Error(), Valid: true}
+	}
+	if err := db.Queries.RecordScrapeJob(context.WithoutCancel(ctx), params); err != nil {
+		log.Printf("Warning: error recording %s %s in the job ledger: %v", job.Scraper, job.Unit, err)
+	}
+}
+
+// ResetJobs marks a scraper's jobs in seasons pending, so the next run does
+// them all again. It returns how many were reset.
+func (db *DB) ResetJobs(ctx context.Context, scraper string, seasons []int) (int64, error) {
+	var reset int64
+	for _, season := range seasons {
+		n, err := db.Queries.ResetScrapeJobs(ctx, sqlc.ResetScrapeJobsParams{Scraper: scraper, Season: int64(season)})
+		if err != nil {
+			return reset, fmt.Errorf("error resetting %d %s jobs: %w", season, scraper, err)
+		}
+		reset += n
+	}
+	return reset, nil
+}
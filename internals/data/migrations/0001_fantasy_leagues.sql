@@ -0,0 +1,8 @@
+-- Fantasy leagues saved from the league setup wizard
+CREATE TABLE fantasy_leagues (
+    league_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    rules TEXT NOT NULL,            -- LeagueRules as JSON
+    created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,4 @@
+-- Final or in-progress scores for NFL games, filled in by the games scraper
+ALTER TABLE nfl_games ADD COLUMN away_score INTEGER;
+ALTER TABLE nfl_games ADD COLUMN home_score INTEGER;
+ALTER TABLE nfl_games ADD COLUMN completed BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,11 @@
+-- Seasons played by saved leagues: the drafted teams and the NFL season whose
+-- games they score points in
+CREATE TABLE fantasy_seasons (
+    league_id INTEGER NOT NULL,
+    season INTEGER NOT NULL,        -- NFL season year
+    teams TEXT NOT NULL,            -- Teams and rosters as JSON
+    created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (league_id, season),
+    FOREIGN KEY (league_id) REFERENCES fantasy_leagues(league_id) ON DELETE CASCADE
+);
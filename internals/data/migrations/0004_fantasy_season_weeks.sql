@@ -0,0 +1,10 @@
+-- The last week each fantasy season has played. Seasons advance a week at a
+-- time and weeks after this one aren't scored yet.
+ALTER TABLE fantasy_seasons ADD COLUMN week INTEGER NOT NULL DEFAULT 0;
+
+-- Seasons drafted before weeks were tracked keep every week already final
+UPDATE fantasy_seasons SET week = COALESCE((
+    SELECT MAX(week) FROM nfl_games
+    WHERE nfl_games.season = fantasy_seasons.season
+      AND (completed OR (away_score IS NULL AND home_score IS NULL AND date < date('now')))
+), 0);
@@ -0,0 +1,13 @@
+-- Raw ESPN API responses, cached so re-scrapes only download what changed.
+-- Responses are revalidated with their ETag or Last-Modified once they expire.
+CREATE TABLE api_responses (
+    url TEXT PRIMARY KEY,
+    endpoint TEXT NOT NULL,         -- e.g. scoreboard, summary, athlete
+    body BLOB NOT NULL,
+    etag TEXT,
+    last_modified TEXT,
+    fetched_at TEXT NOT NULL,       -- When the body was last downloaded or revalidated, RFC 3339
+    expires_at TEXT                 -- When to revalidate, RFC 3339, or NULL if never
+);
+
+CREATE INDEX idx_api_responses_endpoint ON api_responses(endpoint);
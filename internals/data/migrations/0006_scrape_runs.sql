@@ -0,0 +1,15 @@
+-- Each scrape or update run, so updates know when they last synced and
+-- which week they got to
+CREATE TABLE scrape_runs (
+    run_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    command TEXT NOT NULL,          -- e.g. "update" or "scrape all"
+    seasons TEXT NOT NULL,          -- Comma-separated seasons
+    week INTEGER,                   -- Week an update synced up to, NULL for whole seasons
+    status TEXT NOT NULL DEFAULT 'running', -- running, succeeded or failed
+    summary TEXT NOT NULL DEFAULT '',
+    error TEXT,
+    started_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TEXT
+);
+
+CREATE INDEX idx_scrape_runs_command ON scrape_runs(command, status);
@@ -0,0 +1,14 @@
+-- The units of work scrapers have done, so an interrupted or failed scrape
+-- picks up where it left off
+CREATE TABLE scrape_jobs (
+    scraper TEXT NOT NULL,          -- games, teams, players, stats, totals, injuries or depth
+    season INTEGER NOT NULL,
+    unit TEXT NOT NULL,             -- e.g. "week 1", "team 23" or "game 401671744"
+    status TEXT NOT NULL DEFAULT 'pending', -- pending, done or failed
+    error TEXT,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (scraper, season, unit)
+);
+
+CREATE INDEX idx_scrape_jobs_season ON scrape_jobs(season, scraper);
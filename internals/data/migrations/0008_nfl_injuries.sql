@@ -0,0 +1,17 @@
+-- Weekly injury reports. A team's report for a week replaces the one
+-- scraped earlier that week, so players who recovered drop off it.
+CREATE TABLE nfl_injuries (
+    player_id TEXT NOT NULL,
+    season INTEGER NOT NULL,
+    week INTEGER NOT NULL,
+    team_id TEXT NOT NULL,
+    status TEXT NOT NULL,           -- Out, Doubtful, Questionable, IR or ESPN's status for others
+    body_part TEXT,                 -- e.g. "Ankle"
+    detail TEXT,                    -- ESPN's short comment
+    injury_date TEXT,               -- YYYY-MM-DD of the report entry
+    PRIMARY KEY (player_id, season, week),
+    FOREIGN KEY (player_id) REFERENCES nfl_players(player_id),
+    FOREIGN KEY (team_id) REFERENCES nfl_teams(team_id)
+);
+
+CREATE INDEX idx_nfl_injuries_week ON nfl_injuries(season, week, team_id);
@@ -0,0 +1,16 @@
+-- Team depth charts by week. A team's chart for a week replaces the one
+-- scraped earlier that week.
+CREATE TABLE nfl_depth_charts (
+    season INTEGER NOT NULL,
+    week INTEGER NOT NULL,
+    team_id TEXT NOT NULL,
+    slot TEXT NOT NULL,             -- ESPN's slot on the chart, e.g. "QB", "LDE" or "PK"
+    position TEXT NOT NULL,         -- Position abbreviation of the slot, e.g. "DE"
+    depth INTEGER NOT NULL,         -- 1 for the starter, 2 for the first backup and so on
+    player_id TEXT NOT NULL,
+    PRIMARY KEY (season, week, team_id, slot, depth),
+    FOREIGN KEY (team_id) REFERENCES nfl_teams(team_id),
+    FOREIGN KEY (player_id) REFERENCES nfl_players(player_id)
+);
+
+CREATE INDEX idx_nfl_depth_charts_player ON nfl_depth_charts(player_id, season, week);
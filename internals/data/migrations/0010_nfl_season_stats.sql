@@ -0,0 +1,14 @@
+-- Players' season totals as ESPN reports them, to check the totals summed
+-- from box scores against. A player's totals replace the ones scraped before.
+CREATE TABLE nfl_season_stats (
+    player_id TEXT NOT NULL,
+    season INTEGER NOT NULL,
+    season_type INTEGER NOT NULL,   -- 2 for the regular season, 3 for the postseason
+    category TEXT NOT NULL,         -- e.g. 'passing', 'rushing', 'general'
+    stat_type TEXT NOT NULL,        -- e.g. 'passingYards', 'gamesPlayed'
+    stat_value REAL NOT NULL,
+    PRIMARY KEY (player_id, season, season_type, category, stat_type),
+    FOREIGN KEY (player_id) REFERENCES nfl_players(player_id)
+);
+
+CREATE INDEX idx_nfl_season_stats_season ON nfl_season_stats(season, season_type);
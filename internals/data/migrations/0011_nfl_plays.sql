@@ -0,0 +1,52 @@
+-- Play-by-play from game summaries. A game's drives and plays replace the
+-- ones scraped before.
+CREATE TABLE nfl_drives (
+    drive_id TEXT PRIMARY KEY,
+    game_id INTEGER NOT NULL,
+    team_id TEXT NOT NULL,          -- Team with the ball
+    sequence INTEGER NOT NULL,      -- Order in the game, from 1
+    period INTEGER NOT NULL,
+    yards_to_endzone INTEGER NOT NULL, -- Where the drive started
+    yards INTEGER NOT NULL,
+    plays INTEGER NOT NULL,
+    result TEXT NOT NULL,           -- e.g. 'Touchdown', 'Punt', 'Interception'
+    scoring BOOLEAN NOT NULL,
+    FOREIGN KEY (game_id) REFERENCES nfl_games(event_id)
+);
+
+CREATE INDEX idx_nfl_drives_game ON nfl_drives(game_id, sequence);
+
+CREATE TABLE nfl_plays (
+    play_id TEXT PRIMARY KEY,
+    game_id INTEGER NOT NULL,
+    drive_id TEXT NOT NULL,
+    sequence INTEGER NOT NULL,      -- Order in the game, from 1
+    team_id TEXT NOT NULL,          -- Team with the ball
+    period INTEGER NOT NULL,
+    clock TEXT NOT NULL,
+    play_type TEXT NOT NULL,        -- 'pass', 'rush', 'sack', 'field_goal', 'extra_point', 'two_point', 'punt', 'kickoff', 'penalty' or 'other'
+    result TEXT NOT NULL,           -- 'complete', 'incomplete' or 'intercepted' for passes, 'good', 'missed' or 'blocked' for kicks and two-point tries, otherwise ''
+    espn_type TEXT NOT NULL,        -- ESPN's play type, e.g. 'Pass Reception'
+    down INTEGER,                   -- NULL for kickoffs and tries
+    distance INTEGER,
+    yards_to_endzone INTEGER NOT NULL, -- Line of scrimmage
+    yards INTEGER NOT NULL,         -- Yards gained, or a kick's distance
+    scoring BOOLEAN NOT NULL,
+    description TEXT NOT NULL,
+    FOREIGN KEY (game_id) REFERENCES nfl_games(event_id),
+    FOREIGN KEY (drive_id) REFERENCES nfl_drives(drive_id)
+);
+
+CREATE INDEX idx_nfl_plays_game ON nfl_plays(game_id, sequence);
+
+-- The players involved in each play and their parts in it
+CREATE TABLE nfl_play_players (
+    play_id TEXT NOT NULL,
+    player_id TEXT NOT NULL,
+    role TEXT NOT NULL,             -- e.g. 'passer', 'rusher', 'receiver', 'kicker'
+    PRIMARY KEY (play_id, player_id, role),
+    FOREIGN KEY (play_id) REFERENCES nfl_plays(play_id),
+    FOREIGN KEY (player_id) REFERENCES nfl_players(player_id)
+);
+
+CREATE INDEX idx_nfl_play_players_player ON nfl_play_players(player_id);
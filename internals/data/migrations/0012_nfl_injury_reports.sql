@@ -0,0 +1,13 @@
+-- The weeks each team's injury report was saved for, so a team whose report
+-- had nobody on it isn't mistaken for one whose scrape failed
+CREATE TABLE nfl_injury_reports (
+    season INTEGER NOT NULL,
+    week INTEGER NOT NULL,
+    team_id TEXT NOT NULL,
+    scraped_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (season, week, team_id),
+    FOREIGN KEY (team_id) REFERENCES nfl_teams(team_id)
+);
+
+INSERT INTO nfl_injury_reports (season, week, team_id)
+SELECT DISTINCT season, week, team_id FROM nfl_injuries;
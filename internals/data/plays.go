@@ -0,0 +1,25 @@
+package data
+
+// Play types stored in nfl_plays, normalized from ESPN's
+const (
+	PlayPass       = "pass" // Includes interceptions, but not sacks
+	PlayRush       = "rush"
+	PlaySack       = "sack"
+	PlayFieldGoal  = "field_goal"
+	PlayExtraPoint = "extra_point"
+	PlayTwoPoint   = "two_point"
+	PlayPunt       = "punt"
+	PlayKickoff    = "kickoff"
+	PlayPenalty    = "penalty"
+	PlayOther      = "other"
+)
+
+// Play results stored in nfl_plays, for passes, kicks and two-point tries
+const (
+	ResultComplete    = "complete"
+	ResultIncomplete  = "incomplete"
+	ResultIntercepted = "intercepted"
+	ResultGood        = "good"
+	ResultMissed      = "missed"
+	ResultBlocked     = "blocked"
+)
@@ -0,0 +1,40 @@
+-- name: GetAPIResponse :one
+SELECT * FROM api_responses
+WHERE url = ?;
+
+-- name: UpsertAPIResponse :exec
+-- Save a response, replacing any earlier copy of the same URL
+INSERT INTO api_responses (
+  url, endpoint, body, etag, last_modified, fetched_at, expires_at
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?
+) ON CONFLICT(url) DO UPDATE SET
+  endpoint = excluded.endpoint,
+  body = excluded.body,
+  etag = excluded.etag,
+  last_modified = excluded.last_modified,
+  fetched_at = excluded.fetched_at,
+  expires_at = excluded.expires_at;
+
+-- name: ListAPIResponses :many
+-- List cached responses without their bodies, most recently fetched first
+SELECT url, endpoint, length(body) AS size, etag, last_modified, fetched_at, expires_at
+FROM api_responses
+ORDER BY fetched_at DESC, url;
+
+-- name: GetAPIResponseSummary :one
+-- Count cached responses and their total size
+SELECT count(*) AS responses, CAST(COALESCE(SUM(length(body)), 0) AS INTEGER) AS bytes
+FROM api_responses;
+
+-- name: DeleteAPIResponsesByEndpoint :execrows
+DELETE FROM api_responses
+WHERE endpoint = ?;
+
+-- name: DeleteExpiredAPIResponses :execrows
+-- Delete responses that expired before the given time
+DELETE FROM api_responses
+WHERE expires_at IS NOT NULL AND expires_at < ?;
+
+-- name: DeleteAllAPIResponses :execrows
+DELETE FROM api_responses;
@@ -0,0 +1,45 @@
+-- name: UpsertDepthChartEntry :exec
+INSERT INTO nfl_depth_charts (
+  season, week, team_id, slot, position, depth, player_id
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT(season, week, team_id, slot, depth) DO UPDATE SET
+  position = excluded.position,
+  player_id = excluded.player_id;
+
+-- name: DeleteTeamDepthChart :exec
+-- Clear a team's chart for a week before saving a new one
+DELETE FROM nfl_depth_charts
+WHERE season = ? AND week = ? AND team_id = ?;
+
+-- name: GetTeamDepthChart :many
+-- Get a team's depth chart in effect for a week: the latest one scraped on or before it
+SELECT d.* FROM nfl_depth_charts d
+JOIN (
+  SELECT team_id, season, MAX(week) AS week FROM nfl_depth_charts
+  WHERE team_id = ? AND season = ? AND week <= ?
+  GROUP BY team_id, season
+) latest ON d.team_id = latest.team_id AND d.season = latest.season AND d.week = latest.week
+ORDER BY d.slot, d.depth;
+
+-- name: GetDepthChartsByWeek :many
+-- Get every team's depth chart in effect for a week
+SELECT d.* FROM nfl_depth_charts d
+JOIN (
+  SELECT team_id, season, MAX(week) AS week FROM nfl_depth_charts
+  WHERE season = ? AND week <= ?
+  GROUP BY team_id, season
+) latest ON d.team_id = latest.team_id AND d.season = latest.season AND d.week = latest.week
+ORDER BY d.team_id, d.slot, d.depth;
+
+-- name: GetPlayerDepth :many
+-- Get a player's slots on the depth charts in effect for a week, deepest last
+SELECT d.* FROM nfl_depth_charts d
+JOIN (
+  SELECT team_id, season, MAX(week) AS week FROM nfl_depth_charts
+  WHERE season = ? AND week <= ?
+  GROUP BY team_id, season
+) latest ON d.team_id = latest.team_id AND d.season = latest.season AND d.week = latest.week
+WHERE d.player_id = ?
+ORDER BY d.depth, d.slot;
@@ -33,6 +33,17 @@ SELECT * FROM nfl_games
 WHERE season = ? AND week = ?
 ORDER BY date ASC;
 
+-- name: GetSeasons :many
+-- Get every season with scheduled games, newest first
+SELECT DISTINCT season FROM nfl_games
+ORDER BY season DESC;
+
+-- name: GetWeeksBySeason :many
+-- Get every week with scheduled games in a season
+SELECT DISTINCT week FROM nfl_games
+WHERE season = ?
+ORDER BY week ASC;
+
 -- name: UpsertGame :exec
 INSERT INTO nfl_games (
   event_id, date, name, short_name, season, week, away_team, home_team
@@ -46,3 +57,24 @@ INSERT INTO nfl_games (
   week = excluded.week,
   away_team = excluded.away_team,
   home_team = excluded.home_team;
+
+-- name: GetLatestWeekByDate :one
+-- Get the week of the latest game scheduled on or before a date
+SELECT season, week FROM nfl_games
+WHERE date <= ?
+ORDER BY date DESC, week DESC
+LIMIT 1;
+
+-- name: GetFirstWeek :one
+-- Get the week of the earliest game in a season
+SELECT season, week FROM nfl_games
+WHERE season = ?
+ORDER BY date ASC, week ASC
+LIMIT 1;
+
+-- name: GetCompletedGamesWithoutStats :many
+-- Get a season's final games that don't have any stats yet
+SELECT * FROM nfl_games
+WHERE season = ? AND completed
+  AND NOT EXISTS (SELECT 1 FROM nfl_stats WHERE nfl_stats.game_id = nfl_games.event_id)
+ORDER BY date ASC, event_id ASC;
@@ -0,0 +1,40 @@
+-- name: UpsertInjury :exec
+INSERT INTO nfl_injuries (
+  player_id, season, week, team_id, status, body_part, detail, injury_date
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT(player_id, season, week) DO UPDATE SET
+  team_id = excluded.team_id,
+  status = excluded.status,
+  body_part = excluded.body_part,
+  detail = excluded.detail,
+  injury_date = excluded.injury_date;
+
+-- name: DeleteTeamInjuries :exec
+-- Clear a team's report for a week before saving a new one
+DELETE FROM nfl_injuries
+WHERE season = ? AND week = ? AND team_id = ?;
+
+-- name: SaveInjuryReport :exec
+-- Record that a team's report was saved for a week, even if nobody was on it
+INSERT INTO nfl_injury_reports (season, week, team_id)
+VALUES (?, ?, ?)
+ON CONFLICT(season, week, team_id) DO UPDATE SET
+  scraped_at = CURRENT_TIMESTAMP;
+
+-- name: GetInjuriesByWeek :many
+-- Get the injury reports in effect for a week: each team's latest one saved on or before it
+SELECT i.* FROM nfl_injuries i
+JOIN (
+  SELECT season, team_id, MAX(week) AS week FROM nfl_injury_reports
+  WHERE season = ? AND week <= ?
+  GROUP BY season, team_id
+) latest ON i.season = latest.season AND i.team_id = latest.team_id AND i.week = latest.week
+ORDER BY i.team_id, i.player_id;
+
+-- name: GetInjuriesByPlayer :many
+-- Get a player's injury designations, newest first
+SELECT * FROM nfl_injuries
+WHERE player_id = ?
+ORDER BY season DESC, week DESC;
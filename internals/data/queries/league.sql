@@ -0,0 +1,42 @@
+-- name: CreateFantasyLeague :one
+INSERT INTO fantasy_leagues (
+  name, rules
+) VALUES (
+  ?, ?
+)
+RETURNING *;
+
+-- name: GetFantasyLeague :one
+SELECT * FROM fantasy_leagues
+WHERE league_id = ?;
+
+-- name: GetAllFantasyLeagues :many
+-- Get every saved league, most recently updated first
+SELECT * FROM fantasy_leagues
+ORDER BY updated_at DESC, league_id DESC;
+
+-- name: UpdateFantasyLeague :exec
+UPDATE fantasy_leagues
+SET name = ?, rules = ?, updated_at = CURRENT_TIMESTAMP
+WHERE league_id = ?;
+
+-- name: DeleteFantasyLeague :exec
+DELETE FROM fantasy_leagues
+WHERE league_id = ?;
+
+-- name: UpsertFantasySeason :exec
+-- Save a league's teams for a season, replacing any earlier draft
+INSERT INTO fantasy_seasons (
+  league_id, season, teams, week
+) VALUES (
+  ?, ?, ?, ?
+) ON CONFLICT(league_id, season) DO UPDATE SET
+  teams = excluded.teams,
+  week = excluded.week,
+  updated_at = CURRENT_TIMESTAMP;
+
+-- name: GetFantasySeasons :many
+-- Get every season a league has played, newest first
+SELECT * FROM fantasy_seasons
+WHERE league_id = ?
+ORDER BY season DESC;
@@ -18,6 +18,11 @@ SELECT * FROM nfl_player_seasons
 WHERE season_year = ?
 ORDER BY player_id;
 
+-- name: GetPlayerSeasonsByPlayer :many
+SELECT * FROM nfl_player_seasons
+WHERE player_id = ?
+ORDER BY season_year DESC;
+
 -- name: GetPlayerSeasonsByTeam :many
 SELECT ps.*
 FROM nfl_player_seasons ps
@@ -0,0 +1,137 @@
+-- name: CreateDrive :exec
+INSERT INTO nfl_drives (
+  drive_id, game_id, team_id, sequence, period, yards_to_endzone, yards, plays, result, scoring
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: CreatePlay :exec
+INSERT INTO nfl_plays (
+  play_id, game_id, drive_id, sequence, team_id, period, clock, play_type, result,
+  espn_type, down, distance, yards_to_endzone, yards, scoring, description
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: AddPlayPlayer :exec
+INSERT INTO nfl_play_players (play_id, player_id, role)
+VALUES (?, ?, ?)
+ON CONFLICT(play_id, player_id, role) DO NOTHING;
+
+-- name: DeleteGamePlayPlayers :exec
+-- Clear the players in a game's plays before saving its plays again
+DELETE FROM nfl_play_players
+WHERE play_id IN (SELECT play_id FROM nfl_plays WHERE game_id = ?);
+
+-- name: DeleteGamePlays :exec
+DELETE FROM nfl_plays
+WHERE game_id = ?;
+
+-- name: DeleteGameDrives :exec
+DELETE FROM nfl_drives
+WHERE game_id = ?;
+
+-- name: GetGameDrives :many
+SELECT * FROM nfl_drives
+WHERE game_id = ?
+ORDER BY sequence;
+
+-- name: GetGamePlays :many
+SELECT * FROM nfl_plays
+WHERE game_id = ?
+ORDER BY sequence;
+
+-- name: GetGamePlayPlayers :many
+-- Get the players involved in each of a game's plays
+SELECT pp.* FROM nfl_play_players pp
+JOIN nfl_plays p ON pp.play_id = p.play_id
+WHERE p.game_id = ?
+ORDER BY p.sequence, pp.role, pp.player_id;
+
+-- name: GetPlayerPlays :many
+-- Get every play a player was involved in during a season, with their part in it
+SELECT
+  p.play_id,
+  p.game_id,
+  g.week,
+  p.team_id,
+  pp.role,
+  p.play_type,
+  p.result,
+  p.down,
+  p.distance,
+  p.yards_to_endzone,
+  p.yards,
+  p.scoring,
+  p.description
+FROM nfl_play_players pp
+JOIN nfl_plays p ON pp.play_id = p.play_id
+JOIN nfl_games g ON p.game_id = g.event_id
+WHERE pp.player_id = ? AND g.season = ?
+ORDER BY g.week, p.sequence, pp.role;
+
+-- name: GetTeamPassAttemptsBySeason :many
+-- Get how many passes each team threw in each game of a season
+SELECT
+  p.game_id,
+  p.team_id,
+  COUNT(*) AS attempts
+FROM nfl_plays p
+JOIN nfl_games g ON p.game_id = g.event_id
+WHERE g.season = ? AND p.play_type = 'pass'
+GROUP BY p.game_id, p.team_id
+ORDER BY p.game_id, p.team_id;
+
+-- name: GetGameScoringPlays :many
+-- Get the scoring plays and two-point tries in a game, with each player's part in them (for play bonuses)
+SELECT
+  pp.player_id,
+  p.game_id,
+  g.week,
+  pp.role,
+  p.play_type,
+  p.result,
+  p.yards_to_endzone,
+  p.yards,
+  p.scoring
+FROM nfl_play_players pp
+JOIN nfl_plays p ON pp.play_id = p.play_id
+JOIN nfl_games g ON p.game_id = g.event_id
+WHERE p.game_id = ? AND (p.scoring = 1 OR p.play_type = 'two_point')
+ORDER BY pp.player_id, p.sequence;
+
+-- name: GetWeekScoringPlays :many
+-- Get the scoring plays and two-point tries in a week of a season, with each player's part in them
+SELECT
+  pp.player_id,
+  p.game_id,
+  g.week,
+  pp.role,
+  p.play_type,
+  p.result,
+  p.yards_to_endzone,
+  p.yards,
+  p.scoring
+FROM nfl_play_players pp
+JOIN nfl_plays p ON pp.play_id = p.play_id
+JOIN nfl_games g ON p.game_id = g.event_id
+WHERE g.season = ? AND g.week = ? AND (p.scoring = 1 OR p.play_type = 'two_point')
+ORDER BY pp.player_id, p.game_id, p.sequence;
+
+-- name: GetSeasonScoringPlays :many
+-- Get the scoring plays and two-point tries in a season, with each player's part in them
+SELECT
+  pp.player_id,
+  p.game_id,
+  g.week,
+  pp.role,
+  p.play_type,
+  p.result,
+  p.yards_to_endzone,
+  p.yards,
+  p.scoring
+FROM nfl_play_players pp
+JOIN nfl_plays p ON pp.play_id = p.play_id
+JOIN nfl_games g ON p.game_id = g.event_id
+WHERE g.season = ? AND (p.scoring = 1 OR p.play_type = 'two_point')
+ORDER BY pp.player_id, p.game_id, p.sequence;
@@ -0,0 +1,31 @@
+-- name: QueueScrapeJob :exec
+-- Add a pending job, unless it's already in the ledger
+INSERT INTO scrape_jobs (
+  scraper, season, unit
+) VALUES (
+  ?, ?, ?
+)
+ON CONFLICT(scraper, season, unit) DO NOTHING;
+
+-- name: RecordScrapeJob :exec
+INSERT INTO scrape_jobs (
+  scraper, season, unit, status, error, attempts
+) VALUES (
+  ?, ?, ?, ?, ?, 1
+)
+ON CONFLICT(scraper, season, unit) DO UPDATE SET
+  status = excluded.status,
+  error = excluded.error,
+  attempts = scrape_jobs.attempts + 1,
+  updated_at = CURRENT_TIMESTAMP;
+
+-- name: ListScrapeJobsBySeason :many
+SELECT * FROM scrape_jobs
+WHERE season = ?
+ORDER BY scraper, unit;
+
+-- name: ResetScrapeJobs :execrows
+-- Mark a scraper's jobs in a season pending, so they're all done again
+UPDATE scrape_jobs
+SET status = 'pending', error = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE scraper = ? AND season = ?;
@@ -0,0 +1,25 @@
+-- name: CreateScrapeRun :one
+INSERT INTO scrape_runs (
+  command, seasons, week
+) VALUES (
+  ?, ?, ?
+)
+RETURNING *;
+
+-- name: FinishScrapeRun :exec
+UPDATE scrape_runs
+SET status = ?, summary = ?, error = ?, finished_at = CURRENT_TIMESTAMP
+WHERE run_id = ?;
+
+-- name: GetLastSuccessfulScrapeRun :one
+-- Get the latest run of a command that succeeded
+SELECT * FROM scrape_runs
+WHERE command = ? AND status = 'succeeded'
+ORDER BY run_id DESC
+LIMIT 1;
+
+-- name: ListScrapeRuns :many
+-- List the latest runs, newest first
+SELECT * FROM scrape_runs
+ORDER BY run_id DESC
+LIMIT ?;
@@ -0,0 +1,49 @@
+-- name: UpsertSeasonStat :exec
+INSERT INTO nfl_season_stats (
+  player_id, season, season_type, category, stat_type, stat_value
+) VALUES (
+  ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT(player_id, season, season_type, category, stat_type) DO UPDATE SET
+  stat_value = excluded.stat_value;
+
+-- name: DeletePlayerSeasonStats :exec
+-- Clear a player's season totals before saving new ones
+DELETE FROM nfl_season_stats
+WHERE player_id = ? AND season = ? AND season_type = ?;
+
+-- name: GetPlayerSeasonStats :many
+-- Get a player's season totals as ESPN reports them
+SELECT * FROM nfl_season_stats
+WHERE player_id = ? AND season = ? AND season_type = ?
+ORDER BY category, stat_type;
+
+-- name: GetSeasonStatsBySeason :many
+-- Get every player's season totals as ESPN reports them
+SELECT * FROM nfl_season_stats
+WHERE season = ? AND season_type = ?
+ORDER BY player_id, category, stat_type;
+
+-- name: GetGameStatTotalsBySeason :many
+-- Get every player's box score totals for each category and stat type in a season
+SELECT
+  s.player_id,
+  s.category,
+  s.stat_type,
+  SUM(s.stat_value) AS total_value
+FROM nfl_stats s
+JOIN nfl_games g ON s.game_id = g.event_id
+WHERE g.season = ?
+GROUP BY s.player_id, s.category, s.stat_type
+ORDER BY s.player_id, s.category, s.stat_type;
+
+-- name: GetPlayerGameCountsBySeason :many
+-- Get how many games each player has box score stats for in a season
+SELECT
+  s.player_id,
+  COUNT(DISTINCT s.game_id) AS games
+FROM nfl_stats s
+JOIN nfl_games g ON s.game_id = g.event_id
+WHERE g.season = ?
+GROUP BY s.player_id
+ORDER BY s.player_id;
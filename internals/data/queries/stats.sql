@@ -256,6 +256,23 @@ WHERE
 ORDER BY 
   s.category, s.stat_type;
 
+-- name: GetPlayerGameStatsBySeason :many
+-- Get every stat a player recorded in each game of a season
+SELECT 
+  s.game_id,
+  g.week,
+  s.category,
+  s.stat_type,
+  s.stat_value
+FROM 
+  nfl_stats s
+JOIN 
+  nfl_games g ON s.game_id = g.event_id
+WHERE 
+  s.player_id = ? AND g.season = ?
+ORDER BY 
+  g.week, s.game_id, s.category, s.stat_type;
+
 -- name: GetPlayerTotalStatsBySeason :many
 -- Get a player's total stats for each stat type in a season
 SELECT 
@@ -271,3 +288,43 @@ GROUP BY
   s.stat_type
 ORDER BY 
   s.stat_type;
+
+-- name: GetSeasonGameStats :many
+-- Get every player's stats for each game of a season (for fantasy point rankings)
+SELECT 
+  s.player_id,
+  p.position,
+  s.game_id,
+  s.category,
+  s.stat_type,
+  s.stat_value
+FROM 
+  nfl_stats s
+JOIN 
+  nfl_games g ON s.game_id = g.event_id
+JOIN 
+  nfl_players p ON s.player_id = p.player_id
+WHERE 
+  g.season = ?
+ORDER BY 
+  s.player_id, s.game_id;
+
+-- name: GetWeekGameStats :many
+-- Get every player's stats for each game of a week (for fantasy scoreboards)
+SELECT 
+  s.player_id,
+  p.position,
+  s.game_id,
+  s.category,
+  s.stat_type,
+  s.stat_value
+FROM 
+  nfl_stats s
+JOIN 
+  nfl_games g ON s.game_id = g.event_id
+JOIN 
+  nfl_players p ON s.player_id = p.player_id
+WHERE 
+  g.season = ? AND g.week = ?
+ORDER BY 
+  s.player_id, s.game_id;
@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+)
+
+// Statuses of a scrape run
+const (
+	RunRunning   = "running"
+	RunSucceeded = "succeeded"
+	RunFailed    = "failed"
+)
+
+// ScrapeRun is a scrape or update being recorded in the scrape_runs table
+type ScrapeRun struct {
+	ID int64
+	db *DB
+}
+
+// StartScrapeRun records the start of a run of command over seasons. Pass
+// a week of 0 for runs over whole seasons.
+func (db *DB) StartScrapeRun(ctx context.Context, command string, seasons []int, week int) (*ScrapeRun, error) {
+	years := make([]string, len(seasons))
+	for i, season := range seasons {
+		years[i] = strconv.Itoa(season)
+	}
+	row, err := db.Queries.CreateScrapeRun(ctx, sqlc.CreateScrapeRunParams{
+		Command: command,
+		Seasons: strings.Join(years, ","),
+		Week:    sql.NullInt64{Int64: int64(week), Valid: week > 0},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error recording scrape run: %w", err)
+	}
+	return &ScrapeRun{ID: row.RunID, db: db}, nil
+}
+
+// Finish records how a run ended, failed if runErr isn't nil. It's
+// recorded even when ctx was cancelled, so interrupted runs show as failed.
+func (r *ScrapeRun) Finish(ctx context.Context, summary string, runErr error) {
+	params := sqlc.FinishScrapeRunParams{Status: RunSucceeded, Summary: summary, RunID: r.ID}
+	if runErr != nil {
+		params.Status = RunFailed
+		params.Error = sql.NullString{String: runErr.Error(), Valid: true}
+	}
+	if err := r.db.Queries.FinishScrapeRun(context.WithoutCancel(ctx), params); err != nil {
+		log.Printf("Warning: error recording the end of scrape run %d: %v", r.ID, err)
+	}
+}
+
+// LastSuccessfulRun returns the latest run of command that succeeded, or nil
+// if there hasn't been one
+func (db *DB) LastSuccessfulRun(ctx context.Context, command string) (*sqlc.ScrapeRun, error) {
+	run, err := db.Queries.GetLastSuccessfulScrapeRun(ctx, command)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting the last %s run: %w", command, err)
+	}
+	return run, nil
+}
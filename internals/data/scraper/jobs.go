@@ -0,0 +1,171 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+)
+
+// Scrapers that record their units of work in the job ledger
+const (
+	GamesScraper    = "games"    // A job per season and week
+	TeamsScraper    = "teams"    // A job per team, in the season being played
+	PlayersScraper  = "players"  // A job per team roster in a season
+	StatsScraper    = "stats"    // A job per game
+	TotalsScraper   = "totals"   // A job per player season
+	InjuriesScraper = "injuries" // A job per season and week
+	DepthScraper    = "depth"    // A job per season and week
+)
+
+// weekJob is the job of scraping a week's scoreboard
+func weekJob(season, week int) data.Job {
+	return data.Job{Scraper: GamesScraper, Season: season, Unit: fmt.Sprintf("week %d", week)}
+}
+
+// teamJob is the job of scraping a team's details. Teams aren't scraped by
+// season, so their jobs are kept under the season being played.
+func teamJob(season int, teamID string) data.Job {
+	return data.Job{Scraper: TeamsScraper, Season: season, Unit: "team " + teamID}
+}
+
+// rosterJob is the job of scraping a team's roster in a season
+func rosterJob(season int, teamID string) data.Job {
+	return data.Job{Scraper: PlayersScraper, Season: season, Unit: "team " + teamID}
+}
+
+// gameJob is the job of scraping a game's stats
+func gameJob(season int, eventID int64) data.Job {
+	return data.Job{Scraper: StatsScraper, Season: season, Unit: fmt.Sprintf("game %d", eventID)}
+}
+
+// totalsJob is the job of scraping a player's season totals
+func totalsJob(season int, playerID string) data.Job {
+	return data.Job{Scraper: TotalsScraper, Season: season, Unit: "player " + playerID}
+}
+
+// injuryJob is the job of scraping a week's injury reports
+func injuryJob(season, week int) data.Job {
+	return data.Job{Scraper: InjuriesScraper, Season: season, Unit: fmt.Sprintf("week %d", week)}
+}
+
+// depthJob is the job of scraping a week's depth charts
+func depthJob(season, week int) data.Job {
+	return data.Job{Scraper: DepthScraper, Season: season, Unit: fmt.Sprintf("week %d", week)}
+}
+
+// resume filters units of work down to the ones the job ledger doesn't
+// have as done, queueing any it hasn't seen
+func resume[T any](ctx context.Context, db *data.DB, scraper string, units []T, job func(T) data.Job) ([]T, error) {
+	jobs := make([]data.Job, len(units))
+	for i, unit := range units {
+		jobs[i] = job(unit)
+	}
+	pending, err := db.ResumeJobs(ctx, jobs)
+	if err != nil {
+		return nil, err
+	}
+
+	todo := make(map[data.Job]bool, len(pending))
+	for _, job := range pending {
+		todo[job] = true
+	}
+	remaining := make([]T, 0, len(pending))
+	for _, unit := range units {
+		if todo[job(unit)] {
+			remaining = append(remaining, unit)
+		}
+	}
+	if done := len(units) - len(remaining); done > 0 {
+		log.Printf("Resuming %s scrape: %d of %d units already done", scraper, done, len(units))
+	}
+	return remaining, nil
+}
+
+// seasonInProgress reports whether a season is still being played, so its
+// rosters may still change
+func seasonInProgress(season int) bool {
+	return season >= SeasonAt(time.Now())
+}
+
+// weekFinal reports whether every game in a week is final, so reports for
+// it won't change. A week without games is final once its season is over.
+func weekFinal(ctx context.Context, db *data.DB, season, week int) (bool, error) {
+	games, err := db.Queries.GetAllGamesBySeasonAndWeek(ctx, sqlc.GetAllGamesBySeasonAndWeekParams{Season: int64(season), Week: int64(week)})
+	if err != nil {
+		return false, fmt.Errorf("error getting %d week %d games: %w", season, week, err)
+	}
+	if len(games) == 0 {
+		return !seasonInProgress(season), nil
+	}
+	return !slices.ContainsFunc(games, func(g *sqlc.NflGame) bool { return !g.Completed }), nil
+}
+
+// finishReportJob records a week's injury report or depth chart job. Reports
+// change until the week's games are played, so the job stays pending until
+// the week is final.
+func finishReportJob(ctx context.Context, db *data.DB, job data.Job, season, week int, jobErr error) {
+	if jobErr == nil {
+		final, err := weekFinal(ctx, db, season, week)
+		if err != nil {
+			log.Printf("Warning: %v", err)
+		}
+		if !final {
+			db.DeferJob(ctx, job)
+			return
+		}
+	}
+	db.FinishJob(ctx, job, jobErr)
+}
+
+// Jobs returns every job the scrapers have for seasons, given the teams,
+// games and players scraped so far. Injury reports only have a job for the
+// week whose games they're for in a season being played, and depth charts
+// for that week in every season.
+func Jobs(ctx context.Context, db *data.DB, seasons []int) ([]data.Job, error) {
+	teams, err := db.Queries.GetAllNFLTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting teams: %w", err)
+	}
+
+	updater := NewUpdater(db, nil)
+	var jobs []data.Job
+	for _, season := range seasons {
+		for week := 1; week <= 18; week++ {
+			jobs = append(jobs, weekJob(season, week))
+		}
+		for _, team := range teams {
+			if season == SeasonAt(updater.Now()) {
+				jobs = append(jobs, teamJob(season, team.TeamID))
+			}
+			jobs = append(jobs, rosterJob(season, team.TeamID))
+		}
+		games, err := db.Queries.GetGamesBySeason(ctx, int64(season))
+		if err != nil {
+			return nil, fmt.Errorf("error getting %d games: %w", season, err)
+		}
+		for _, game := range games {
+			jobs = append(jobs, gameJob(season, game.EventID))
+		}
+		players, err := db.Queries.GetPlayerSeasonsByYear(ctx, int64(season))
+		if err != nil {
+			return nil, fmt.Errorf("error getting %d players: %w", season, err)
+		}
+		for _, player := range players {
+			jobs = append(jobs, totalsJob(season, player.PlayerID))
+		}
+
+		// Seasons without games have no report week
+		if week, err := updater.ReportWeek(ctx, season); err == nil {
+			if seasonInProgress(season) {
+				jobs = append(jobs, injuryJob(season, week.Week))
+			}
+			jobs = append(jobs, depthJob(season, week.Week))
+		}
+	}
+	return jobs, nil
+}
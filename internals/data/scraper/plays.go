@@ -0,0 +1,152 @@
+package scraper
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/espn"
+)
+
+// savePlays replaces a game's drives and plays with the ones in its summary.
+// Players involved who aren't in the database are left off their plays. It
+// returns how many plays were saved.
+func savePlays(ctx context.Context, queries *sqlc.Queries, summary *espn.GameSummary, gameID int64) (int, error) {
+	if err := queries.DeleteGamePlayPlayers(ctx, gameID); err != nil {
+		return 0, fmt.Errorf("error clearing play players: %w", err)
+	}
+	if err := queries.DeleteGamePlays(ctx, gameID); err != nil {
+		return 0, fmt.Errorf("error clearing plays: %w", err)
+	}
+	if err := queries.DeleteGameDrives(ctx, gameID); err != nil {
+		return 0, fmt.Errorf("error clearing drives: %w", err)
+	}
+
+	known := make(map[string]bool) // Player IDs checked so far
+	sequence, skipped := 0, 0
+	for i, drive := range summary.Drives.Previous {
+		err := queries.CreateDrive(ctx, sqlc.CreateDriveParams{
+			DriveID:        drive.ID,
+			GameID:         gameID,
+			TeamID:         drive.Team.ID,
+			Sequence:       int64(i + 1),
+			Period:         int64(drive.Start.Period.Number),
+			YardsToEndzone: int64(100 - drive.Start.YardLine),
+			Yards:          int64(drive.Yards),
+			Plays:          int64(drive.OffensivePlays),
+			Result:         drive.Result,
+			Scoring:        drive.IsScore,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("error saving drive %s: %w", drive.ID, err)
+		}
+
+		for _, play := range drive.Plays {
+			playType, result := playType(play.Type.Text, play.Text)
+			if playType == "" {
+				continue
+			}
+			sequence++
+			err := queries.CreatePlay(ctx, sqlc.CreatePlayParams{
+				PlayID:         play.ID,
+				GameID:         gameID,
+				DriveID:        drive.ID,
+				Sequence:       int64(sequence),
+				TeamID:         cmp.Or(play.Start.Team.ID, drive.Team.ID),
+				Period:         int64(play.Period.Number),
+				Clock:          play.Clock.DisplayValue,
+				PlayType:       playType,
+				Result:         result,
+				EspnType:       play.Type.Text,
+				Down:           sql.NullInt64{Int64: int64(play.Start.Down), Valid: play.Start.Down > 0},
+				Distance:       sql.NullInt64{Int64: int64(play.Start.Distance), Valid: play.Start.Down > 0},
+				YardsToEndzone: int64(play.Start.YardsToEndzone),
+				Yards:          int64(play.StatYardage),
+				Scoring:        play.ScoringPlay,
+				Description:    play.Text,
+			})
+			if err != nil {
+				return 0, fmt.Errorf("error saving play %s: %w", play.ID, err)
+			}
+
+			for _, participant := range play.Participants {
+				playerID := participant.Athlete.ID
+				if playerID == "" || participant.Type == "" {
+					continue
+				}
+				exists, checked := known[playerID]
+				if !checked {
+					_, err := queries.GetNFLPlayer(ctx, playerID)
+					if err != nil && !errors.Is(err, sql.ErrNoRows) {
+						return 0, fmt.Errorf("error getting player %s: %w", playerID, err)
+					}
+					exists = err == nil
+					known[playerID] = exists
+				}
+				if !exists {
+					skipped++
+					continue
+				}
+				err := queries.AddPlayPlayer(ctx, sqlc.AddPlayPlayerParams{PlayID: play.ID, PlayerID: playerID, Role: participant.Type})
+				if err != nil {
+					return 0, fmt.Errorf("error saving player %s in play %s: %w", playerID, play.ID, err)
+				}
+			}
+		}
+	}
+	if skipped > 0 {
+		log.Printf("Left %d unknown players off game %d's plays", skipped, gameID)
+	}
+	return sequence, nil
+}
+
+// playType normalizes ESPN's play type to a stored play type and result, or
+// returns "" for entries that aren't plays, like timeouts. A two-point try's
+// result comes from its description.
+func playType(espnType, text string) (string, string) {
+	switch espnType {
+	case "Pass Reception", "Pass Completion", "Passing Touchdown":
+		return data.PlayPass, data.ResultComplete
+	case "Pass Incompletion":
+		return data.PlayPass, data.ResultIncomplete
+	case "Pass Interception", "Pass Interception Return", "Interception Return Touchdown":
+		return data.PlayPass, data.ResultIntercepted
+	case "Rush", "Rushing Touchdown":
+		return data.PlayRush, ""
+	case "Sack":
+		return data.PlaySack, ""
+	case "Field Goal Good":
+		return data.PlayFieldGoal, data.ResultGood
+	case "Field Goal Missed", "Missed Field Goal Return", "Missed Field Goal Return Touchdown":
+		return data.PlayFieldGoal, data.ResultMissed
+	case "Blocked Field Goal", "Blocked Field Goal Touchdown":
+		return data.PlayFieldGoal, data.ResultBlocked
+	case "Extra Point Good":
+		return data.PlayExtraPoint, data.ResultGood
+	case "Extra Point Missed":
+		return data.PlayExtraPoint, data.ResultMissed
+	case "Blocked PAT":
+		return data.PlayExtraPoint, data.ResultBlocked
+	case "Two Point Pass", "Two Point Rush", "Two-Point Conversion":
+		if strings.Contains(strings.ToUpper(text), "ATTEMPT SUCCEEDS") {
+			return data.PlayTwoPoint, data.ResultGood
+		}
+		return data.PlayTwoPoint, data.ResultMissed
+	case "Punt", "Punt Return Touchdown", "Blocked Punt", "Blocked Punt Touchdown":
+		return data.PlayPunt, ""
+	case "Kickoff", "Kickoff Return (Offense)", "Kickoff Return Touchdown":
+		return data.PlayKickoff, ""
+	case "Penalty":
+		return data.PlayPenalty, ""
+	case "Timeout", "Official Timeout", "End Period", "End of Half", "End of Game", "End of Regulation", "Two-minute warning", "Coin Toss":
+		return "", ""
+	default:
+		return data.PlayOther, ""
+	}
+}
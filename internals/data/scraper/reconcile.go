@@ -0,0 +1,144 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/espn"
+)
+
+// reconciledStat pairs a box score stat with the season total it adds up to
+type reconciledStat struct {
+	category string // Box score category
+	key      string // Box score key, which for "made/attempts" pairs holds the first number
+	total    statKey
+}
+
+// statKey is a season total's category and name
+type statKey struct {
+	category string
+	name     string
+}
+
+// reconciledStats are the box score stats that have a season total to check
+// them against
+var reconciledStats = []reconciledStat{
+	{"passing", "completions/passingAttempts", statKey{"passing", "completions"}},
+	{"passing", "passingYards", statKey{"passing", "passingYards"}},
+	{"passing", "passingTouchdowns", statKey{"passing", "passingTouchdowns"}},
+	{"passing", "interceptions", statKey{"passing", "interceptions"}},
+	{"rushing", "rushingAttempts", statKey{"rushing", "rushingAttempts"}},
+	{"rushing", "rushingYards", statKey{"rushing", "rushingYards"}},
+	{"rushing", "rushingTouchdowns", statKey{"rushing", "rushingTouchdowns"}},
+	{"receiving", "receptions", statKey{"receiving", "receptions"}},
+	{"receiving", "receivingYards", statKey{"receiving", "receivingYards"}},
+	{"receiving", "receivingTouchdowns", statKey{"receiving", "receivingTouchdowns"}},
+	{"receiving", "receivingTargets", statKey{"receiving", "receivingTargets"}},
+	{"fumbles", "fumblesLost", statKey{"general", "fumblesLost"}},
+	{"defensive", "totalTackles", statKey{"defensive", "totalTackles"}},
+	{"defensive", "sacks", statKey{"defensive", "sacks"}},
+	{"interceptions", "interceptions", statKey{"defensiveInterceptions", "interceptions"}},
+	{"kicking", "fieldGoalsMade/fieldGoalAttempts", statKey{"kicking", "fieldGoalsMade"}},
+	{"kicking", "extraPointsMade/extraPointAttempts", statKey{"kicking", "extraPointsMade"}},
+}
+
+// Reconciliation is a player whose season totals don't match the sums of
+// their box scores. Fewer box scores than games played points to missing
+// games; mismatches with every game there point to parsing errors.
+type Reconciliation struct {
+	PlayerID    string         `json:"player_id"`
+	Name        string         `json:"name"`
+	Position    string         `json:"position"`
+	GamesPlayed int            `json:"games_played"` // From ESPN's season totals
+	BoxScores   int            `json:"box_scores"`   // Games with stats saved for the player
+	Mismatches  []StatMismatch `json:"mismatches"`
+}
+
+// StatMismatch is a season total that differs from the sum of its box scores
+type StatMismatch struct {
+	Category string  `json:"category"` // Season total's category, e.g. "receiving"
+	StatType string  `json:"stat_type"`
+	Season   float64 `json:"season"`
+	Games    float64 `json:"games"`
+}
+
+// MissingGames reports whether the player has fewer box scores than games
+// played
+func (r *Reconciliation) MissingGames() bool {
+	return r.BoxScores < r.GamesPlayed
+}
+
+// ReconcileSeasonStats compares players' regular season totals with the sums
+// of their box scores in a season, returning the players whose totals don't
+// match. Players without scraped totals are left out.
+func ReconcileSeasonStats(ctx context.Context, db *data.DB, season int) ([]*Reconciliation, error) {
+	totals, err := db.Queries.GetSeasonStatsBySeason(ctx, sqlc.GetSeasonStatsBySeasonParams{Season: int64(season), SeasonType: espn.SeasonTypeRegular})
+	if err != nil {
+		return nil, fmt.Errorf("error getting %d season totals: %w", season, err)
+	}
+	sums, err := db.Queries.GetGameStatTotalsBySeason(ctx, int64(season))
+	if err != nil {
+		return nil, fmt.Errorf("error getting %d box score totals: %w", season, err)
+	}
+	counts, err := db.Queries.GetPlayerGameCountsBySeason(ctx, int64(season))
+	if err != nil {
+		return nil, fmt.Errorf("error getting %d box score counts: %w", season, err)
+	}
+
+	var playerIDs []string
+	seasonTotals := make(map[string]map[statKey]float64) // By player ID
+	for _, total := range totals {
+		if seasonTotals[total.PlayerID] == nil {
+			playerIDs = append(playerIDs, total.PlayerID)
+			seasonTotals[total.PlayerID] = make(map[statKey]float64)
+		}
+		seasonTotals[total.PlayerID][statKey{total.Category, total.StatType}] = total.StatValue
+	}
+	gameTotals := make(map[string]map[statKey]float64)
+	for _, sum := range sums {
+		if gameTotals[sum.PlayerID] == nil {
+			gameTotals[sum.PlayerID] = make(map[statKey]float64)
+		}
+		gameTotals[sum.PlayerID][statKey{sum.Category, sum.StatType}] = sum.TotalValue.Float64
+	}
+	boxScores := make(map[string]int, len(counts))
+	for _, count := range counts {
+		boxScores[count.PlayerID] = int(count.Games)
+	}
+
+	var report []*Reconciliation
+	for _, playerID := range playerIDs {
+		playerTotals := seasonTotals[playerID]
+		r := &Reconciliation{
+			PlayerID:    playerID,
+			GamesPlayed: int(playerTotals[statKey{"general", "gamesPlayed"}]),
+			BoxScores:   boxScores[playerID],
+		}
+		for _, stat := range reconciledStats {
+			seasonValue, ok := playerTotals[stat.total]
+			if !ok {
+				continue
+			}
+			gamesValue := gameTotals[playerID][statKey{stat.category, stat.key}]
+			if math.Abs(seasonValue-gamesValue) > 0.001 {
+				r.Mismatches = append(r.Mismatches, StatMismatch{
+					Category: stat.total.category,
+					StatType: stat.total.name,
+					Season:   seasonValue,
+					Games:    gamesValue,
+				})
+			}
+		}
+		if len(r.Mismatches) == 0 {
+			continue
+		}
+		if player, err := db.Queries.GetNFLPlayer(ctx, r.PlayerID); err == nil {
+			r.Name, r.Position = player.FullName, player.Position
+		}
+		report = append(report, r)
+	}
+	return report, nil
+}
@@ -0,0 +1,184 @@
+package scraper
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/espn"
+)
+
+// DepthChartScraper handles fetching and storing NFL team depth charts
+type DepthChartScraper struct {
+	DB     *data.DB
+	Client *espn.Client
+}
+
+// NewDepthChartScraper creates a new scraper for NFL depth charts
+func NewDepthChartScraper(db *data.DB, client *espn.Client) *DepthChartScraper {
+	return &DepthChartScraper{
+		DB:     db,
+		Client: client,
+	}
+}
+
+// ScrapeDepthCharts saves every team's depth charts in a season as their
+// charts for a week, replacing any saved for it earlier. Players on a chart
+// who aren't in the database are fetched first. It returns how many entries
+// were saved. Weeks whose games are all final are skipped once saved.
+func (s *DepthChartScraper) ScrapeDepthCharts(ctx context.Context, season, week int) (int, error) {
+	weeks, err := resume(ctx, s.DB, DepthScraper, []int{week}, func(week int) data.Job {
+		return depthJob(season, week)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(weeks) == 0 {
+		log.Printf("The %d week %d depth charts are already saved", season, week)
+		return 0, nil
+	}
+
+	saved, err := s.scrapeDepthCharts(ctx, season, week)
+	finishReportJob(ctx, s.DB, depthJob(season, week), season, week, err)
+	return saved, err
+}
+
+// scrapeDepthCharts saves every team's depth charts for a week
+func (s *DepthChartScraper) scrapeDepthCharts(ctx context.Context, season, week int) (int, error) {
+	teams, err := s.DB.Queries.GetAllNFLTeams(ctx)
+	if err != nil || len(teams) == 0 {
+		return 0, fmt.Errorf("failed to fetch NFL teams from database: %w", err)
+	}
+
+	log.Printf("Scraping %d week %d depth charts for %d teams", season, week, len(teams))
+	saved, failed := 0, 0
+	for _, team := range teams {
+		if ctx.Err() != nil {
+			return saved, ctx.Err()
+		}
+		n, err := s.scrapeTeam(ctx, team.TeamID, season, week)
+		if err != nil {
+			log.Printf("Error scraping the %s depth chart: %v", team.Abbreviation, err)
+			failed++
+			continue
+		}
+		saved += n
+	}
+
+	log.Printf("Saved %d depth chart entries (%d teams failed)", saved, failed)
+	if failed > 0 {
+		return saved, fmt.Errorf("depth charts failed to load for %d of %d teams", failed, len(teams))
+	}
+	return saved, nil
+}
+
+// ScrapeNFLDepthCharts saves every team's depth charts in each season, as
+// the charts for the week whose games they're for: the upcoming week in the
+// season being played, or the last week of seasons that are over
+func (s *DepthChartScraper) ScrapeNFLDepthCharts(ctx context.Context, seasons []int) error {
+	updater := NewUpdater(s.DB, s.Client)
+	failed := 0
+	for _, season := range seasons {
+		week, err := updater.ReportWeek(ctx, season)
+		if err != nil {
+			log.Printf("Skipping %d depth charts: %v", season, err)
+			failed++
+			continue
+		}
+		if _, err := s.ScrapeDepthCharts(ctx, season, week.Week); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Printf("Error scraping %d depth charts: %v", season, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("depth charts failed to load for %d of %d seasons", failed, len(seasons))
+	}
+	return nil
+}
+
+// scrapeTeam fetches and saves a team's depth charts for a week
+func (s *DepthChartScraper) scrapeTeam(ctx context.Context, teamID string, season, week int) (int, error) {
+	charts, err := s.Client.TeamDepthCharts(ctx, season, teamID)
+	if err != nil {
+		return 0, fmt.Errorf("error fetching depth charts: %w", err)
+	}
+
+	var entries []sqlc.UpsertDepthChartEntryParams
+	unknown := make(map[string]string) // Player ID to team ID
+	for _, chart := range charts.Items {
+		for slot, position := range chart.Positions {
+			for _, athlete := range position.Athletes {
+				playerID := espn.RefPathID(athlete.Athlete.Ref, "athletes")
+				depth := cmp.Or(athlete.Rank, athlete.Slot)
+				if playerID == "" || depth < 1 {
+					continue
+				}
+
+				_, err := s.DB.Queries.GetNFLPlayer(ctx, playerID)
+				if errors.Is(err, sql.ErrNoRows) {
+					unknown[playerID] = teamID
+				} else if err != nil {
+					return 0, fmt.Errorf("error getting player %s: %w", playerID, err)
+				}
+
+				entries = append(entries, sqlc.UpsertDepthChartEntryParams{
+					Season:   int64(season),
+					Week:     int64(week),
+					TeamID:   teamID,
+					Slot:     strings.ToUpper(slot),
+					Position: strings.ToUpper(position.Position.Abbreviation),
+					Depth:    int64(depth),
+					PlayerID: playerID,
+				})
+			}
+		}
+	}
+
+	// Fetch players who aren't in the database, like practice squad call-ups,
+	// skipping the ones that can't be saved
+	if len(unknown) > 0 {
+		saved, _, err := NewPlayerScraper(s.DB, s.Client).refreshPlayers(ctx, season, unknown)
+		if err != nil {
+			return 0, fmt.Errorf("error backfilling depth chart players: %w", err)
+		}
+		for _, player := range saved {
+			delete(unknown, player.PlayerID)
+		}
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	queries := s.DB.Queries.WithTx(tx)
+	err = queries.DeleteTeamDepthChart(ctx, sqlc.DeleteTeamDepthChartParams{Season: int64(season), Week: int64(week), TeamID: teamID})
+	if err != nil {
+		return 0, fmt.Errorf("error clearing depth chart: %w", err)
+	}
+	saved := 0
+	for _, entry := range entries {
+		if _, ok := unknown[entry.PlayerID]; ok {
+			log.Printf("Skipping %s depth chart entry for player %s: couldn't fetch the player", entry.Slot, entry.PlayerID)
+			continue
+		}
+		if err := queries.UpsertDepthChartEntry(ctx, entry); err != nil {
+			return 0, fmt.Errorf("error saving depth chart entry for player %s: %w", entry.PlayerID, err)
+		}
+		saved++
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return saved, nil
+}
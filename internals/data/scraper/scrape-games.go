@@ -3,56 +3,33 @@ package scraper
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"fmt"
 	"log"
-	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 
 	"github.com/Mclazy108/GridironGo/internals/data"
 	//"github.com/Mclazy108/GridironGo/internals/data/sqlc"
-	"golang.org/x/time/rate"
+	"github.com/Mclazy108/GridironGo/internals/espn"
 )
 
 // NFLScraper handles fetching and storing NFL data
 type NFLScraper struct {
-	DB *data.DB
+	DB     *data.DB
+	Client *espn.Client
 }
 
 // NewScraper creates a new scraper that can populate the database
-func NewScraper(db *data.DB) *NFLScraper {
+func NewScraper(db *data.DB, client *espn.Client) *NFLScraper {
 	return &NFLScraper{
-		DB: db,
+		DB:     db,
+		Client: client,
 	}
 }
 
-// ScoreboardResponse represents the JSON structure returned by the ESPN API
-type ScoreboardResponse struct {
-	Events []Event `json:"events"`
-}
-
-// Event represents a game event from the ESPN API
-type Event struct {
-	ID        string `json:"id"`
-	Date      string `json:"date"`
-	Name      string `json:"name"`
-	ShortName string `json:"shortName"`
-	Season    Season `json:"season"`
-	Week      Week   `json:"week"`
-}
-
-// Season represents season information from the ESPN API
-type Season struct {
-	Year int `json:"year"`
-}
-
-// Week represents week information from the ESPN API
-type Week struct {
-	Number int `json:"number"`
-}
-
 // GameWeekJob represents a job to scrape games for a specific season and week
 type GameWeekJob struct {
 	Season int
@@ -69,6 +46,9 @@ type GameData struct {
 	Week      int64
 	AwayTeam  string
 	HomeTeam  string
+	AwayScore sql.NullInt64
+	HomeScore sql.NullInt64
+	Completed bool
 }
 
 // insertNFLGamesBulk performs a bulk insert of game data
@@ -79,17 +59,19 @@ func insertNFLGamesBulk(ctx context.Context, tx *sql.Tx, games []GameData) error
 
 	// Build query
 	query := `INSERT INTO nfl_games (
-		event_id, date, name, short_name, season, week, away_team, home_team
+		event_id, date, name, short_name, season, week, away_team, home_team,
+		away_score, home_score, completed
 	) VALUES `
 
 	// Collect value placeholders like (?, ?, ?, ...), (?, ?, ?, ...), ...
 	valueStrings := make([]string, 0, len(games))
-	valueArgs := make([]interface{}, 0, len(games)*8)
+	valueArgs := make([]interface{}, 0, len(games)*11)
 
 	for _, g := range games {
-		valueStrings = append(valueStrings, "(?, ?, ?, ?, ?, ?, ?, ?)")
+		valueStrings = append(valueStrings, "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
 		valueArgs = append(valueArgs,
 			g.EventID, g.Date, g.Name, g.ShortName, g.Season, g.Week, g.AwayTeam, g.HomeTeam,
+			g.AwayScore, g.HomeScore, g.Completed,
 		)
 	}
 
@@ -101,7 +83,10 @@ func insertNFLGamesBulk(ctx context.Context, tx *sql.Tx, games []GameData) error
 			season = excluded.season,
 			week = excluded.week,
 			away_team = excluded.away_team,
-			home_team = excluded.home_team`
+			home_team = excluded.home_team,
+			away_score = excluded.away_score,
+			home_score = excluded.home_score,
+			completed = excluded.completed`
 
 	// Prepare + exec
 	_, err := tx.ExecContext(ctx, query, valueArgs...)
@@ -113,14 +98,26 @@ func (s *NFLScraper) ScrapeNFLGames(ctx context.Context, seasons []int) error {
 	log.Println("Starting NFL games scraping process with parallel workers...")
 	log.Println("Press Ctrl+C to cancel the scraping process gracefully")
 
-	// Create a rate limiter to avoid overwhelming the API
-	limiter := rate.NewLimiter(800, 1)
+	// Queue every week, skipping the ones an earlier scrape finished
+	var weeks []GameWeekJob
+	for _, year := range seasons {
+		// Scrape regular season weeks 1-18
+		for week := 1; week <= 18; week++ {
+			weeks = append(weeks, GameWeekJob{Season: year, Week: week})
+		}
+	}
+	weeks, err := resume(ctx, s.DB, GamesScraper, weeks, func(job GameWeekJob) data.Job {
+		return weekJob(job.Season, job.Week)
+	})
+	if err != nil {
+		return err
+	}
 
 	// Create a wait group to wait for all goroutines to finish
 	var wg sync.WaitGroup
 
 	// Create job channel
-	jobChan := make(chan GameWeekJob, 100)
+	jobChan := make(chan GameWeekJob, len(weeks))
 
 	// Track stats
 	var processedWeeks int32 = 0
@@ -140,109 +137,19 @@ func (s *NFLScraper) ScrapeNFLGames(ctx context.Context, seasons []int) error {
 				log.Printf("Worker %d: Processing Season %d, Week %d",
 					workerID, job.Season, job.Week)
 
-				// Fetch games for this week and year
-				events, err := s.fetchEvents(ctx, job.Season, job.Week, limiter)
+				gamesInserted, err := s.ScrapeWeek(ctx, job.Season, job.Week)
 				if err != nil {
-					log.Printf("Worker %d: Error fetching games for Season %d, Week %d: %v",
+					log.Printf("Worker %d: Error scraping games for Season %d, Week %d: %v",
 						workerID, job.Season, job.Week, err)
 					atomic.AddInt32(&failedWeeks, 1)
 					continue
 				}
-
-				if len(events) == 0 {
-					log.Printf("Worker %d: No games found for Season %d, Week %d",
-						workerID, job.Season, job.Week)
-					atomic.AddInt32(&processedWeeks, 1)
-					continue
-				}
-
-				// Process events and prepare for bulk insert
-				gameData := make([]GameData, 0, len(events))
-				for _, event := range events {
-					// Convert event ID string to int64
-					var eventID int64
-					_, err := fmt.Sscanf(event.ID, "%d", &eventID)
-					if err != nil {
-						// Try alternate parsing if simple scanf fails
-						var temp int64
-						for i := 0; i < len(event.ID); i++ {
-							if event.ID[i] >= '0' && event.ID[i] <= '9' {
-								temp = temp*10 + int64(event.ID[i]-'0')
-							}
-						}
-
-						if temp > 0 {
-							eventID = temp
-						} else {
-							log.Printf("Worker %d: Error parsing event ID '%s': %v",
-								workerID, event.ID, err)
-							continue
-						}
-					}
-
-					// Extract home and away teams from name
-					awayTeam, homeTeam := extractTeams(event.Name)
-
-					// Skip games where team extraction failed
-					if awayTeam == "" || homeTeam == "" {
-						log.Printf("Worker %d: Skipping game with ID %s due to missing team information",
-							workerID, event.ID)
-						continue
-					}
-
-					// Format date for better readability in database
-					formattedDate := event.Date
-					if len(event.Date) >= 10 {
-						formattedDate = event.Date[:10]
-					}
-
-					gameData = append(gameData, GameData{
-						EventID:   eventID,
-						Date:      formattedDate,
-						Name:      event.Name,
-						ShortName: event.ShortName,
-						Season:    int64(event.Season.Year),
-						Week:      int64(event.Week.Number),
-						AwayTeam:  awayTeam,
-						HomeTeam:  homeTeam,
-					})
-				}
-
-				// Insert into database in a single transaction
-				if len(gameData) > 0 {
-					tx, err := s.DB.BeginTx(ctx, nil)
-					if err != nil {
-						log.Printf("Worker %d: Failed to begin transaction: %v", workerID, err)
-						atomic.AddInt32(&failedWeeks, 1)
-						continue
-					}
-
-					err = insertNFLGamesBulk(ctx, tx, gameData)
-					if err != nil {
-						log.Printf("Worker %d: Error inserting bulk games for Season %d, Week %d: %v",
-							workerID, job.Season, job.Week, err)
-						_ = tx.Rollback()
-						atomic.AddInt32(&failedWeeks, 1)
-						continue
-					}
-
-					if err := tx.Commit(); err != nil {
-						log.Printf("Worker %d: Failed to commit transaction: %v", workerID, err)
-						atomic.AddInt32(&failedWeeks, 1)
-						continue
-					}
-
-					gamesInserted := len(gameData)
-					atomic.AddInt32(&totalGames, int32(gamesInserted))
-					log.Printf("Worker %d: Successfully inserted %d games for Season %d, Week %d",
-						workerID, gamesInserted, job.Season, job.Week)
-				}
+				atomic.AddInt32(&totalGames, int32(gamesInserted))
 
 				// Increment counter for processed weeks
 				weeksProcessed := atomic.AddInt32(&processedWeeks, 1)
-				totalJobs := len(seasons) * 18 // Assuming 18 weeks per season
 				log.Printf("Progress: %d/%d weeks processed, %d total games",
-					weeksProcessed, totalJobs, atomic.LoadInt32(&totalGames))
+					weeksProcessed, len(weeks), atomic.LoadInt32(&totalGames))
 			}
 			log.Printf("Worker %d finished", workerID)
 		}(i)
@@ -250,18 +157,15 @@ func (s *NFLScraper) ScrapeNFLGames(ctx context.Context, seasons []int) error {
 
 	// Create jobs for all seasons and weeks
 	totalJobs := 0
-	for _, year := range seasons {
-		// Scrape regular season weeks 1-18
-		for week := 1; week <= 18; week++ {
-			select {
-			case <-ctx.Done():
-				log.Println("Job creation cancelled by user")
-				close(jobChan)
-				return ctx.Err()
-			default:
-				jobChan <- GameWeekJob{Season: year, Week: week}
-				totalJobs++
-			}
+	for _, job := range weeks {
+		select {
+		case <-ctx.Done():
+			log.Println("Job creation cancelled by user")
+			close(jobChan)
+			return ctx.Err()
+		default:
+			jobChan <- job
+			totalJobs++
 		}
 	}
 
@@ -279,46 +183,121 @@ func (s *NFLScraper) ScrapeNFLGames(ctx context.Context, seasons []int) error {
 	return nil
 }
 
-// fetchEvents fetches NFL games for a specific year and week from the ESPN API
-func (s *NFLScraper) fetchEvents(ctx context.Context, year int, week int, limiter *rate.Limiter) ([]Event, error) {
-	// Wait for rate limiter
-	if err := limiter.Wait(ctx); err != nil {
-		return nil, fmt.Errorf("rate limiter error: %w", err)
+// ScrapeWeek fetches and stores a regular season week's games and scores,
+// returning how many games were saved. The week is done in the job ledger
+// once all its games are final.
+func (s *NFLScraper) ScrapeWeek(ctx context.Context, season, week int) (int, error) {
+	saved, final, err := s.scrapeWeek(ctx, season, week)
+	if err == nil && !final {
+		s.DB.DeferJob(ctx, weekJob(season, week))
+	} else {
+		s.DB.FinishJob(ctx, weekJob(season, week), err)
 	}
+	return saved, err
+}
 
-	// Construct the API URL
-	url := fmt.Sprintf("https://site.api.espn.com/apis/site/v2/sports/football/nfl/scoreboard?dates=%d&seasontype=2&week=%d", year, week)
-
-	// Create a new request with context
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+// scrapeWeek does the work of ScrapeWeek, also reporting whether every game
+// in the week is final
+func (s *NFLScraper) scrapeWeek(ctx context.Context, season, week int) (int, bool, error) {
+	// Fetch games for this week and year
+	events, err := s.fetchEvents(ctx, season, week)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return 0, false, fmt.Errorf("error fetching games: %w", err)
 	}
 
-	// Send HTTP GET request
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		// Check if the error was due to context cancellation
-		if ctx.Err() != nil {
-			return nil, fmt.Errorf("request cancelled: %w", ctx.Err())
+	// A finished season's empty week, like week 18 before 2021, won't get
+	// any games
+	if len(events) == 0 {
+		log.Printf("No games found for Season %d, Week %d", season, week)
+		return 0, !seasonInProgress(season), nil
+	}
+
+	// Process events and prepare for bulk insert
+	gameData := make([]GameData, 0, len(events))
+	for _, event := range events {
+		// Convert event ID string to int64
+		var eventID int64
+		_, err := fmt.Sscanf(event.ID, "%d", &eventID)
+		if err != nil {
+			// Try alternate parsing if simple scanf fails
+			var temp int64
+			for i := 0; i < len(event.ID); i++ {
+				if event.ID[i] >= '0' && event.ID[i] <= '9' {
+					temp = temp*10 + int64(event.ID[i]-'0')
+				}
+			}
+
+			if temp > 0 {
+				eventID = temp
+			} else {
+				log.Printf("Error parsing event ID '%s': %v", event.ID, err)
+				continue
+			}
 		}
-		return nil, fmt.Errorf("HTTP request failed: %w", err)
+
+		// Extract home and away teams from name
+		awayTeam, homeTeam := extractTeams(event.Name)
+
+		// Skip games where team extraction failed
+		if awayTeam == "" || homeTeam == "" {
+			log.Printf("Skipping game with ID %s due to missing team information", event.ID)
+			continue
+		}
+
+		// Format date for better readability in database
+		formattedDate := event.Date
+		if len(event.Date) >= 10 {
+			formattedDate = event.Date[:10]
+		}
+
+		awayScore, homeScore := extractScores(event)
+
+		gameData = append(gameData, GameData{
+			EventID:   eventID,
+			Date:      formattedDate,
+			Name:      event.Name,
+			ShortName: event.ShortName,
+			Season:    int64(event.Season.Year),
+			Week:      int64(event.Week.Number),
+			AwayTeam:  awayTeam,
+			HomeTeam:  homeTeam,
+			AwayScore: awayScore,
+			HomeScore: homeScore,
+			Completed: event.Status.Type.Completed,
+		})
 	}
-	defer resp.Body.Close()
 
-	// Check response status
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned non-OK status: %d", resp.StatusCode)
+	if len(gameData) == 0 {
+		return 0, false, nil
 	}
 
-	// Parse the JSON response
-	var scoreboardResponse ScoreboardResponse
-	err = json.NewDecoder(resp.Body).Decode(&scoreboardResponse)
+	// Insert into database in a single transaction
+	tx, err := s.DB.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode JSON response: %w", err)
+		return 0, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := insertNFLGamesBulk(ctx, tx, gameData); err != nil {
+		_ = tx.Rollback()
+		return 0, false, fmt.Errorf("error inserting bulk games: %w", err)
 	}
 
-	return scoreboardResponse.Events, nil
+	if err := tx.Commit(); err != nil {
+		return 0, false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("Successfully inserted %d games for Season %d, Week %d", len(gameData), season, week)
+	final := !slices.ContainsFunc(gameData, func(g GameData) bool { return !g.Completed })
+	return len(gameData), final, nil
+}
+
+// fetchEvents fetches NFL games for a specific year and week from the ESPN API
+func (s *NFLScraper) fetchEvents(ctx context.Context, year int, week int) ([]espn.Event, error) {
+	scoreboard, err := s.Client.Scoreboard(ctx, year, week)
+	if err != nil {
+		return nil, err
+	}
+	return scoreboard.Events, nil
 }
 
 // extractTeams extracts away and home teams from the game name
@@ -346,3 +325,26 @@ func extractTeams(gameName string) (string, string) {
 	log.Printf("Failed to extract teams from game name: %s", gameName)
 	return "", ""
 }
+
+// extractScores returns the away and home scores of a game that has kicked
+// off. Scores are null for games that haven't started.
+func extractScores(event espn.Event) (sql.NullInt64, sql.NullInt64) {
+	var away, home sql.NullInt64
+	if event.Status.Type.State == "pre" || len(event.Competitions) == 0 {
+		return away, home
+	}
+
+	for _, competitor := range event.Competitions[0].Competitors {
+		score, err := strconv.ParseInt(competitor.Score, 10, 64)
+		if err != nil {
+			continue
+		}
+		switch competitor.HomeAway {
+		case "away":
+			away = sql.NullInt64{Int64: score, Valid: true}
+		case "home":
+			home = sql.NullInt64{Int64: score, Valid: true}
+		}
+	}
+	return away, home
+}
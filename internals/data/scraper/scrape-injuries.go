@@ -0,0 +1,186 @@
+package scraper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/espn"
+)
+
+// InjuryScraper handles fetching and storing NFL injury reports
+type InjuryScraper struct {
+	DB     *data.DB
+	Client *espn.Client
+}
+
+// NewInjuryScraper creates a new scraper for NFL injury reports
+func NewInjuryScraper(db *data.DB, client *espn.Client) *InjuryScraper {
+	return &InjuryScraper{
+		DB:     db,
+		Client: client,
+	}
+}
+
+// ScrapeInjuries saves every team's current injury report as the reports for
+// a week, replacing any saved for it earlier. A team whose report fails to
+// load keeps its latest earlier one. Injured players who aren't in
+// the database are fetched first. It returns how many designations were
+// saved. Weeks whose games are all final are skipped once saved.
+func (s *InjuryScraper) ScrapeInjuries(ctx context.Context, season, week int) (int, error) {
+	weeks, err := resume(ctx, s.DB, InjuriesScraper, []int{week}, func(week int) data.Job {
+		return injuryJob(season, week)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(weeks) == 0 {
+		log.Printf("The %d week %d injury reports are already saved", season, week)
+		return 0, nil
+	}
+
+	saved, err := s.scrapeInjuries(ctx, season, week)
+	finishReportJob(ctx, s.DB, injuryJob(season, week), season, week, err)
+	return saved, err
+}
+
+// scrapeInjuries saves every team's injury reports for a week
+func (s *InjuryScraper) scrapeInjuries(ctx context.Context, season, week int) (int, error) {
+	teams, err := s.DB.Queries.GetAllNFLTeams(ctx)
+	if err != nil || len(teams) == 0 {
+		return 0, fmt.Errorf("failed to fetch NFL teams from database: %w", err)
+	}
+
+	log.Printf("Scraping %d week %d injury reports for %d teams", season, week, len(teams))
+	saved, failed := 0, 0
+	for _, team := range teams {
+		if ctx.Err() != nil {
+			return saved, ctx.Err()
+		}
+		n, err := s.scrapeTeam(ctx, team.TeamID, season, week)
+		if err != nil {
+			log.Printf("Error scraping the %s injury report: %v", team.Abbreviation, err)
+			failed++
+			continue
+		}
+		saved += n
+	}
+
+	log.Printf("Saved %d injury designations (%d teams failed)", saved, failed)
+	if failed > 0 {
+		return saved, fmt.Errorf("injury reports failed to load for %d of %d teams", failed, len(teams))
+	}
+	return saved, nil
+}
+
+// scrapeTeam fetches and saves a team's injury report for a week
+func (s *InjuryScraper) scrapeTeam(ctx context.Context, teamID string, season, week int) (int, error) {
+	refs, err := s.Client.TeamInjuries(ctx, teamID)
+	if err != nil {
+		return 0, fmt.Errorf("error fetching injuries: %w", err)
+	}
+
+	var injuries []sqlc.UpsertInjuryParams
+	unknown := make(map[string]string) // Player ID to team ID
+	for _, ref := range refs.Items {
+		playerID := espn.RefPathID(ref.Ref, "athletes")
+		if playerID == "" {
+			log.Printf("Skipping injury %s: no player in the reference", ref.Ref)
+			continue
+		}
+		injury, err := s.Client.Injury(ctx, playerID, espn.RefID(ref.Ref))
+		if err != nil {
+			return 0, fmt.Errorf("error fetching injury for player %s: %w", playerID, err)
+		}
+		status := injuryStatus(injury.Status)
+		if status == "" {
+			continue
+		}
+
+		_, err = s.DB.Queries.GetNFLPlayer(ctx, playerID)
+		if errors.Is(err, sql.ErrNoRows) {
+			unknown[playerID] = teamID
+		} else if err != nil {
+			return 0, fmt.Errorf("error getting player %s: %w", playerID, err)
+		}
+
+		injuries = append(injuries, sqlc.UpsertInjuryParams{
+			PlayerID:   playerID,
+			Season:     int64(season),
+			Week:       int64(week),
+			TeamID:     teamID,
+			Status:     status,
+			BodyPart:   sql.NullString{String: injury.Details.Type, Valid: injury.Details.Type != ""},
+			Detail:     sql.NullString{String: injury.ShortComment, Valid: injury.ShortComment != ""},
+			InjuryDate: sql.NullString{String: injuryDate(injury.Date), Valid: injury.Date != ""},
+		})
+	}
+
+	// Fetch injured players who aren't in the database, like practice squad
+	// call-ups, skipping the ones that can't be saved
+	if len(unknown) > 0 {
+		saved, _, err := NewPlayerScraper(s.DB, s.Client).refreshPlayers(ctx, season, unknown)
+		if err != nil {
+			return 0, fmt.Errorf("error backfilling injured players: %w", err)
+		}
+		for _, player := range saved {
+			delete(unknown, player.PlayerID)
+		}
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	queries := s.DB.Queries.WithTx(tx)
+	err = queries.DeleteTeamInjuries(ctx, sqlc.DeleteTeamInjuriesParams{Season: int64(season), Week: int64(week), TeamID: teamID})
+	if err != nil {
+		return 0, fmt.Errorf("error clearing injuries: %w", err)
+	}
+	err = queries.SaveInjuryReport(ctx, sqlc.SaveInjuryReportParams{Season: int64(season), Week: int64(week), TeamID: teamID})
+	if err != nil {
+		return 0, fmt.Errorf("error saving the report: %w", err)
+	}
+	saved := 0
+	for _, injury := range injuries {
+		if _, ok := unknown[injury.PlayerID]; ok {
+			log.Printf("Skipping injury for player %s: couldn't fetch the player", injury.PlayerID)
+			continue
+		}
+		if err := queries.UpsertInjury(ctx, injury); err != nil {
+			return 0, fmt.Errorf("error saving injury for player %s: %w", injury.PlayerID, err)
+		}
+		saved++
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return saved, nil
+}
+
+// injuryStatus converts ESPN's injury status to a designation, or "" for
+// players who are active
+func injuryStatus(status string) string {
+	switch status {
+	case "", "Active":
+		return ""
+	case "Injured Reserve":
+		return data.InjuryIR
+	default:
+		return status
+	}
+}
+
+// injuryDate trims an ESPN timestamp like "2024-09-12T18:35Z" to its date
+func injuryDate(timestamp string) string {
+	if len(timestamp) < len("2006-01-02") {
+		return timestamp
+	}
+	return timestamp[:len("2006-01-02")]
+}
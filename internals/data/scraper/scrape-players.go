@@ -3,104 +3,38 @@ package scraper
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
 
 	"github.com/Mclazy108/GridironGo/internals/data"
 	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
-	"golang.org/x/time/rate"
+	"github.com/Mclazy108/GridironGo/internals/espn"
 )
 
 // PlayerScraper handles fetching and storing NFL player data
 type PlayerScraper struct {
-	DB *data.DB
+	DB     *data.DB
+	Client *espn.Client
 }
 
 // NewPlayerScraper creates a new scraper for NFL player data
-func NewPlayerScraper(db *data.DB) *PlayerScraper {
+func NewPlayerScraper(db *data.DB, client *espn.Client) *PlayerScraper {
 	return &PlayerScraper{
-		DB: db,
+		DB:     db,
+		Client: client,
 	}
 }
 
-// ESPNPlayerResponse represents the direct player data structure from ESPN API
-type ESPNPlayerResponse struct {
-	ID          string  `json:"id"`
-	UID         string  `json:"uid"`
-	GUID        string  `json:"guid"`
-	FirstName   string  `json:"firstName"`
-	LastName    string  `json:"lastName"`
-	FullName    string  `json:"fullName"`
-	DisplayName string  `json:"displayName"`
-	ShortName   string  `json:"shortName"`
-	Weight      float64 `json:"weight"` // Using float64 as API returns decimal values like 213.0
-	Height      float64 `json:"height"` // Using float64 as API returns decimal values like 74.0
-	Jersey      string  `json:"jersey"`
-	Age         int     `json:"age,omitempty"` // Age is optional as it might not always be present
-	DateOfBirth string  `json:"dateOfBirth,omitempty"`
-	Position    struct {
-		ID           string `json:"id"`
-		Name         string `json:"name"`
-		Abbreviation string `json:"abbreviation"`
-		DisplayName  string `json:"displayName"`
-	} `json:"position"`
-	Team struct {
-		ID           string `json:"id"`
-		UID          string `json:"uid"`
-		Slug         string `json:"slug"`
-		Location     string `json:"location"`
-		Name         string `json:"name"`
-		Abbreviation string `json:"abbreviation"`
-		DisplayName  string `json:"displayName"`
-		ShortName    string `json:"shortName"`
-		Color        string `json:"color"`
-	} `json:"team"`
-	College struct {
-		ID           string `json:"id"`
-		Name         string `json:"name"`
-		Abbreviation string `json:"abbreviation"`
-	} `json:"college"`
-	Status struct {
-		ID           string `json:"id"`
-		Name         string `json:"name"`
-		Type         string `json:"type"`
-		Abbreviation string `json:"abbreviation"`
-	} `json:"status"`
-	Experience struct {
-		Years int `json:"years"`
-	} `json:"experience"`
-	Active          bool   `json:"active"`
-	HeadshotImgURL  string `json:"headshotImgUrl,omitempty"`  // Image URL may be at this level
-	HeadshotImgHref string `json:"headshotImgHref,omitempty"` // Or might be here
-	Draft           struct {
-		Year      int `json:"year"`
-		Round     int `json:"round"`
-		Selection int `json:"selection"`
-		Team      struct {
-			ID           string `json:"id"`
-			DisplayName  string `json:"displayName"`
-			Abbreviation string `json:"abbreviation"`
-		} `json:"team"`
-	} `json:"draft"`
-	Headshot struct {
-		Href string `json:"href"` // Or might be in this nested structure
-		Alt  string `json:"alt"`
-	} `json:"headshot"`
-	Linked bool `json:"linked"`
-}
-
 // PlayerData holds a player's information and their team ID
 type PlayerData struct {
 	PlayerID   string
 	TeamID     string
 	SeasonYear int
-	PlayerInfo *ESPNPlayerResponse
+	PlayerInfo *espn.Athlete
 }
 
 // ScrapeNFLPlayers fetches and stores NFL player data with team-based batching
@@ -120,18 +54,32 @@ func (s *PlayerScraper) ScrapeNFLPlayers(ctx context.Context, seasons []int) err
 	var processedTeams int32 = 0
 	var failedPlayers int32 = 0
 
-	// Create a rate limiter to avoid overwhelming the API
-	limiter := rate.NewLimiter(2500, 1)
+	// Queue every team-season, skipping the ones an earlier scrape finished
+	type TeamSeason struct {
+		Team       *sqlc.NflTeam
+		SeasonYear int
+	}
+	var teamSeasons []TeamSeason
+	for _, season := range seasons {
+		for i := range teams {
+			teamSeasons = append(teamSeasons, TeamSeason{
+				Team:       teams[i],
+				SeasonYear: season,
+			})
+		}
+	}
+	teamSeasons, err = resume(ctx, s.DB, PlayersScraper, teamSeasons, func(ts TeamSeason) data.Job {
+		return rosterJob(ts.SeasonYear, ts.Team.TeamID)
+	})
+	if err != nil {
+		return err
+	}
 
 	// Create a wait group to wait for all goroutines to finish
 	var wg sync.WaitGroup
 
 	// Create a channel for team-season combinations
-	type TeamSeason struct {
-		Team       *sqlc.NflTeam
-		SeasonYear int
-	}
-	teamSeasonChan := make(chan TeamSeason, len(teams)*len(seasons))
+	teamSeasonChan := make(chan TeamSeason, len(teamSeasons))
 
 	// Number of worker goroutines to process teams
 	numWorkers := 32
@@ -149,7 +97,15 @@ func (s *PlayerScraper) ScrapeNFLPlayers(ctx context.Context, seasons []int) err
 				log.Printf("Worker %d: Processing team %s for season %d", workerID, team.DisplayName, seasonYear)
 
 				// Process the team roster as a batch
-				playersProcessed, err := s.processTeamRoster(ctx, *team, seasonYear, limiter)
+				playersProcessed, err := s.processTeamRoster(ctx, *team, seasonYear)
+
+				// Rosters change until a season is over, so they're only
+				// done in the job ledger after that
+				if err == nil && seasonInProgress(seasonYear) {
+					s.DB.DeferJob(ctx, rosterJob(seasonYear, team.TeamID))
+				} else {
+					s.DB.FinishJob(ctx, rosterJob(seasonYear, team.TeamID), err)
+				}
 
 				if err != nil {
 					log.Printf("Worker %d: Error processing team %s for season %d: %v",
@@ -163,7 +119,7 @@ func (s *PlayerScraper) ScrapeNFLPlayers(ctx context.Context, seasons []int) err
 					totalPlayersCount := atomic.AddInt32(&totalPlayers, int32(playersProcessed))
 
 					log.Printf("Progress: %d/%d team-seasons processed, %d total players",
-						numProcessed, len(teams)*len(seasons), totalPlayersCount)
+						numProcessed, len(teamSeasons), totalPlayersCount)
 				}
 			}
 			log.Printf("Worker %d finished", workerID)
@@ -171,13 +127,8 @@ func (s *PlayerScraper) ScrapeNFLPlayers(ctx context.Context, seasons []int) err
 	}
 
 	// Send team-season combinations to workers
-	for _, season := range seasons {
-		for i := range teams {
-			teamSeasonChan <- TeamSeason{
-				Team:       teams[i],
-				SeasonYear: season,
-			}
-		}
+	for _, teamSeason := range teamSeasons {
+		teamSeasonChan <- teamSeason
 	}
 
 	// Close the team-season channel when done
@@ -188,7 +139,7 @@ func (s *PlayerScraper) ScrapeNFLPlayers(ctx context.Context, seasons []int) err
 	wg.Wait()
 
 	log.Printf("Processed %d team-seasons with %d total players (%d failed)",
-		len(teams)*len(seasons), totalPlayers, failedPlayers)
+		len(teamSeasons), totalPlayers, failedPlayers)
 	log.Println("NFL players scraping completed")
 	return nil
 }
@@ -355,15 +306,11 @@ func insertPlayerSeasonsBulk(ctx context.Context, tx *sql.Tx, players []PlayerDa
 }
 
 // processTeamRoster fetches and processes an entire team's roster for a specific season
-func (s *PlayerScraper) processTeamRoster(ctx context.Context, team sqlc.NflTeam, seasonYear int, limiter *rate.Limiter) (int, error) {
+func (s *PlayerScraper) processTeamRoster(ctx context.Context, team sqlc.NflTeam, seasonYear int) (int, error) {
 	teamID := team.TeamID
 	teamName := team.DisplayName
 
-	if err := limiter.Wait(ctx); err != nil {
-		return 0, fmt.Errorf("rate limiter error: %w", err)
-	}
-
-	playerIDs, err := s.fetchTeamRoster(ctx, teamID, seasonYear, limiter)
+	playerIDs, err := s.fetchTeamRoster(ctx, teamID, seasonYear)
 	if err != nil {
 		return 0, fmt.Errorf("error fetching roster for team %s in season %d: %w", teamName, seasonYear, err)
 	}
@@ -373,12 +320,11 @@ func (s *PlayerScraper) processTeamRoster(ctx context.Context, team sqlc.NflTeam
 	playerDataList := make([]PlayerData, 0, len(playerIDs))
 
 	for _, playerID := range playerIDs {
-		if err := limiter.Wait(ctx); err != nil {
-			log.Printf("Rate limiter error while fetching player %s: %v", playerID, err)
-			continue
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
 		}
 
-		playerResponse, err := s.fetchPlayerDetails(ctx, playerID, limiter)
+		playerResponse, err := s.Client.Athlete(ctx, playerID)
 		if err != nil {
 			log.Printf("Error fetching details for player ID %s: %v", playerID, err)
 			continue
@@ -434,114 +380,81 @@ func (s *PlayerScraper) processTeamRoster(ctx context.Context, team sqlc.NflTeam
 	return len(playerDataList), nil
 }
 
-// fetchTeamRoster fetches the roster for a specific team and season
-func (s *PlayerScraper) fetchTeamRoster(ctx context.Context, teamID string, seasonYear int, limiter *rate.Limiter) ([]string, error) {
-	// Construct the API URL for team roster with the specific season
-	url := fmt.Sprintf("https://sports.core.api.espn.com/v2/sports/football/leagues/nfl/seasons/%d/teams/%s/athletes?limit=200",
-		seasonYear, teamID)
-
-	// Create a new request with context
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Set headers to make it look like a browser request
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64)")
-	req.Header.Set("Accept", "application/json")
+// RefreshPlayers fetches and stores the details of specific players, given
+// as a map of player ID to the team they played for in a season. It returns
+// how many were saved; players without a position, or whose details fail to
+// load, are skipped.
+func (s *PlayerScraper) RefreshPlayers(ctx context.Context, seasonYear int, players map[string]string) (int, error) {
+	saved, _, err := s.refreshPlayers(ctx, seasonYear, players)
+	return len(saved), err
+}
 
-	// Send HTTP GET request
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
+// refreshPlayers does the work of RefreshPlayers, returning the players saved
+// and the IDs of the ones whose details failed to load, in order
+func (s *PlayerScraper) refreshPlayers(ctx context.Context, seasonYear int, players map[string]string) ([]PlayerData, []string, error) {
+	playerDataList := make([]PlayerData, 0, len(players))
+	var failed []string
+	for playerID, teamID := range players {
 		if ctx.Err() != nil {
-			return nil, fmt.Errorf("request cancelled: %w", ctx.Err())
+			return nil, nil, ctx.Err()
 		}
-		return nil, fmt.Errorf("HTTP request failed: %w", err)
-	}
-	defer resp.Body.Close()
 
-	// Check response status
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned non-OK status: %d. Response: %s", resp.StatusCode, string(body))
-	}
+		playerResponse, err := s.Client.Athlete(ctx, playerID)
+		if err != nil {
+			log.Printf("Error fetching details for player ID %s: %v", playerID, err)
+			failed = append(failed, playerID)
+			continue
+		}
+		if playerResponse.Position.Abbreviation == "" {
+			log.Printf("Skipping player %s - no position data", playerResponse.FullName)
+			continue
+		}
 
-	// Parse the JSON response
-	var rosterResponse struct {
-		Items []struct {
-			Ref string `json:"$ref"`
-		} `json:"items"`
+		playerDataList = append(playerDataList, PlayerData{
+			PlayerID:   playerID,
+			TeamID:     teamID,
+			SeasonYear: seasonYear,
+			PlayerInfo: playerResponse,
+		})
 	}
-
-	if err := json.NewDecoder(resp.Body).Decode(&rosterResponse); err != nil {
-		return nil, fmt.Errorf("failed to decode JSON response: %w", err)
+	slices.Sort(failed)
+	if len(playerDataList) == 0 {
+		return nil, failed, nil
 	}
 
-	// Extract player IDs from the response
-	var playerIDs []string
-	for _, item := range rosterResponse.Items {
-		if item.Ref != "" {
-			// The $ref URL is typically in the format ".../athletes/{playerID}?..."
-			// We need to extract just the playerID part
-			parts := strings.Split(item.Ref, "/")
-			if len(parts) > 0 {
-				// Get the last part of the URL (which contains the player ID)
-				lastPart := parts[len(parts)-1]
-				// If there's a query string, remove it
-				playerID := strings.Split(lastPart, "?")[0]
-				if playerID != "" {
-					playerIDs = append(playerIDs, playerID)
-				}
-			}
-		}
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
-
-	return playerIDs, nil
-}
-
-// fetchPlayerDetails fetches detailed information for a specific player
-func (s *PlayerScraper) fetchPlayerDetails(ctx context.Context, playerID string, limiter *rate.Limiter) (*ESPNPlayerResponse, error) {
-	// Construct the API URL for player details
-	// Using the direct athlete endpoint from ESPN API
-	url := fmt.Sprintf("https://sports.core.api.espn.com/v2/sports/football/leagues/nfl/athletes/%s", playerID)
-
-	// Wait for rate limiter
-	if err := limiter.Wait(ctx); err != nil {
-		return nil, fmt.Errorf("rate limiter error: %w", err)
+	if err := insertNFLPlayersBulk(ctx, tx, playerDataList); err != nil {
+		_ = tx.Rollback()
+		return nil, nil, fmt.Errorf("error inserting base player records: %w", err)
 	}
-
-	// Create a new request with context
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	if err := insertPlayerSeasonsBulk(ctx, tx, playerDataList); err != nil {
+		_ = tx.Rollback()
+		return nil, nil, fmt.Errorf("error inserting player seasons: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	// Set headers to make it look like a browser request
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64)")
-	req.Header.Set("Accept", "application/json")
+	return playerDataList, failed, nil
+}
 
-	// Send HTTP GET request
-	resp, err := http.DefaultClient.Do(req)
+// fetchTeamRoster fetches the IDs of the players on a team's roster in a season
+func (s *PlayerScraper) fetchTeamRoster(ctx context.Context, teamID string, seasonYear int) ([]string, error) {
+	roster, err := s.Client.TeamRoster(ctx, seasonYear, teamID)
 	if err != nil {
-		if ctx.Err() != nil {
-			return nil, fmt.Errorf("request cancelled: %w", ctx.Err())
-		}
-		return nil, fmt.Errorf("HTTP request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// Check response status
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned non-OK status: %d. Response: %s", resp.StatusCode, string(body))
+		return nil, err
 	}
 
-	// Parse the JSON response
-	var playerResponse ESPNPlayerResponse
-	if err := json.NewDecoder(resp.Body).Decode(&playerResponse); err != nil {
-		return nil, fmt.Errorf("failed to decode JSON response: %w", err)
+	// Extract player IDs from the ".../athletes/{playerID}?..." references
+	var playerIDs []string
+	for _, item := range roster.Items {
+		if playerID := espn.RefID(item.Ref); playerID != "" {
+			playerIDs = append(playerIDs, playerID)
+		}
 	}
 
-	return &playerResponse, nil
+	return playerIDs, nil
 }
-
@@ -0,0 +1,147 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/espn"
+)
+
+// SeasonStatScraper handles fetching and storing players' season totals,
+// which ESPN keeps separately from box scores
+type SeasonStatScraper struct {
+	DB     *data.DB
+	Client *espn.Client
+}
+
+// NewSeasonStatScraper creates a new scraper for players' season totals
+func NewSeasonStatScraper(db *data.DB, client *espn.Client) *SeasonStatScraper {
+	return &SeasonStatScraper{
+		DB:     db,
+		Client: client,
+	}
+}
+
+// ScrapeNFLSeasonStats fetches and stores the regular season totals of every
+// player with a season in each season. Each player is done in the job ledger
+// once their season is over.
+func (s *SeasonStatScraper) ScrapeNFLSeasonStats(ctx context.Context, seasons []int) error {
+	failed := 0
+	for _, season := range seasons {
+		players, err := s.DB.Queries.GetPlayerSeasonsByYear(ctx, int64(season))
+		if err != nil {
+			return fmt.Errorf("failed to fetch %d players from database: %w", season, err)
+		}
+		players, err = resume(ctx, s.DB, TotalsScraper, players, func(player *sqlc.NflPlayerSeason) data.Job {
+			return totalsJob(season, player.PlayerID)
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Printf("Scraping %d season totals for %d players", season, len(players))
+		saved, n := s.scrapePlayers(ctx, season, players)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Printf("Saved %d season totals for %d season (%d players failed)", saved, season, n)
+		failed += n
+	}
+	if failed > 0 {
+		return fmt.Errorf("season totals failed to load for %d players", failed)
+	}
+	return nil
+}
+
+// scrapePlayers fetches and saves players' totals for a season with a pool
+// of workers, returning how many stats were saved and how many players failed
+func (s *SeasonStatScraper) scrapePlayers(ctx context.Context, season int, players []*sqlc.NflPlayerSeason) (int, int) {
+	var saved, failed atomic.Int32
+	var wg sync.WaitGroup
+	playerChan := make(chan *sqlc.NflPlayerSeason)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for player := range playerChan {
+				n, err := s.scrapePlayer(ctx, season, player.PlayerID)
+				if err == nil && seasonInProgress(season) {
+					s.DB.DeferJob(ctx, totalsJob(season, player.PlayerID))
+				} else {
+					s.DB.FinishJob(ctx, totalsJob(season, player.PlayerID), err)
+				}
+				if err != nil {
+					log.Printf("Error scraping %d season totals for player %s: %v", season, player.PlayerID, err)
+					failed.Add(1)
+					continue
+				}
+				saved.Add(int32(n))
+			}
+		}()
+	}
+
+	for _, player := range players {
+		if ctx.Err() != nil {
+			break
+		}
+		playerChan <- player
+	}
+	close(playerChan)
+	wg.Wait()
+	return int(saved.Load()), int(failed.Load())
+}
+
+// scrapePlayer fetches and saves a player's regular season totals, replacing
+// any saved before. Players ESPN has no totals for, because they didn't
+// play, are skipped.
+func (s *SeasonStatScraper) scrapePlayer(ctx context.Context, season int, playerID string) (int, error) {
+	totals, err := s.Client.AthleteStatistics(ctx, season, espn.SeasonTypeRegular, playerID)
+	if espn.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("error fetching season totals: %w", err)
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	queries := s.DB.Queries.WithTx(tx)
+	err = queries.DeletePlayerSeasonStats(ctx, sqlc.DeletePlayerSeasonStatsParams{
+		PlayerID:   playerID,
+		Season:     int64(season),
+		SeasonType: espn.SeasonTypeRegular,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error clearing season totals: %w", err)
+	}
+	saved := 0
+	for _, category := range totals.Splits.Categories {
+		for _, stat := range category.Stats {
+			err := queries.UpsertSeasonStat(ctx, sqlc.UpsertSeasonStatParams{
+				PlayerID:   playerID,
+				Season:     int64(season),
+				SeasonType: espn.SeasonTypeRegular,
+				Category:   category.Name,
+				StatType:   stat.Name,
+				StatValue:  stat.Value,
+			})
+			if err != nil {
+				return 0, fmt.Errorf("error saving %s %s: %w", category.Name, stat.Name, err)
+			}
+			saved++
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return saved, nil
+}
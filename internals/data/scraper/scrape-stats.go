@@ -3,12 +3,8 @@ package scraper
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
-	//"os"
 	"strconv"
 	"strings"
 	"sync"
@@ -16,77 +12,26 @@ import (
 
 	"github.com/Mclazy108/GridironGo/internals/data"
 	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
-	"golang.org/x/time/rate"
+	"github.com/Mclazy108/GridironGo/internals/espn"
 )
 
 // StatScraper handles fetching and storing NFL game statistics
 type StatScraper struct {
-	DB *data.DB
+	DB     *data.DB
+	Client *espn.Client
+
+	backfilled atomic.Int32 // Unknown players fetched while extracting stats
+	unfetched  atomic.Int32 // Unknown players whose details failed to load
 }
 
 // NewStatScraper creates a new scraper for NFL game statistics
-func NewStatScraper(db *data.DB) *StatScraper {
+func NewStatScraper(db *data.DB, client *espn.Client) *StatScraper {
 	return &StatScraper{
-		DB: db,
+		DB:     db,
+		Client: client,
 	}
 }
 
-// ESPNGameSummaryResponse represents the JSON structure from the ESPN summary API
-type ESPNGameSummaryResponse struct {
-	Header struct {
-		ID string `json:"id"`
-	} `json:"header"`
-	Boxscore struct {
-		Teams []struct {
-			Team struct {
-				ID           string `json:"id"`
-				Abbreviation string `json:"abbreviation"`
-			} `json:"team"`
-			Statistics []struct {
-				Name         string   `json:"name"`
-				DisplayName  string   `json:"displayName"`
-				Keys         []string `json:"keys"`
-				Labels       []string `json:"labels"`
-				Descriptions []string `json:"descriptions"`
-			} `json:"statistics"`
-		} `json:"teams"`
-		Players []struct {
-			Team struct {
-				ID string `json:"id"`
-			} `json:"team"`
-			Statistics []struct {
-				Name         string   `json:"name"`
-				Keys         []string `json:"keys"`
-				Labels       []string `json:"labels"`
-				Descriptions []string `json:"descriptions"`
-				Athletes     []struct {
-					Athlete struct {
-						ID          string `json:"id"`
-						DisplayName string `json:"displayName"`
-					} `json:"athlete"`
-					Stats []string `json:"stats"`
-				} `json:"athletes"`
-			} `json:"statistics"`
-		} `json:"players"`
-	} `json:"boxscore"`
-	Leaders []struct {
-		Team struct {
-			ID string `json:"id"`
-		} `json:"team"`
-		Leaders []struct {
-			Name        string `json:"name"`
-			DisplayName string `json:"displayName"`
-			Leaders     []struct {
-				Athlete struct {
-					ID   string `json:"id"`
-					Name string `json:"name"`
-				} `json:"athlete"`
-				Value float64 `json:"value"`
-			} `json:"leaders"`
-		} `json:"leaders"`
-	} `json:"leaders"`
-}
-
 // StatData represents processed statistics ready to be stored
 type StatData struct {
 	GameID    int64
@@ -116,24 +61,26 @@ func (s *StatScraper) ScrapeNFLGameStats(ctx context.Context, seasons []int) err
 	}
 
 	log.Printf("Found %d games across specified seasons. Will fetch game statistics", len(games))
+	games, err := resume(ctx, s.DB, StatsScraper, games, func(game *sqlc.NflGame) data.Job {
+		return gameJob(int(game.Season), game.EventID)
+	})
+	if err != nil {
+		return err
+	}
+	s.ScrapeGames(ctx, games)
+	log.Println("NFL game statistics scraping completed")
+	return nil
+}
 
-	// Debug the API response for the first game
-	/*
-		if len(games) > 0 {
-			err := s.debugAPIResponse(ctx, games[0].EventID)
-			if err != nil {
-				log.Printf("Warning: Failed to debug API response: %v", err)
-			}
-		}
-	*/
-
+// ScrapeGames fetches and stores the statistics for a set of games, returning
+// how many games succeeded. Games that fail are logged and skipped. Each
+// game is done in the job ledger once it's final.
+func (s *StatScraper) ScrapeGames(ctx context.Context, games []*sqlc.NflGame) int {
 	// Track processing statistics
 	var totalStats int32 = 0
 	var processedGames int32 = 0
 	var failedGames int32 = 0
 
-	limiter := rate.NewLimiter(500, 1)
-
 	// Create a wait group to wait for all goroutines to finish
 	var wg sync.WaitGroup
 
@@ -158,7 +105,12 @@ func (s *StatScraper) ScrapeNFLGameStats(ctx context.Context, seasons []int) err
 					log.Printf("Worker %d: Processing game %s (ID: %d)", workerID, game.Name, game.EventID)
 
 					// Process the game statistics
-					statsProcessed, err := s.processGameStats(ctx, *game, limiter)
+					statsProcessed, err := s.processGameStats(ctx, *game)
+					if err == nil && !game.Completed {
+						s.DB.DeferJob(ctx, gameJob(int(game.Season), game.EventID))
+					} else {
+						s.DB.FinishJob(ctx, gameJob(int(game.Season), game.EventID), err)
+					}
 
 					if err != nil {
 						log.Printf("Worker %d: Error processing game %s: %v",
@@ -202,82 +154,61 @@ func (s *StatScraper) ScrapeNFLGameStats(ctx context.Context, seasons []int) err
 	log.Println("Waiting for all game workers to finish...")
 	wg.Wait()
 
-	log.Printf("Processed %d/%d games with %d total stats (%d failed)",
-		atomic.LoadInt32(&processedGames), len(games), atomic.LoadInt32(&totalStats), atomic.LoadInt32(&failedGames))
-	log.Println("NFL game statistics scraping completed")
-	return nil
+	log.Printf("Processed %d/%d games with %d total stats (%d failed), backfilled %d unknown players (%d failed to load)",
+		atomic.LoadInt32(&processedGames), len(games), atomic.LoadInt32(&totalStats), atomic.LoadInt32(&failedGames), s.Backfilled(), s.Unfetched())
+	return int(atomic.LoadInt32(&processedGames))
 }
 
-// debugAPIResponse fetches and saves a complete API response for debugging
-/*
-func (s *StatScraper) debugAPIResponse(ctx context.Context, gameID int64) error {
-	// Construct the API URL for game summary
-	url := fmt.Sprintf("https://site.api.espn.com/apis/site/v2/sports/football/nfl/summary?event=%d", gameID)
-
-	// Create a new request with context
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Set headers to make it look like a browser request
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64)")
-	req.Header.Set("Accept", "application/json")
-
-	// Send HTTP GET request
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		if ctx.Err() != nil {
-			return fmt.Errorf("request cancelled: %w", ctx.Err())
-		}
-		return fmt.Errorf("HTTP request failed: %w", err)
-	}
-	defer resp.Body.Close()
+// Backfilled returns how many players the scraper has fetched because they
+// were in a box score but not the database
+func (s *StatScraper) Backfilled() int {
+	return int(s.backfilled.Load())
+}
 
-	// Check response status
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API returned non-OK status: %d. Response: %s", resp.StatusCode, string(body))
-	}
+// Unfetched returns how many players in box scores the scraper couldn't
+// fetch, leaving their games failed
+func (s *StatScraper) Unfetched() int {
+	return int(s.unfetched.Load())
+}
 
-	// Read the body
-	body, err := io.ReadAll(resp.Body)
+// GamePlayers returns the team of every player in a game's box score, keyed
+// by player ID
+func (s *StatScraper) GamePlayers(ctx context.Context, eventID int64) (map[string]string, error) {
+	summary, err := s.Client.GameSummary(ctx, eventID)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("error fetching summary for game %d: %w", eventID, err)
 	}
 
-	// Save the response to a file for inspection
-	err = os.WriteFile(fmt.Sprintf("game_%d_response.json", gameID), body, 0644)
-	if err != nil {
-		return fmt.Errorf("failed to save response to file: %w", err)
+	players := make(map[string]string)
+	for _, teamPlayers := range summary.Boxscore.Players {
+		for _, statCategory := range teamPlayers.Statistics {
+			for _, athlete := range statCategory.Athletes {
+				if athlete.Athlete.ID != "" {
+					players[athlete.Athlete.ID] = teamPlayers.Team.ID
+				}
+			}
+		}
 	}
-
-	log.Printf("Saved API response for game %d to game_%d_response.json", gameID, gameID)
-	return nil
+	return players, nil
 }
-*/
-// processGameStats fetches and processes statistics for a single game
-func (s *StatScraper) processGameStats(ctx context.Context, game sqlc.NflGame, limiter *rate.Limiter) (int, error) {
-	// Wait for rate limiter
-	if err := limiter.Wait(ctx); err != nil {
-		return 0, fmt.Errorf("rate limiter error: %w", err)
-	}
 
+// processGameStats fetches and processes statistics for a single game
+func (s *StatScraper) processGameStats(ctx context.Context, game sqlc.NflGame) (int, error) {
 	// Fetch game summary from ESPN API
-	gameSummary, err := s.fetchGameSummary(ctx, game.EventID)
+	gameSummary, err := s.Client.GameSummary(ctx, game.EventID)
 	if err != nil {
 		return 0, fmt.Errorf("error fetching summary for game %d: %w", game.EventID, err)
 	}
 
 	// Process the game summary to extract stats
-	stats, err := s.extractGameStats(ctx, gameSummary, game.EventID)
+	stats, unfetched, err := s.extractGameStats(ctx, gameSummary, game.EventID, int(game.Season))
 	if err != nil {
 		return 0, fmt.Errorf("error extracting stats for game %d: %w", game.EventID, err)
 	}
 
 	if len(stats) == 0 {
 		log.Printf("No stats found for game %d (%s)", game.EventID, game.Name)
-		return 0, nil
+		return 0, unfetchedError(unfetched)
 	}
 
 	log.Printf("Extracted %d stats for game %d, saving to database...", len(stats), game.EventID)
@@ -332,74 +263,56 @@ func (s *StatScraper) processGameStats(ctx context.Context, game sqlc.NflGame, l
 		insertCount++
 	}
 
+	// Save the play-by-play along with the box score
+	plays, err := savePlays(ctx, s.DB.Queries.WithTx(tx), gameSummary, game.EventID)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, fmt.Errorf("error saving plays: %w", err)
+	}
+	if plays > 0 {
+		log.Printf("Saved %d plays for game %d", plays, game.EventID)
+	}
+
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
 		return 0, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return insertCount, nil
+	return insertCount, unfetchedError(unfetched)
 }
 
-// fetchGameSummary retrieves game summary data from the ESPN API
-func (s *StatScraper) fetchGameSummary(ctx context.Context, gameID int64) (*ESPNGameSummaryResponse, error) {
-	// Construct the API URL for game summary
-	url := fmt.Sprintf("https://site.api.espn.com/apis/site/v2/sports/football/nfl/summary?event=%d", gameID)
-
-	// Create a new request with context
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// unfetchedError fails a game whose box score has players who couldn't be
+// fetched, so the game is tried again on the next run. The rest of its stats
+// are still saved.
+func unfetchedError(playerIDs []string) error {
+	if len(playerIDs) == 0 {
+		return nil
 	}
-
-	// Set headers to make it look like a browser request
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64)")
-	req.Header.Set("Accept", "application/json")
-
-	// Send HTTP GET request
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		if ctx.Err() != nil {
-			return nil, fmt.Errorf("request cancelled: %w", ctx.Err())
-		}
-		return nil, fmt.Errorf("HTTP request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// Check response status
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned non-OK status: %d. Response: %s", resp.StatusCode, string(body))
-	}
-
-	// Parse the JSON response
-	var summaryResponse ESPNGameSummaryResponse
-	if err := json.NewDecoder(resp.Body).Decode(&summaryResponse); err != nil {
-		return nil, fmt.Errorf("failed to decode JSON response: %w", err)
-	}
-
-	return &summaryResponse, nil
+	return fmt.Errorf("couldn't fetch %d players in the box score, so their stats weren't saved: %s", len(playerIDs), strings.Join(playerIDs, ", "))
 }
 
-// extractGameStats processes a game summary to extract all player statistics
-func (s *StatScraper) extractGameStats(ctx context.Context, summary *ESPNGameSummaryResponse, gameID int64) ([]StatData, error) {
-	log.Printf("Debug: Game ID: %d", gameID)
-
+// extractGameStats processes a game summary to extract all player statistics.
+// Players who aren't in the database, like mid-season signings and practice
+// squad call-ups, are fetched and saved first so their stats aren't lost. It
+// also returns the IDs of the players whose details failed to load, whose
+// stats are left out.
+func (s *StatScraper) extractGameStats(ctx context.Context, summary *espn.GameSummary, gameID int64, season int) ([]StatData, []string, error) {
 	var stats []StatData
 
 	// Verify game exists first
 	var gameExists bool
 	err := s.DB.DB.QueryRowContext(ctx, "SELECT 1 FROM nfl_games WHERE event_id = ?", gameID).Scan(&gameExists)
 	if err != nil && err != sql.ErrNoRows {
-		return nil, fmt.Errorf("error checking if game exists: %w", err)
+		return nil, nil, fmt.Errorf("error checking if game exists: %w", err)
 	}
 	if !gameExists {
-		return nil, fmt.Errorf("game with ID %d does not exist in database", gameID)
+		return nil, nil, fmt.Errorf("game with ID %d does not exist in database", gameID)
 	}
 
 	// Get all valid player IDs and team IDs from database to verify them
 	rows, err := s.DB.DB.QueryContext(ctx, "SELECT player_id FROM nfl_players")
 	if err != nil {
-		return nil, fmt.Errorf("error fetching player IDs: %w", err)
+		return nil, nil, fmt.Errorf("error fetching player IDs: %w", err)
 	}
 	defer rows.Close()
 
@@ -407,18 +320,18 @@ func (s *StatScraper) extractGameStats(ctx context.Context, summary *ESPNGameSum
 	for rows.Next() {
 		var playerID string
 		if err := rows.Scan(&playerID); err != nil {
-			return nil, fmt.Errorf("error scanning player ID: %w", err)
+			return nil, nil, fmt.Errorf("error scanning player ID: %w", err)
 		}
 		validPlayerIDs[playerID] = true
 	}
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating player IDs: %w", err)
+		return nil, nil, fmt.Errorf("error iterating player IDs: %w", err)
 	}
 
 	// Get all valid team IDs
 	rows, err = s.DB.DB.QueryContext(ctx, "SELECT team_id FROM nfl_teams")
 	if err != nil {
-		return nil, fmt.Errorf("error fetching team IDs: %w", err)
+		return nil, nil, fmt.Errorf("error fetching team IDs: %w", err)
 	}
 	defer rows.Close()
 
@@ -426,18 +339,22 @@ func (s *StatScraper) extractGameStats(ctx context.Context, summary *ESPNGameSum
 	for rows.Next() {
 		var teamID string
 		if err := rows.Scan(&teamID); err != nil {
-			return nil, fmt.Errorf("error scanning team ID: %w", err)
+			return nil, nil, fmt.Errorf("error scanning team ID: %w", err)
 		}
 		validTeamIDs[teamID] = true
 	}
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating team IDs: %w", err)
+		return nil, nil, fmt.Errorf("error iterating team IDs: %w", err)
 	}
 
-	// Process boxscore player statistics
+	// Process boxscore player statistics, holding back those of unknown
+	// players until they've been fetched
+	unknownPlayers := make(map[string]string) // Player ID to team ID
+	var unknownStats []StatData
 	for _, teamPlayers := range summary.Boxscore.Players {
 		teamID := teamPlayers.Team.ID
 		if !validTeamIDs[teamID] {
+			log.Printf("Warning: skipping stats for team ID %s in game %d (not found in database, run 'scrape teams')", teamID, gameID)
 			continue
 		}
 
@@ -446,7 +363,7 @@ func (s *StatScraper) extractGameStats(ctx context.Context, summary *ESPNGameSum
 			for keyIndex, key := range statCategory.Keys {
 				for _, athlete := range statCategory.Athletes {
 					playerID := athlete.Athlete.ID
-					if !validPlayerIDs[playerID] {
+					if playerID == "" {
 						continue
 					}
 
@@ -468,70 +385,61 @@ func (s *StatScraper) extractGameStats(ctx context.Context, summary *ESPNGameSum
 						continue
 					}
 
-					stats = append(stats, StatData{
+					stat := StatData{
 						GameID:    gameID,
 						PlayerID:  playerID,
 						TeamID:    teamID,
 						Category:  category,
 						StatType:  key,
 						StatValue: statValue,
-					})
+					}
+					if validPlayerIDs[playerID] {
+						stats = append(stats, stat)
+					} else {
+						unknownPlayers[playerID] = teamID
+						unknownStats = append(unknownStats, stat)
+					}
 				}
 			}
 		}
 	}
 
-	// Process leaders data with the same validation
-	/*
-		for _, teamLeader := range summary.Leaders {
-			teamID := teamLeader.Team.ID
-
-			// Skip if team ID is not valid
-			if !validTeamIDs[teamID] {
-				log.Printf("Warning: skipping leader stats for team ID %s (not found in database)", teamID)
-				continue
+	var unfetched []string
+	if len(unknownPlayers) > 0 {
+		backfilled, failed, err := s.backfillPlayers(ctx, season, unknownPlayers)
+		if err != nil {
+			return nil, nil, err
+		}
+		log.Printf("Backfilled %d of %d unknown players in game %d", len(backfilled), len(unknownPlayers), gameID)
+		for _, stat := range unknownStats {
+			if backfilled[stat.PlayerID] {
+				stats = append(stats, stat)
 			}
+		}
+		unfetched = failed
+	}
 
-			for _, leaderCategory := range teamLeader.Leaders {
-				category := leaderCategory.Name
-				log.Printf("Debug: Leader Category: %s", category)
-
-				for _, leader := range leaderCategory.Leaders {
-					playerID := leader.Athlete.ID
-					log.Printf("Debug: Leader: %s, Value: %f", leader.Athlete.Name, leader.Value)
-
-					// Skip if player ID is not valid
-					if !validPlayerIDs[playerID] {
-						log.Printf("Warning: skipping leader stats for player ID %s (not found in database)", playerID)
-						continue
-					}
-
-					statValue := leader.Value
-
-					// Use DisplayName as stat type if available
-					statType := leaderCategory.DisplayName
-					if statType == "" {
-						statType = category
-					}
-
-					log.Printf("Debug: Extracted leader stat - Player: %s, Category: %s, Type: %s, Value: %f",
-						leader.Athlete.Name, category, statType, statValue)
+	return stats, unfetched, nil
+}
 
-					stats = append(stats, StatData{
-						GameID:    gameID,
-						PlayerID:  playerID,
-						TeamID:    teamID,
-						Category:  category,
-						StatType:  statType,
-						StatValue: statValue,
-					})
-				}
-			}
-		}
-	*/
-	///
+// backfillPlayers fetches and saves players found in a box score who aren't
+// in the database, with a player season for the team they played for. It
+// returns the IDs of the ones saved and of the ones whose details failed to
+// load; players ESPN has no position for are skipped, as they are when
+// scraping rosters.
+func (s *StatScraper) backfillPlayers(ctx context.Context, season int, players map[string]string) (map[string]bool, []string, error) {
+	saved, failed, err := NewPlayerScraper(s.DB, s.Client).refreshPlayers(ctx, season, players)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error backfilling players: %w", err)
+	}
+	s.backfilled.Add(int32(len(saved)))
+	s.unfetched.Add(int32(len(failed)))
 
-	return stats, nil
+	backfilled := make(map[string]bool, len(saved))
+	for _, player := range saved {
+		backfilled[player.PlayerID] = true
+	}
+	return backfilled, failed, nil
 }
 
 // parseStatValue converts a string stat value to a float64
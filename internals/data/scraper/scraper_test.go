@@ -0,0 +1,804 @@
+package scraper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/espn"
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// fixturesDir holds ESPN responses for week 1 of 2024, Steelers at Falcons
+const fixturesDir = "testdata/espn"
+
+// newTestDB creates an empty database in a temporary directory
+func newTestDB(t *testing.T) *data.DB {
+	t.Helper()
+	db, err := data.NewDB(&data.DBConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	if err != nil {
+		t.Fatalf("Error creating database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// replayClient starts a fixture server and returns a client using it
+func replayClient(t *testing.T) (*espn.Client, *espn.FixtureServer) {
+	t.Helper()
+	server := espn.NewFixtureServer(fixturesDir)
+	t.Cleanup(server.Close)
+	return espn.NewClient(server.Config()), server
+}
+
+// scrapeFixtures runs every scraper against the fixtures, in the order the
+// scrape command does
+func scrapeFixtures(t *testing.T, db *data.DB, client *espn.Client) {
+	t.Helper()
+	ctx := context.Background()
+	seasons := []int{2024}
+
+	if err := NewScraper(db, client).ScrapeNFLGames(ctx, seasons); err != nil {
+		t.Fatalf("Error scraping games: %v", err)
+	}
+	if err := NewTeamScraper(db, client).ScrapeNFLTeams(ctx); err != nil {
+		t.Fatalf("Error scraping teams: %v", err)
+	}
+	if err := NewPlayerScraper(db, client).ScrapeNFLPlayers(ctx, seasons); err != nil {
+		t.Fatalf("Error scraping players: %v", err)
+	}
+	if err := NewStatScraper(db, client).ScrapeNFLGameStats(ctx, seasons); err != nil {
+		t.Fatalf("Error scraping stats: %v", err)
+	}
+}
+
+// resetJobs marks every job in the ledger pending, as scrape -fresh does
+func resetJobs(t *testing.T, db *data.DB, seasons []int) {
+	t.Helper()
+	for _, scraper := range []string{GamesScraper, PlayersScraper, StatsScraper, TotalsScraper, InjuriesScraper, DepthScraper} {
+		if _, err := db.ResetJobs(context.Background(), scraper, seasons); err != nil {
+			t.Fatalf("Error resetting %s jobs: %v", scraper, err)
+		}
+	}
+	if _, err := db.ResetJobs(context.Background(), TeamsScraper, []int{SeasonAt(time.Now())}); err != nil {
+		t.Fatalf("Error resetting team jobs: %v", err)
+	}
+}
+
+func TestScrapeFixtures(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	client, server := replayClient(t)
+	scrapeFixtures(t, db, client)
+
+	// Only the scoreboards for weeks without games are missing
+	if misses := server.Misses(); len(misses) != 15 {
+		t.Errorf("Expected 15 requests without fixtures, got %d: %v", len(misses), misses)
+	}
+
+	games, err := db.Queries.GetGamesBySeason(ctx, 2024)
+	if err != nil {
+		t.Fatalf("Error getting games: %v", err)
+	}
+	if len(games) != 2 {
+		t.Fatalf("Expected 2 games, got %d", len(games))
+	}
+	final := games[0]
+	if final.EventID != 401671744 || final.AwayTeam != "Pittsburgh Steelers" || final.HomeTeam != "Atlanta Falcons" ||
+		final.Date != "2024-09-08" || !final.Completed || final.AwayScore.Int64 != 18 || final.HomeScore.Int64 != 10 {
+		t.Errorf("Unexpected week 1 game: %+v", final)
+	}
+	if upcoming := games[1]; upcoming.Completed || upcoming.AwayScore.Valid || upcoming.HomeScore.Valid {
+		t.Errorf("Expected week 2 to have no score yet, got %+v", upcoming)
+	}
+
+	team, err := db.Queries.GetNFLTeam(ctx, "23")
+	if err != nil {
+		t.Fatalf("Error getting the Steelers: %v", err)
+	}
+	if team.Abbreviation != "PIT" || team.DisplayName != "Pittsburgh Steelers" || team.SecondaryColor.String != "ffb612" {
+		t.Errorf("Unexpected team: %+v", team)
+	}
+
+	// The practice squad player has no position, so is skipped
+	players, err := db.Queries.GetAllNFLPlayers(ctx)
+	if err != nil {
+		t.Fatalf("Error getting players: %v", err)
+	}
+	if len(players) != 6 {
+		t.Errorf("Expected 6 players, got %d", len(players))
+	}
+	player, err := db.Queries.GetNFLPlayer(ctx, "4430807")
+	if err != nil {
+		t.Fatalf("Error getting Bijan Robinson: %v", err)
+	}
+	if player.FullName != "Bijan Robinson" || player.Position != "RB" || player.TeamID.String != "1" {
+		t.Errorf("Unexpected player: %+v", player)
+	}
+
+	stats, err := db.Queries.GetStatsByGameAndPlayer(ctx, sqlc.GetStatsByGameAndPlayerParams{GameID: 401671744, PlayerID: "14880"})
+	if err != nil {
+		t.Fatalf("Error getting Kirk Cousins' stats: %v", err)
+	}
+	values := make(map[string]float64)
+	for _, stat := range stats {
+		values[stat.Category+"."+stat.StatType] = stat.StatValue
+	}
+	expected := map[string]float64{
+		"passing.completions/passingAttempts": 16,
+		"passing.passingYards":                155,
+		"passing.passingTouchdowns":           1,
+		"passing.interceptions":               2,
+		"rushing.rushingAttempts":             1,
+	}
+	for key, value := range expected {
+		if values[key] != value {
+			t.Errorf("Expected %s to be %v, got %v", key, value, values[key])
+		}
+	}
+	if _, ok := values["rushing.rushingYards"]; ok {
+		t.Errorf("Expected a -- stat to be skipped")
+	}
+
+	// A player in the box score who wasn't on a roster is fetched, with a
+	// season on the team they played for, and their stats are kept
+	backfilled, err := db.Queries.GetNFLPlayer(ctx, "4428331")
+	if err != nil {
+		t.Fatalf("Error getting KhaDarel Hodge: %v", err)
+	}
+	if backfilled.Position != "WR" || backfilled.TeamID.String != "1" {
+		t.Errorf("Unexpected backfilled player: %+v", backfilled)
+	}
+	playerSeasons, err := db.Queries.GetPlayerSeasonsByPlayer(ctx, "4428331")
+	if err != nil || len(playerSeasons) != 1 || playerSeasons[0].SeasonYear != 2024 {
+		t.Errorf("Expected a 2024 season for the backfilled player, got %+v (%v)", playerSeasons, err)
+	}
+	hodge, err := db.Queries.GetStatsByPlayer(ctx, "4428331")
+	if err != nil {
+		t.Fatalf("Error getting stats: %v", err)
+	}
+	if len(hodge) == 0 {
+		t.Errorf("Expected stats for the backfilled player")
+	}
+
+	// Players ESPN has no position for are skipped
+	unknown, err := db.Queries.GetStatsByPlayer(ctx, "3915416")
+	if err != nil {
+		t.Fatalf("Error getting stats: %v", err)
+	}
+	if len(unknown) != 0 {
+		t.Errorf("Expected no stats for a player who wasn't scraped, got %d", len(unknown))
+	}
+}
+
+func TestScrapeStatsUnfetchedPlayer(t *testing.T) {
+	ctx := context.Background()
+
+	// Serve the fixtures without the details of a player in the box score
+	dir := t.TempDir()
+	if err := os.CopyFS(dir, os.DirFS(fixturesDir)); err != nil {
+		t.Fatalf("Error copying fixtures: %v", err)
+	}
+	athlete := filepath.Join(dir, "core", "athletes", "3915416.json")
+	fixture, err := os.ReadFile(athlete)
+	if err != nil {
+		t.Fatalf("Error reading fixture: %v", err)
+	}
+	if err := os.Remove(athlete); err != nil {
+		t.Fatalf("Error removing fixture: %v", err)
+	}
+	server := espn.NewFixtureServer(dir)
+	defer server.Close()
+	client := espn.NewClient(server.Config())
+	db := newTestDB(t)
+	if err := NewScraper(db, client).ScrapeNFLGames(ctx, []int{2024}); err != nil {
+		t.Fatalf("Error scraping games: %v", err)
+	}
+	if err := NewTeamScraper(db, client).ScrapeNFLTeams(ctx); err != nil {
+		t.Fatalf("Error scraping teams: %v", err)
+	}
+	if err := NewPlayerScraper(db, client).ScrapeNFLPlayers(ctx, []int{2024}); err != nil {
+		t.Fatalf("Error scraping players: %v", err)
+	}
+
+	// The rest of the game is saved, but it's left failed and the player is
+	// reported
+	stats := NewStatScraper(db, client)
+	if err := stats.ScrapeNFLGameStats(ctx, []int{2024}); err != nil {
+		t.Fatalf("Error scraping stats: %v", err)
+	}
+	if stats.Unfetched() != 1 {
+		t.Errorf("Expected 1 player that couldn't be fetched, got %d", stats.Unfetched())
+	}
+	job := gameJob(2024, 401671744)
+	statuses, err := db.JobStatuses(ctx, []data.Job{job})
+	if err != nil {
+		t.Fatalf("Error getting job statuses: %v", err)
+	}
+	if status := statuses[job]; status == nil || status.Status != data.JobFailed || !strings.Contains(status.Error.String, "3915416") {
+		t.Errorf("Expected the game to fail naming the player, got %+v", status)
+	}
+	if cousins, err := db.Queries.GetStatsByPlayer(ctx, "14880"); err != nil || len(cousins) == 0 {
+		t.Errorf("Expected the known players' stats to be saved, got %d (%v)", len(cousins), err)
+	}
+
+	// Once the player loads the game is done
+	if err := os.WriteFile(athlete, fixture, 0o644); err != nil {
+		t.Fatalf("Error restoring fixture: %v", err)
+	}
+	if err := NewStatScraper(db, client).ScrapeNFLGameStats(ctx, []int{2024}); err != nil {
+		t.Fatalf("Error resuming stats: %v", err)
+	}
+	statuses, err = db.JobStatuses(ctx, []data.Job{job})
+	if err != nil {
+		t.Fatalf("Error getting job statuses: %v", err)
+	}
+	if status := statuses[job]; status == nil || status.Status != data.JobDone {
+		t.Errorf("Expected the game to be done once the player loads, got %+v", status)
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+
+	// Recording a scrape of the fixtures reproduces them
+	client, _ := replayClient(t)
+	config := client.Config()
+	config.RecordDir = t.TempDir()
+	recorder := espn.NewClient(config)
+	scrapeFixtures(t, newTestDB(t), recorder)
+
+	// Replaying the recording scrapes the same data
+	server := espn.NewFixtureServer(config.RecordDir)
+	defer server.Close()
+	db := newTestDB(t)
+	scrapeFixtures(t, db, espn.NewClient(server.Config()))
+
+	stats, err := db.Queries.GetStatsByGame(ctx, 401671744)
+	if err != nil {
+		t.Fatalf("Error getting stats: %v", err)
+	}
+	original := newTestDB(t)
+	scrapeFixtures(t, original, client)
+	expected, err := original.Queries.GetStatsByGame(ctx, 401671744)
+	if err != nil {
+		t.Fatalf("Error getting stats: %v", err)
+	}
+	if len(stats) == 0 || len(stats) != len(expected) {
+		t.Errorf("Expected the replay to load %d stats, got %d", len(expected), len(stats))
+	}
+}
+
+func TestScrapeCached(t *testing.T) {
+	db := newTestDB(t)
+	_, server := replayClient(t)
+	config := server.Config()
+	config.Cache = data.NewResponseCache(db)
+	scrapeFixtures(t, db, espn.NewClient(config))
+
+	// A second scrape of everything only asks for the weeks that weren't found
+	resetJobs(t, db, []int{2024})
+	client := espn.NewClient(config)
+	scrapeFixtures(t, db, client)
+	if metrics := client.Metrics(); metrics.CacheHits != 18 || metrics.Requests != 15 {
+		t.Errorf("Expected 18 cached responses and 15 requests, got %+v", metrics)
+	}
+
+	ctx := context.Background()
+	cached, err := config.Cache.Get(ctx, config.SiteURL+"/summary?event=401671744")
+	if err != nil || cached == nil {
+		t.Fatalf("Expected the week 1 summary to be cached, got %v", err)
+	}
+	if !cached.ExpiresAt.IsZero() || cached.Endpoint != espn.EndpointSummary {
+		t.Errorf("Expected a final game's summary never to expire, got %+v", cached)
+	}
+	upcoming, err := config.Cache.Get(ctx, config.SiteURL+"/summary?event=401671800")
+	if err != nil || upcoming == nil || upcoming.ExpiresAt.IsZero() {
+		t.Errorf("Expected an upcoming game's summary to expire, got %+v (%v)", upcoming, err)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	client, _ := replayClient(t)
+	if err := NewScraper(db, client).ScrapeNFLGames(ctx, []int{2024}); err != nil {
+		t.Fatalf("Error scraping games: %v", err)
+	}
+	if err := NewTeamScraper(db, client).ScrapeNFLTeams(ctx); err != nil {
+		t.Fatalf("Error scraping teams: %v", err)
+	}
+
+	// Before week 1 is played, it's the current week
+	updater := NewUpdater(db, client)
+	updater.Now = func() time.Time { return time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC) }
+	week, err := updater.CurrentWeek(ctx, 2024)
+	if err != nil || week.Week != 1 {
+		t.Errorf("Expected week 1 before the season, got %+v (%v)", week, err)
+	}
+
+	// The first update loads the final game's stats, and the players in it
+	// even though players were never scraped
+	updater.Now = func() time.Time { return time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC) }
+	result, err := updater.Update(ctx, UpdateOptions{})
+	if err != nil {
+		t.Fatalf("Error updating: %v", err)
+	}
+	if result.Season != 2024 || result.Week != 1 || result.FinalGames != 1 || result.Players != 6 || result.LastSync != "" {
+		t.Errorf("Unexpected first update: %+v", result)
+	}
+	// Week 1 is final, so the injury report and depth charts are for week 2
+	if result.ReportWeek != 2 || result.Injuries != 3 || result.DepthCharts != 7 {
+		t.Errorf("Expected 3 week 2 injury designations and 7 depth chart entries, got %d and %d for week %d",
+			result.Injuries, result.DepthCharts, result.ReportWeek)
+	}
+	stats, err := db.Queries.GetStatsByGameAndPlayer(ctx, sqlc.GetStatsByGameAndPlayerParams{GameID: 401671744, PlayerID: "14880"})
+	if err != nil || len(stats) == 0 {
+		t.Errorf("Expected Kirk Cousins' stats to be loaded, got %d (%v)", len(stats), err)
+	}
+
+	// The next one has nothing new to load
+	result, err = updater.Update(ctx, UpdateOptions{})
+	if err != nil {
+		t.Fatalf("Error updating: %v", err)
+	}
+	if result.FinalGames != 0 || result.Players != 0 || result.LastSync == "" {
+		t.Errorf("Expected nothing to update since the last sync, got %+v", result)
+	}
+
+	runs, err := db.Queries.ListScrapeRuns(ctx, 10)
+	if err != nil {
+		t.Fatalf("Error listing runs: %v", err)
+	}
+	if len(runs) != 2 || runs[0].Status != data.RunSucceeded || runs[0].Week.Int64 != 1 || runs[0].Seasons != "2024" {
+		t.Errorf("Expected 2 successful update runs for 2024 week 1, got %+v", runs)
+	}
+}
+
+func TestScrapeInjuries(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	client, _ := replayClient(t)
+	if err := NewScraper(db, client).ScrapeNFLGames(ctx, []int{2024}); err != nil {
+		t.Fatalf("Error scraping games: %v", err)
+	}
+	if err := NewTeamScraper(db, client).ScrapeNFLTeams(ctx); err != nil {
+		t.Fatalf("Error scraping teams: %v", err)
+	}
+	if err := NewPlayerScraper(db, client).ScrapeNFLPlayers(ctx, []int{2024}); err != nil {
+		t.Fatalf("Error scraping players: %v", err)
+	}
+
+	// Active players are left off, and the practice squad player ESPN has no
+	// position for is skipped
+	saved, err := NewInjuryScraper(db, client).ScrapeInjuries(ctx, 2024, 2)
+	if err != nil {
+		t.Fatalf("Error scraping injuries: %v", err)
+	}
+	if saved != 3 {
+		t.Errorf("Expected 3 injury designations, got %d", saved)
+	}
+
+	injuries, err := league.LoadInjuries(ctx, db.Queries, 2024, 3)
+	if err != nil {
+		t.Fatalf("Error loading injuries: %v", err)
+	}
+	london := injuries["4426502"]
+	if london.Status != league.InjuryQuestionable || london.BodyPart != "Hamstring" || london.Date != "2024-09-12" || london.Week != 2 {
+		t.Errorf("Unexpected injury for Drake London: %+v", london)
+	}
+	if !injuries.Unavailable("4241457") || injuries.Unavailable("4426502") || injuries.Unavailable("4362887") {
+		t.Errorf("Expected only Najee Harris and the injured reserve player to be unavailable, got %+v", injuries)
+	}
+
+	// The player on injured reserve wasn't on a roster, so is fetched first
+	hodge := injuries["4428331"]
+	if hodge.Status != league.InjuryIR {
+		t.Errorf("Expected KhaDarel Hodge on injured reserve, got %+v", hodge)
+	}
+	if _, err := db.Queries.GetNFLPlayer(ctx, "4428331"); err != nil {
+		t.Errorf("Expected the injured player to be backfilled: %v", err)
+	}
+
+	// There's no report before the first one scraped
+	if injuries, err := league.LoadInjuries(ctx, db.Queries, 2024, 1); err != nil || len(injuries) != 0 {
+		t.Errorf("Expected no week 1 injuries, got %+v (%v)", injuries, err)
+	}
+
+	// An empty week 3 report clears ATL's injuries, while PIT's report didn't
+	// load, so its week 2 one still applies
+	err = db.Queries.SaveInjuryReport(ctx, sqlc.SaveInjuryReportParams{Season: 2024, Week: 3, TeamID: "1"})
+	if err != nil {
+		t.Fatalf("Error saving the ATL report: %v", err)
+	}
+	injuries, err = league.LoadInjuries(ctx, db.Queries, 2024, 3)
+	if err != nil {
+		t.Fatalf("Error loading injuries: %v", err)
+	}
+	if _, ok := injuries["4426502"]; ok {
+		t.Errorf("Expected Drake London off the week 3 report, got %+v", injuries)
+	}
+	if najee := injuries["4241457"]; najee.Status != league.InjuryOut || najee.Week != 2 {
+		t.Errorf("Expected Najee Harris out from the week 2 report, got %+v", najee)
+	}
+
+	// Week 2's game is still to be played, so its reports can change, while
+	// week 1's are saved for good
+	if _, err := NewInjuryScraper(db, client).ScrapeInjuries(ctx, 2024, 1); err != nil {
+		t.Fatalf("Error scraping week 1 injuries: %v", err)
+	}
+	statuses, err := db.JobStatuses(ctx, []data.Job{injuryJob(2024, 1), injuryJob(2024, 2)})
+	if err != nil {
+		t.Fatalf("Error getting job statuses: %v", err)
+	}
+	if week1, week2 := statuses[injuryJob(2024, 1)], statuses[injuryJob(2024, 2)]; week1 == nil || week1.Status != data.JobDone || week2 == nil || week2.Status != data.JobPending {
+		t.Errorf("Expected week 1 done and week 2 pending, got %+v and %+v", week1, week2)
+	}
+	if saved, err := NewInjuryScraper(db, client).ScrapeInjuries(ctx, 2024, 1); err != nil || saved != 0 {
+		t.Errorf("Expected week 1 to be skipped, saved %d (%v)", saved, err)
+	}
+}
+
+func TestScrapeDepthCharts(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	client, _ := replayClient(t)
+	if err := NewScraper(db, client).ScrapeNFLGames(ctx, []int{2024}); err != nil {
+		t.Fatalf("Error scraping games: %v", err)
+	}
+	if err := NewTeamScraper(db, client).ScrapeNFLTeams(ctx); err != nil {
+		t.Fatalf("Error scraping teams: %v", err)
+	}
+	if err := NewPlayerScraper(db, client).ScrapeNFLPlayers(ctx, []int{2024}); err != nil {
+		t.Fatalf("Error scraping players: %v", err)
+	}
+
+	// Charts are saved for the upcoming week, and the practice squad player
+	// ESPN has no position for is skipped
+	if err := NewDepthChartScraper(db, client).ScrapeNFLDepthCharts(ctx, []int{2024}); err != nil {
+		t.Fatalf("Error scraping depth charts: %v", err)
+	}
+	chart, err := db.Queries.GetTeamDepthChart(ctx, sqlc.GetTeamDepthChartParams{TeamID: "1", Season: 2024, Week: 2})
+	if err != nil {
+		t.Fatalf("Error getting the ATL depth chart: %v", err)
+	}
+	if len(chart) != 4 {
+		t.Errorf("Expected 4 ATL depth chart entries, got %d: %+v", len(chart), chart)
+	}
+
+	// The backup receiver wasn't on a roster, so is fetched first
+	depths, err := league.LoadDepthChart(ctx, db.Queries, 2024, 3)
+	if err != nil {
+		t.Fatalf("Error loading depth charts: %v", err)
+	}
+	if depth := depths.Depth("4428331", "WR"); depth != 2 {
+		t.Errorf("Expected KhaDarel Hodge to be WR2, got %d", depth)
+	}
+	if _, err := db.Queries.GetNFLPlayer(ctx, "4428331"); err != nil {
+		t.Errorf("Expected the backup receiver to be backfilled: %v", err)
+	}
+
+	// Najee Harris is the starting running back and the kick returner, but
+	// only the running back slot counts at his position
+	positions, err := db.Queries.GetPlayerDepth(ctx, sqlc.GetPlayerDepthParams{Season: 2024, Week: 2, PlayerID: "4241457"})
+	if err != nil || len(positions) != 2 {
+		t.Fatalf("Expected Najee Harris on 2 slots, got %+v (%v)", positions, err)
+	}
+	if depth := depths.Depth("4241457", "RB"); depth != 1 {
+		t.Errorf("Expected Najee Harris to be RB1, got %d", depth)
+	}
+	if depth := depths.Depth("4241457", "WR"); depth != 0 {
+		t.Errorf("Expected Najee Harris not to be on the WR chart, got %d", depth)
+	}
+
+	// There's no chart before the first one scraped
+	if depths, err := league.LoadDepthChart(ctx, db.Queries, 2024, 1); err != nil || len(depths) != 0 {
+		t.Errorf("Expected no week 1 depth charts, got %+v (%v)", depths, err)
+	}
+}
+
+func TestReconcileSeasonStats(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	client, _ := replayClient(t)
+	scrapeFixtures(t, db, client)
+
+	// Players without totals, who get a 404, are skipped rather than failed
+	if err := NewSeasonStatScraper(db, client).ScrapeNFLSeasonStats(ctx, []int{2024}); err != nil {
+		t.Fatalf("Error scraping season totals: %v", err)
+	}
+	totals, err := db.Queries.GetPlayerSeasonStats(ctx, sqlc.GetPlayerSeasonStatsParams{PlayerID: "14880", Season: 2024, SeasonType: espn.SeasonTypeRegular})
+	if err != nil || len(totals) != 13 {
+		t.Fatalf("Expected 13 season totals for Kirk Cousins, got %d (%v)", len(totals), err)
+	}
+
+	// Bijan Robinson's second game is missing, and Drake London has a target
+	// his box score doesn't. Kirk Cousins' and Justin Fields' totals match.
+	report, err := ReconcileSeasonStats(ctx, db, 2024)
+	if err != nil {
+		t.Fatalf("Error reconciling season totals: %v", err)
+	}
+	if len(report) != 2 {
+		t.Fatalf("Expected 2 players with mismatched totals, got %d: %+v", len(report), report)
+	}
+	london, bijan := report[0], report[1]
+	if london.PlayerID != "4426502" || london.MissingGames() || len(london.Mismatches) != 1 {
+		t.Errorf("Expected Drake London to have every game and one mismatch, got %+v", london)
+	} else if mismatch := london.Mismatches[0]; mismatch.StatType != "receivingTargets" || mismatch.Season != 9 || mismatch.Games != 8 {
+		t.Errorf("Expected Drake London's targets not to match, got %+v", mismatch)
+	}
+	if bijan.PlayerID != "4430807" || !bijan.MissingGames() || bijan.GamesPlayed != 2 || bijan.BoxScores != 1 || bijan.Name != "Bijan Robinson" {
+		t.Errorf("Expected Bijan Robinson to be missing a game, got %+v", bijan)
+	}
+	if len(bijan.Mismatches) != 6 {
+		t.Errorf("Expected 6 mismatches for Bijan Robinson, got %+v", bijan.Mismatches)
+	}
+}
+
+func TestScrapePlays(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	client, _ := replayClient(t)
+	scrapeFixtures(t, db, client)
+
+	// The timeout isn't a play, and players who aren't in the database are
+	// left off the plays they were in
+	drives, err := db.Queries.GetGameDrives(ctx, 401671744)
+	if err != nil || len(drives) != 3 {
+		t.Fatalf("Expected 3 drives, got %d (%v)", len(drives), err)
+	}
+	if drive := drives[1]; drive.TeamID != "1" || drive.Result != "Touchdown" || !drive.Scoring || drive.YardsToEndzone != 75 {
+		t.Errorf("Unexpected second drive: %+v", drive)
+	}
+	plays, err := db.Queries.GetGamePlays(ctx, 401671744)
+	if err != nil || len(plays) != 16 {
+		t.Fatalf("Expected 16 plays, got %d (%v)", len(plays), err)
+	}
+	fg := plays[6]
+	if fg.PlayType != league.PlayFieldGoal || fg.Result != league.ResultGood || fg.Yards != 60 || !fg.Scoring || fg.Down.Int64 != 4 {
+		t.Errorf("Expected a made 60 yard field goal on fourth down, got %+v", fg)
+	}
+	if kickoff := plays[7]; kickoff.PlayType != league.PlayKickoff || kickoff.Down.Valid {
+		t.Errorf("Expected a kickoff without a down, got %+v", kickoff)
+	}
+	players, err := db.Queries.GetGamePlayPlayers(ctx, 401671744)
+	if err != nil || len(players) != 18 {
+		t.Errorf("Expected 18 players in plays, got %d (%v)", len(players), err)
+	}
+
+	// Drake London was thrown to three times out of the five passes Atlanta
+	// threw, once in the red zone for his touchdown
+	splits, err := league.LoadPlaySplits(ctx, db.Queries, "4426502", 2024)
+	if err != nil {
+		t.Fatalf("Error loading splits: %v", err)
+	}
+	if splits.Targets != 3 || splits.Receptions != 2 || splits.ReceivingYards != 30 || splits.ReceivingTouchdowns != 1 ||
+		splits.RedZoneTargets != 1 || splits.TeamPassAttempts != 5 || splits.TargetShare() != 0.6 {
+		t.Errorf("Unexpected splits for Drake London: %+v", splits)
+	}
+	cousins, err := league.LoadPlaySplits(ctx, db.Queries, "14880", 2024)
+	if err != nil {
+		t.Fatalf("Error loading splits: %v", err)
+	}
+	if cousins.PassAttempts != 5 || cousins.Completions != 3 || cousins.PassingYards != 41 || cousins.PassingTouchdowns != 1 || cousins.Interceptions != 1 {
+		t.Errorf("Unexpected splits for Kirk Cousins: %+v", cousins)
+	}
+
+	// Scraping the game again replaces its plays
+	if n := NewStatScraper(db, client).ScrapeGames(ctx, []*sqlc.NflGame{{EventID: 401671744, Season: 2024, Name: "PIT @ ATL", Completed: true}}); n != 1 {
+		t.Fatalf("Expected the game to be scraped again, got %d", n)
+	}
+	if plays, err := db.Queries.GetGamePlays(ctx, 401671744); err != nil || len(plays) != 16 {
+		t.Errorf("Expected 16 plays after scraping again, got %d (%v)", len(plays), err)
+	}
+}
+
+func TestScorePlayBonuses(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	client, _ := replayClient(t)
+	scrapeFixtures(t, db, client)
+
+	rules, err := league.PresetRules("bonus")
+	if err != nil {
+		t.Fatalf("Error getting bonus preset: %v", err)
+	}
+	scorer := league.NewScorer(db.Queries, rules)
+	before, err := scorer.GamePoints(ctx, "4430807", 401671744)
+	if err != nil {
+		t.Fatalf("Error scoring game: %v", err)
+	}
+	standard := league.NewScorer(db.Queries, league.DefaultRules())
+	standardBefore, err := standard.GamePoints(ctx, "4430807", 401671744)
+	if err != nil {
+		t.Fatalf("Error scoring game: %v", err)
+	}
+
+	// Give Bijan Robinson a 45 yard touchdown run and a two-point conversion
+	playType, result := playType("Two Point Rush", "TWO-POINT CONVERSION ATTEMPT. B.Robinson rushes up the middle. ATTEMPT SUCCEEDS.")
+	plays := []sqlc.CreatePlayParams{
+		{PlayID: "40167174498", PlayType: league.PlayRush, EspnType: "Rushing Touchdown", YardsToEndzone: 45, Yards: 45, Scoring: true},
+		{PlayID: "40167174499", PlayType: playType, Result: result, EspnType: "Two Point Rush", YardsToEndzone: 2, Scoring: true},
+	}
+	for i, play := range plays {
+		play.GameID, play.DriveID, play.Sequence, play.TeamID, play.Period = 401671744, "4016717442", int64(98+i), "1", 2
+		if err := db.Queries.CreatePlay(ctx, play); err != nil {
+			t.Fatalf("Error saving play: %v", err)
+		}
+		if err := db.Queries.AddPlayPlayer(ctx, sqlc.AddPlayPlayerParams{PlayID: play.PlayID, PlayerID: "4430807", Role: league.RoleRusher}); err != nil {
+			t.Fatalf("Error saving play player: %v", err)
+		}
+	}
+
+	// 2 points for a 40+ yard rushing touchdown and 2 for the conversion
+	after, err := scorer.GamePoints(ctx, "4430807", 401671744)
+	if err != nil || after-before != 4 {
+		t.Errorf("Expected 4 bonus points, got %.2f (%v)", after-before, err)
+	}
+	if week, err := scorer.WeekPoints(ctx, "4430807", 2024, 1); err != nil || week != after {
+		t.Errorf("Expected %.2f week points, got %.2f (%v)", after, week, err)
+	}
+	if season, err := scorer.SeasonPoints(ctx, "4430807", 2024); err != nil || season != after {
+		t.Errorf("Expected %.2f season points, got %.2f (%v)", after, season, err)
+	}
+	scores, err := scorer.WeekScores(ctx, 2024, 1)
+	if err != nil || scores["4430807"] == nil || scores["4430807"].Points != after {
+		t.Errorf("Expected %.2f on the week's scoreboard, got %+v (%v)", after, scores["4430807"], err)
+	}
+
+	// Rules without play bonuses aren't affected
+	if points, err := standard.GamePoints(ctx, "4430807", 401671744); err != nil || points != standardBefore {
+		t.Errorf("Expected %.2f points under default rules, got %.2f (%v)", standardBefore, points, err)
+	}
+}
+
+func TestScoreFieldGoalDistances(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	client, _ := replayClient(t)
+	scrapeFixtures(t, db, client)
+
+	// Give Chris Boswell a 52 yard field goal in the box score and the plays
+	err := db.Queries.CreateNFLPlayer(ctx, sqlc.CreateNFLPlayerParams{
+		PlayerID: "3124679", FirstName: "Chris", LastName: "Boswell", FullName: "Chris Boswell", Position: "K", Active: true,
+	})
+	if err != nil {
+		t.Fatalf("Error saving player: %v", err)
+	}
+	err = db.Queries.CreateNFLStat(ctx, sqlc.CreateNFLStatParams{
+		GameID: 401671744, PlayerID: "3124679", TeamID: "23", Category: "kicking", StatType: "fieldGoalsMade/fieldGoalAttempts", StatValue: 1,
+	})
+	if err != nil {
+		t.Fatalf("Error saving stat: %v", err)
+	}
+
+	scorer := league.NewScorer(db.Queries, league.DefaultRules())
+	boxScore, err := scorer.GamePoints(ctx, "3124679", 401671744)
+	if err != nil || boxScore != 3 {
+		t.Errorf("Expected the made field goal at the shortest range's 3 points, got %.2f (%v)", boxScore, err)
+	}
+
+	play := sqlc.CreatePlayParams{
+		PlayID: "40167174497", GameID: 401671744, DriveID: "4016717441", Sequence: 97, TeamID: "23", Period: 2,
+		PlayType: league.PlayFieldGoal, Result: league.ResultGood, EspnType: "Field Goal Good", YardsToEndzone: 35, Yards: 52, Scoring: true,
+	}
+	if err := db.Queries.CreatePlay(ctx, play); err != nil {
+		t.Fatalf("Error saving play: %v", err)
+	}
+	if err := db.Queries.AddPlayPlayer(ctx, sqlc.AddPlayPlayerParams{PlayID: play.PlayID, PlayerID: "3124679", Role: league.RoleKicker}); err != nil {
+		t.Fatalf("Error saving play player: %v", err)
+	}
+
+	// The stored play scores the kick at the 50+ value instead
+	points, err := scorer.GamePoints(ctx, "3124679", 401671744)
+	if err != nil || points != 5 {
+		t.Errorf("Expected 5 points for a 52 yard field goal, got %.2f (%v)", points, err)
+	}
+	if season, err := scorer.SeasonPoints(ctx, "3124679", 2024); err != nil || season != points {
+		t.Errorf("Expected %.2f season points, got %.2f (%v)", points, season, err)
+	}
+	scores, err := scorer.WeekScores(ctx, 2024, 1)
+	if err != nil || scores["3124679"] == nil || scores["3124679"].Points != points {
+		t.Errorf("Expected %.2f on the week's scoreboard, got %+v (%v)", points, scores["3124679"], err)
+	}
+}
+
+func TestSeasonAt(t *testing.T) {
+	tests := map[time.Time]int{
+		time.Date(2024, 9, 8, 0, 0, 0, 0, time.UTC):  2024,
+		time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC): 2024,
+		time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC):  2025,
+	}
+	for date, expected := range tests {
+		if got := SeasonAt(date); got != expected {
+			t.Errorf("Expected %s to be in the %d season, got %d", date.Format("2006-01-02"), expected, got)
+		}
+	}
+}
+
+func TestResumeScrape(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	client, _ := replayClient(t)
+	scrapeFixtures(t, db, client)
+
+	// Weeks without fixtures failed, the empty week 18 of a finished season is
+	// done, and the upcoming week 2 game is pending
+	jobs, err := Jobs(ctx, db, []int{2024})
+	if err != nil {
+		t.Fatalf("Error listing jobs: %v", err)
+	}
+	statuses, err := db.JobStatuses(ctx, jobs)
+	if err != nil {
+		t.Fatalf("Error getting job statuses: %v", err)
+	}
+	counts := make(map[string]int)
+	for _, job := range jobs {
+		if status := statuses[job]; status != nil {
+			counts[job.Scraper+" "+status.Status]++
+		}
+	}
+	expected := map[string]int{
+		"games done":    2,
+		"games pending": 1,
+		"games failed":  15,
+		"players done":  2,
+		"stats done":    1,
+		"stats pending": 1,
+	}
+	// The totals and depth chart scrapers haven't run, so their jobs for the 6
+	// players and the last week with games have no status
+	if len(jobs) != 29 || len(counts) != len(expected) {
+		t.Errorf("Expected 29 jobs with statuses %v, got %d with %v", expected, len(jobs), counts)
+	}
+	for key, count := range expected {
+		if counts[key] != count {
+			t.Errorf("Expected %d %s jobs, got %d", count, key, counts[key])
+		}
+	}
+	failed := statuses[weekJob(2024, 3)]
+	if failed == nil || !failed.Error.Valid || failed.Attempts != 1 {
+		t.Errorf("Expected week 3 to have failed with an error, got %+v", failed)
+	}
+
+	// Teams have jobs in the season being played
+	season := SeasonAt(time.Now())
+	teams, err := db.JobStatuses(ctx, []data.Job{teamJob(season, "23"), teamJob(season, "1")})
+	if err != nil {
+		t.Fatalf("Error getting team job statuses: %v", err)
+	}
+	if len(teams) != 2 {
+		t.Errorf("Expected both teams to have jobs, got %v", teams)
+	}
+	for job, status := range teams {
+		if status.Status != data.JobDone {
+			t.Errorf("Expected the %s job to be done, got %+v", job.Unit, status)
+		}
+	}
+
+	// The next scrape only asks for the failed weeks, the upcoming week and
+	// game, and the team list
+	client, _ = replayClient(t)
+	scrapeFixtures(t, db, client)
+	if metrics := client.Metrics(); metrics.Requests != 19 {
+		t.Errorf("Expected 19 requests resuming the scrape, got %+v", metrics)
+	}
+	statuses, err = db.JobStatuses(ctx, jobs)
+	if err != nil {
+		t.Fatalf("Error getting job statuses: %v", err)
+	}
+	if failed := statuses[weekJob(2024, 3)]; failed.Attempts != 2 {
+		t.Errorf("Expected week 3 to have been retried, got %+v", failed)
+	}
+	if empty := statuses[weekJob(2024, 18)]; empty.Status != data.JobDone || empty.Attempts != 1 {
+		t.Errorf("Expected the empty week 18 not to be scraped again, got %+v", empty)
+	}
+	if done := statuses[gameJob(2024, 401671744)]; done.Attempts != 1 {
+		t.Errorf("Expected the final game not to be scraped again, got %+v", done)
+	}
+}
@@ -0,0 +1,323 @@
+package scraper
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/espn"
+)
+
+// UpdateCommand is how update runs are recorded in scrape_runs
+const UpdateCommand = "update"
+
+// Updater brings the current week up to date during the season: its
+// scoreboard, the stats of games that went final since the last update and
+// the players who played in them
+type Updater struct {
+	DB     *data.DB
+	Client *espn.Client
+	Now    func() time.Time // Defaults to time.Now
+}
+
+// NewUpdater creates an updater
+func NewUpdater(db *data.DB, client *espn.Client) *Updater {
+	return &Updater{
+		DB:     db,
+		Client: client,
+		Now:    time.Now,
+	}
+}
+
+// UpdateOptions picks the week to update. Unset fields are worked out from
+// the dates of the scraped games.
+type UpdateOptions struct {
+	Season int
+	Week   int
+}
+
+// UpdateResult summarizes an update
+type UpdateResult struct {
+	Season      int
+	Week        int
+	Weeks       []int  // Weeks whose scoreboards were refreshed
+	Games       int    // Games saved from those scoreboards
+	FinalGames  int    // Final games whose stats were loaded
+	Players     int    // Players refreshed from those games' box scores
+	Failed      int    // Final games whose stats failed to load
+	ReportWeek  int    // Week the injury report and depth charts were saved for, or 0 if they weren't scraped
+	Injuries    int    // Injury designations saved
+	DepthCharts int    // Depth chart entries saved
+	LastSync    string // When the previous update finished, or "" if there wasn't one
+}
+
+// String summarizes the update on one line
+func (r *UpdateResult) String() string {
+	weeks := make([]string, len(r.Weeks))
+	for i, week := range r.Weeks {
+		weeks[i] = strconv.Itoa(week)
+	}
+	summary := fmt.Sprintf("%d week %d: refreshed weeks %s (%d games), loaded stats for %d final games, refreshed %d players",
+		r.Season, r.Week, strings.Join(weeks, ","), r.Games, r.FinalGames, r.Players)
+	if r.ReportWeek > 0 {
+		summary += fmt.Sprintf(", saved %d week %d injuries and %d depth chart entries", r.Injuries, r.ReportWeek, r.DepthCharts)
+	}
+	return summary
+}
+
+// Update refreshes the scoreboards from the week the last update reached
+// through the current week, then loads stats for final games that don't have
+// them and refreshes the players in those games. Updates of the week being
+// played also save the injury report and depth charts for the week's games.
+// The run is recorded in scrape_runs.
+func (u *Updater) Update(ctx context.Context, options UpdateOptions) (*UpdateResult, error) {
+	last, err := u.DB.LastSuccessfulRun(ctx, UpdateCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	season, week := options.Season, options.Week
+	if season == 0 || week == 0 {
+		current, err := u.CurrentWeek(ctx, season)
+		if err != nil {
+			return nil, err
+		}
+		season, week = current.Season, cmp.Or(week, current.Week)
+	}
+
+	run, err := u.DB.StartScrapeRun(ctx, UpdateCommand, []int{season}, week)
+	if err != nil {
+		return nil, err
+	}
+	result := &UpdateResult{Season: season, Week: week}
+	if last != nil {
+		result.LastSync = last.FinishedAt.String
+	}
+	err = u.update(ctx, result, u.firstWeek(last, season, week))
+	if err == nil && options.Week == 0 && season == SeasonAt(u.Now()) {
+		u.updateReports(ctx, result)
+	}
+	run.Finish(ctx, result.String(), err)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// firstWeek returns the first week to refresh: the week the last update got
+// to, if it was earlier in the same season, or else the current week
+func (u *Updater) firstWeek(last *sqlc.ScrapeRun, season, week int) int {
+	if last == nil || !last.Week.Valid || last.Seasons != strconv.Itoa(season) {
+		return week
+	}
+	return min(int(last.Week.Int64), week)
+}
+
+// update does the work of an update, filling in result
+func (u *Updater) update(ctx context.Context, result *UpdateResult, firstWeek int) error {
+	season := int64(result.Season)
+	games := NewScraper(u.DB, u.Client)
+
+	// Refresh the scoreboards, noting which games went final
+	wentFinal := make(map[int64]bool)
+	for week := firstWeek; week <= result.Week; week++ {
+		before, err := u.DB.Queries.GetAllGamesBySeasonAndWeek(ctx, sqlc.GetAllGamesBySeasonAndWeekParams{Season: season, Week: int64(week)})
+		if err != nil {
+			return fmt.Errorf("error getting week %d games: %w", week, err)
+		}
+		saved, err := games.ScrapeWeek(ctx, result.Season, week)
+		if err != nil {
+			return fmt.Errorf("error refreshing week %d: %w", week, err)
+		}
+		result.Weeks = append(result.Weeks, week)
+		result.Games += saved
+
+		after, err := u.DB.Queries.GetAllGamesBySeasonAndWeek(ctx, sqlc.GetAllGamesBySeasonAndWeekParams{Season: season, Week: int64(week)})
+		if err != nil {
+			return fmt.Errorf("error getting week %d games: %w", week, err)
+		}
+		for _, game := range after {
+			if game.Completed && !slices.ContainsFunc(before, func(g *sqlc.NflGame) bool { return g.EventID == game.EventID && g.Completed }) {
+				wentFinal[game.EventID] = true
+			}
+		}
+	}
+
+	// Final games that need stats: the ones that just went final, which may
+	// have partial stats from while they were being played, and any others
+	// missed by earlier runs
+	var final []*sqlc.NflGame
+	seasonGames, err := u.DB.Queries.GetGamesBySeason(ctx, season)
+	if err != nil {
+		return fmt.Errorf("error getting %d games: %w", season, err)
+	}
+	missing, err := u.DB.Queries.GetCompletedGamesWithoutStats(ctx, season)
+	if err != nil {
+		return fmt.Errorf("error getting games without stats: %w", err)
+	}
+	for _, game := range seasonGames {
+		if wentFinal[game.EventID] || slices.ContainsFunc(missing, func(g *sqlc.NflGame) bool { return g.EventID == game.EventID }) {
+			final = append(final, game)
+		}
+	}
+	if len(final) == 0 {
+		log.Printf("No games have gone final since the last update")
+		return nil
+	}
+
+	// Refresh the players in those games first, so stats aren't skipped for
+	// players who weren't on a roster when players were last scraped
+	stats := NewStatScraper(u.DB, u.Client)
+	players := make(map[string]string)
+	for _, game := range final {
+		gamePlayers, err := stats.GamePlayers(ctx, game.EventID)
+		if err != nil {
+			log.Printf("Warning: couldn't get the players in game %d: %v", game.EventID, err)
+			continue
+		}
+		for playerID, teamID := range gamePlayers {
+			players[playerID] = teamID
+		}
+	}
+	result.Players, err = NewPlayerScraper(u.DB, u.Client).RefreshPlayers(ctx, result.Season, players)
+	if err != nil {
+		return fmt.Errorf("error refreshing players: %w", err)
+	}
+
+	result.FinalGames = stats.ScrapeGames(ctx, final)
+	result.Failed = len(final) - result.FinalGames
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if result.Failed > 0 {
+		return fmt.Errorf("stats failed to load for %d of %d final games", result.Failed, len(final))
+	}
+	return nil
+}
+
+// updateReports saves the current injury report and depth charts, logging
+// rather than failing the update if they can't be loaded, since the games and
+// stats are already saved
+func (u *Updater) updateReports(ctx context.Context, result *UpdateResult) {
+	week, err := u.ReportWeek(ctx, result.Season)
+	if err != nil {
+		log.Printf("Warning: couldn't work out the injury report's week: %v", err)
+		return
+	}
+	result.ReportWeek = week.Week
+	result.Injuries, err = NewInjuryScraper(u.DB, u.Client).ScrapeInjuries(ctx, week.Season, week.Week)
+	if err != nil {
+		log.Printf("Warning: error scraping injuries: %v", err)
+	}
+	result.DepthCharts, err = NewDepthChartScraper(u.DB, u.Client).ScrapeDepthCharts(ctx, week.Season, week.Week)
+	if err != nil {
+		log.Printf("Warning: error scraping depth charts: %v", err)
+	}
+}
+
+// Week is a season and week of NFL games
+type Week struct {
+	Season int
+	Week   int
+}
+
+// CurrentWeek works out the week being played from the scraped game dates:
+// the week of the latest game on or before today, or the first week of a
+// season that hasn't started yet. With a season of 0 it's the season being
+// played today, whose schedule is scraped if it hasn't been yet.
+func (u *Updater) CurrentWeek(ctx context.Context, season int) (*Week, error) {
+	now := u.Now()
+	if season == 0 {
+		season = SeasonAt(now)
+		if _, err := u.firstWeekOf(ctx, season); errors.Is(err, sql.ErrNoRows) {
+			log.Printf("No %d games have been scraped, scraping the schedule", season)
+			if err := NewScraper(u.DB, u.Client).ScrapeNFLGames(ctx, []int{season}); err != nil {
+				return nil, fmt.Errorf("error scraping the %d schedule: %w", season, err)
+			}
+		}
+	}
+
+	first, err := u.firstWeekOf(ctx, season)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("no %d games found; run 'gridirongo scrape games -seasons %d' first", season, season)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := u.DB.Queries.GetLatestWeekByDate(ctx, now.Format("2006-01-02"))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return first, nil
+	case err != nil:
+		return nil, fmt.Errorf("error finding the current week: %w", err)
+	case int(latest.Season) < season:
+		return first, nil // The season hasn't started
+	case int(latest.Season) > season:
+		return u.lastWeekOf(ctx, season) // The season is over
+	}
+	return &Week{Season: season, Week: int(latest.Week)}, nil
+}
+
+// ReportWeek returns the week whose games a report released today, like an
+// injury report or depth chart, is for: the current week, or the next one
+// once the current week's games are all final. For seasons that are over
+// it's the last week.
+func (u *Updater) ReportWeek(ctx context.Context, season int) (*Week, error) {
+	current, err := u.CurrentWeek(ctx, season)
+	if err != nil {
+		return nil, err
+	}
+	games, err := u.DB.Queries.GetAllGamesBySeasonAndWeek(ctx, sqlc.GetAllGamesBySeasonAndWeekParams{Season: int64(current.Season), Week: int64(current.Week)})
+	if err != nil {
+		return nil, fmt.Errorf("error getting week %d games: %w", current.Week, err)
+	}
+	if slices.ContainsFunc(games, func(g *sqlc.NflGame) bool { return !g.Completed }) {
+		return current, nil
+	}
+	last, err := u.lastWeekOf(ctx, current.Season)
+	if err != nil {
+		return nil, err
+	}
+	return &Week{Season: current.Season, Week: min(current.Week+1, last.Week)}, nil
+}
+
+// firstWeekOf returns the first week of a season's scraped games
+func (u *Updater) firstWeekOf(ctx context.Context, season int) (*Week, error) {
+	row, err := u.DB.Queries.GetFirstWeek(ctx, int64(season))
+	if err != nil {
+		return nil, err
+	}
+	return &Week{Season: season, Week: int(row.Week)}, nil
+}
+
+// lastWeekOf returns the last week of a season's scraped games, or
+// sql.ErrNoRows if none have been scraped
+func (u *Updater) lastWeekOf(ctx context.Context, season int) (*Week, error) {
+	weeks, err := u.DB.Queries.GetWeeksBySeason(ctx, int64(season))
+	if err != nil {
+		return nil, fmt.Errorf("error getting %d weeks: %w", season, err)
+	}
+	if len(weeks) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &Week{Season: season, Week: int(slices.Max(weeks))}, nil
+}
+
+// SeasonAt returns the NFL season being played at a time. Seasons start in
+// September and run into February, so January is still last year's season.
+func SeasonAt(t time.Time) int {
+	if t.Month() < time.August {
+		return t.Year() - 1
+	}
+	return t.Year()
+}
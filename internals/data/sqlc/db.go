@@ -24,6 +24,15 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.addPlayPlayerStmt, err = db.PrepareContext(ctx, addPlayPlayer); err != nil {
+		return nil, fmt.Errorf("error preparing query AddPlayPlayer: %w", err)
+	}
+	if q.createDriveStmt, err = db.PrepareContext(ctx, createDrive); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateDrive: %w", err)
+	}
+	if q.createFantasyLeagueStmt, err = db.PrepareContext(ctx, createFantasyLeague); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateFantasyLeague: %w", err)
+	}
 	if q.createGameStmt, err = db.PrepareContext(ctx, createGame); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateGame: %w", err)
 	}
@@ -36,12 +45,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createNFLTeamStmt, err = db.PrepareContext(ctx, createNFLTeam); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateNFLTeam: %w", err)
 	}
+	if q.createPlayStmt, err = db.PrepareContext(ctx, createPlay); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePlay: %w", err)
+	}
 	if q.createPlayerSeasonStmt, err = db.PrepareContext(ctx, createPlayerSeason); err != nil {
 		return nil, fmt.Errorf("error preparing query CreatePlayerSeason: %w", err)
 	}
+	if q.createScrapeRunStmt, err = db.PrepareContext(ctx, createScrapeRun); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateScrapeRun: %w", err)
+	}
+	if q.deleteAPIResponsesByEndpointStmt, err = db.PrepareContext(ctx, deleteAPIResponsesByEndpoint); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAPIResponsesByEndpoint: %w", err)
+	}
+	if q.deleteAllAPIResponsesStmt, err = db.PrepareContext(ctx, deleteAllAPIResponses); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAllAPIResponses: %w", err)
+	}
+	if q.deleteExpiredAPIResponsesStmt, err = db.PrepareContext(ctx, deleteExpiredAPIResponses); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredAPIResponses: %w", err)
+	}
+	if q.deleteFantasyLeagueStmt, err = db.PrepareContext(ctx, deleteFantasyLeague); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteFantasyLeague: %w", err)
+	}
 	if q.deleteGameStmt, err = db.PrepareContext(ctx, deleteGame); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteGame: %w", err)
 	}
+	if q.deleteGameDrivesStmt, err = db.PrepareContext(ctx, deleteGameDrives); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteGameDrives: %w", err)
+	}
+	if q.deleteGamePlayPlayersStmt, err = db.PrepareContext(ctx, deleteGamePlayPlayers); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteGamePlayPlayers: %w", err)
+	}
+	if q.deleteGamePlaysStmt, err = db.PrepareContext(ctx, deleteGamePlays); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteGamePlays: %w", err)
+	}
 	if q.deleteNFLPlayerStmt, err = db.PrepareContext(ctx, deleteNFLPlayer); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteNFLPlayer: %w", err)
 	}
@@ -54,12 +90,33 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deletePlayerSeasonStmt, err = db.PrepareContext(ctx, deletePlayerSeason); err != nil {
 		return nil, fmt.Errorf("error preparing query DeletePlayerSeason: %w", err)
 	}
+	if q.deletePlayerSeasonStatsStmt, err = db.PrepareContext(ctx, deletePlayerSeasonStats); err != nil {
+		return nil, fmt.Errorf("error preparing query DeletePlayerSeasonStats: %w", err)
+	}
+	if q.deleteTeamDepthChartStmt, err = db.PrepareContext(ctx, deleteTeamDepthChart); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTeamDepthChart: %w", err)
+	}
+	if q.deleteTeamInjuriesStmt, err = db.PrepareContext(ctx, deleteTeamInjuries); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTeamInjuries: %w", err)
+	}
+	if q.finishScrapeRunStmt, err = db.PrepareContext(ctx, finishScrapeRun); err != nil {
+		return nil, fmt.Errorf("error preparing query FinishScrapeRun: %w", err)
+	}
+	if q.getAPIResponseStmt, err = db.PrepareContext(ctx, getAPIResponse); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAPIResponse: %w", err)
+	}
+	if q.getAPIResponseSummaryStmt, err = db.PrepareContext(ctx, getAPIResponseSummary); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAPIResponseSummary: %w", err)
+	}
 	if q.getActiveNFLPlayersStmt, err = db.PrepareContext(ctx, getActiveNFLPlayers); err != nil {
 		return nil, fmt.Errorf("error preparing query GetActiveNFLPlayers: %w", err)
 	}
 	if q.getActivePlayerSeasonsByYearStmt, err = db.PrepareContext(ctx, getActivePlayerSeasonsByYear); err != nil {
 		return nil, fmt.Errorf("error preparing query GetActivePlayerSeasonsByYear: %w", err)
 	}
+	if q.getAllFantasyLeaguesStmt, err = db.PrepareContext(ctx, getAllFantasyLeagues); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAllFantasyLeagues: %w", err)
+	}
 	if q.getAllGamesStmt, err = db.PrepareContext(ctx, getAllGames); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAllGames: %w", err)
 	}
@@ -75,24 +132,84 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getAllPlayerSeasonsStmt, err = db.PrepareContext(ctx, getAllPlayerSeasons); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAllPlayerSeasons: %w", err)
 	}
+	if q.getCompletedGamesWithoutStatsStmt, err = db.PrepareContext(ctx, getCompletedGamesWithoutStats); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCompletedGamesWithoutStats: %w", err)
+	}
+	if q.getDepthChartsByWeekStmt, err = db.PrepareContext(ctx, getDepthChartsByWeek); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDepthChartsByWeek: %w", err)
+	}
+	if q.getFantasyLeagueStmt, err = db.PrepareContext(ctx, getFantasyLeague); err != nil {
+		return nil, fmt.Errorf("error preparing query GetFantasyLeague: %w", err)
+	}
+	if q.getFantasySeasonsStmt, err = db.PrepareContext(ctx, getFantasySeasons); err != nil {
+		return nil, fmt.Errorf("error preparing query GetFantasySeasons: %w", err)
+	}
+	if q.getFirstWeekStmt, err = db.PrepareContext(ctx, getFirstWeek); err != nil {
+		return nil, fmt.Errorf("error preparing query GetFirstWeek: %w", err)
+	}
 	if q.getGameStmt, err = db.PrepareContext(ctx, getGame); err != nil {
 		return nil, fmt.Errorf("error preparing query GetGame: %w", err)
 	}
+	if q.getGameDrivesStmt, err = db.PrepareContext(ctx, getGameDrives); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGameDrives: %w", err)
+	}
+	if q.getGamePlayPlayersStmt, err = db.PrepareContext(ctx, getGamePlayPlayers); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGamePlayPlayers: %w", err)
+	}
+	if q.getGamePlaysStmt, err = db.PrepareContext(ctx, getGamePlays); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGamePlays: %w", err)
+	}
+	if q.getGameScoringPlaysStmt, err = db.PrepareContext(ctx, getGameScoringPlays); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGameScoringPlays: %w", err)
+	}
+	if q.getGameStatTotalsBySeasonStmt, err = db.PrepareContext(ctx, getGameStatTotalsBySeason); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGameStatTotalsBySeason: %w", err)
+	}
 	if q.getGamesBySeasonStmt, err = db.PrepareContext(ctx, getGamesBySeason); err != nil {
 		return nil, fmt.Errorf("error preparing query GetGamesBySeason: %w", err)
 	}
+	if q.getInjuriesByPlayerStmt, err = db.PrepareContext(ctx, getInjuriesByPlayer); err != nil {
+		return nil, fmt.Errorf("error preparing query GetInjuriesByPlayer: %w", err)
+	}
+	if q.getInjuriesByWeekStmt, err = db.PrepareContext(ctx, getInjuriesByWeek); err != nil {
+		return nil, fmt.Errorf("error preparing query GetInjuriesByWeek: %w", err)
+	}
+	if q.getLastSuccessfulScrapeRunStmt, err = db.PrepareContext(ctx, getLastSuccessfulScrapeRun); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLastSuccessfulScrapeRun: %w", err)
+	}
+	if q.getLatestWeekByDateStmt, err = db.PrepareContext(ctx, getLatestWeekByDate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLatestWeekByDate: %w", err)
+	}
 	if q.getNFLPlayerStmt, err = db.PrepareContext(ctx, getNFLPlayer); err != nil {
 		return nil, fmt.Errorf("error preparing query GetNFLPlayer: %w", err)
 	}
 	if q.getNFLTeamStmt, err = db.PrepareContext(ctx, getNFLTeam); err != nil {
 		return nil, fmt.Errorf("error preparing query GetNFLTeam: %w", err)
 	}
+	if q.getPlayerDepthStmt, err = db.PrepareContext(ctx, getPlayerDepth); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPlayerDepth: %w", err)
+	}
+	if q.getPlayerGameCountsBySeasonStmt, err = db.PrepareContext(ctx, getPlayerGameCountsBySeason); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPlayerGameCountsBySeason: %w", err)
+	}
+	if q.getPlayerGameStatsBySeasonStmt, err = db.PrepareContext(ctx, getPlayerGameStatsBySeason); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPlayerGameStatsBySeason: %w", err)
+	}
+	if q.getPlayerPlaysStmt, err = db.PrepareContext(ctx, getPlayerPlays); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPlayerPlays: %w", err)
+	}
 	if q.getPlayerSeasonStmt, err = db.PrepareContext(ctx, getPlayerSeason); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPlayerSeason: %w", err)
 	}
+	if q.getPlayerSeasonStatsStmt, err = db.PrepareContext(ctx, getPlayerSeasonStats); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPlayerSeasonStats: %w", err)
+	}
 	if q.getPlayerSeasonalStatsByTypeStmt, err = db.PrepareContext(ctx, getPlayerSeasonalStatsByType); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPlayerSeasonalStatsByType: %w", err)
 	}
+	if q.getPlayerSeasonsByPlayerStmt, err = db.PrepareContext(ctx, getPlayerSeasonsByPlayer); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPlayerSeasonsByPlayer: %w", err)
+	}
 	if q.getPlayerSeasonsByTeamStmt, err = db.PrepareContext(ctx, getPlayerSeasonsByTeam); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPlayerSeasonsByTeam: %w", err)
 	}
@@ -135,6 +252,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getPlayersByTeamStmt, err = db.PrepareContext(ctx, getPlayersByTeam); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPlayersByTeam: %w", err)
 	}
+	if q.getSeasonGameStatsStmt, err = db.PrepareContext(ctx, getSeasonGameStats); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSeasonGameStats: %w", err)
+	}
+	if q.getSeasonScoringPlaysStmt, err = db.PrepareContext(ctx, getSeasonScoringPlays); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSeasonScoringPlays: %w", err)
+	}
+	if q.getSeasonStatsBySeasonStmt, err = db.PrepareContext(ctx, getSeasonStatsBySeason); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSeasonStatsBySeason: %w", err)
+	}
+	if q.getSeasonsStmt, err = db.PrepareContext(ctx, getSeasons); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSeasons: %w", err)
+	}
 	if q.getStatsByCategoryStmt, err = db.PrepareContext(ctx, getStatsByCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query GetStatsByCategory: %w", err)
 	}
@@ -153,6 +282,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getStatsByTeamStmt, err = db.PrepareContext(ctx, getStatsByTeam); err != nil {
 		return nil, fmt.Errorf("error preparing query GetStatsByTeam: %w", err)
 	}
+	if q.getTeamDepthChartStmt, err = db.PrepareContext(ctx, getTeamDepthChart); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTeamDepthChart: %w", err)
+	}
+	if q.getTeamPassAttemptsBySeasonStmt, err = db.PrepareContext(ctx, getTeamPassAttemptsBySeason); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTeamPassAttemptsBySeason: %w", err)
+	}
 	if q.getTeamStatsBySeasonStmt, err = db.PrepareContext(ctx, getTeamStatsBySeason); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTeamStatsBySeason: %w", err)
 	}
@@ -165,9 +300,42 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getTopPlayersByStatStmt, err = db.PrepareContext(ctx, getTopPlayersByStat); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTopPlayersByStat: %w", err)
 	}
+	if q.getWeekGameStatsStmt, err = db.PrepareContext(ctx, getWeekGameStats); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWeekGameStats: %w", err)
+	}
+	if q.getWeekScoringPlaysStmt, err = db.PrepareContext(ctx, getWeekScoringPlays); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWeekScoringPlays: %w", err)
+	}
+	if q.getWeeksBySeasonStmt, err = db.PrepareContext(ctx, getWeeksBySeason); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWeeksBySeason: %w", err)
+	}
+	if q.listAPIResponsesStmt, err = db.PrepareContext(ctx, listAPIResponses); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAPIResponses: %w", err)
+	}
+	if q.listScrapeJobsBySeasonStmt, err = db.PrepareContext(ctx, listScrapeJobsBySeason); err != nil {
+		return nil, fmt.Errorf("error preparing query ListScrapeJobsBySeason: %w", err)
+	}
+	if q.listScrapeRunsStmt, err = db.PrepareContext(ctx, listScrapeRuns); err != nil {
+		return nil, fmt.Errorf("error preparing query ListScrapeRuns: %w", err)
+	}
+	if q.queueScrapeJobStmt, err = db.PrepareContext(ctx, queueScrapeJob); err != nil {
+		return nil, fmt.Errorf("error preparing query QueueScrapeJob: %w", err)
+	}
+	if q.recordScrapeJobStmt, err = db.PrepareContext(ctx, recordScrapeJob); err != nil {
+		return nil, fmt.Errorf("error preparing query RecordScrapeJob: %w", err)
+	}
+	if q.resetScrapeJobsStmt, err = db.PrepareContext(ctx, resetScrapeJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query ResetScrapeJobs: %w", err)
+	}
+	if q.saveInjuryReportStmt, err = db.PrepareContext(ctx, saveInjuryReport); err != nil {
+		return nil, fmt.Errorf("error preparing query SaveInjuryReport: %w", err)
+	}
 	if q.searchPlayersStmt, err = db.PrepareContext(ctx, searchPlayers); err != nil {
 		return nil, fmt.Errorf("error preparing query SearchPlayers: %w", err)
 	}
+	if q.updateFantasyLeagueStmt, err = db.PrepareContext(ctx, updateFantasyLeague); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateFantasyLeague: %w", err)
+	}
 	if q.updateGameStmt, err = db.PrepareContext(ctx, updateGame); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateGame: %w", err)
 	}
@@ -183,9 +351,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updatePlayerSeasonStmt, err = db.PrepareContext(ctx, updatePlayerSeason); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdatePlayerSeason: %w", err)
 	}
+	if q.upsertAPIResponseStmt, err = db.PrepareContext(ctx, upsertAPIResponse); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertAPIResponse: %w", err)
+	}
+	if q.upsertDepthChartEntryStmt, err = db.PrepareContext(ctx, upsertDepthChartEntry); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertDepthChartEntry: %w", err)
+	}
+	if q.upsertFantasySeasonStmt, err = db.PrepareContext(ctx, upsertFantasySeason); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertFantasySeason: %w", err)
+	}
 	if q.upsertGameStmt, err = db.PrepareContext(ctx, upsertGame); err != nil {
 		return nil, fmt.Errorf("error preparing query UpsertGame: %w", err)
 	}
+	if q.upsertInjuryStmt, err = db.PrepareContext(ctx, upsertInjury); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertInjury: %w", err)
+	}
 	if q.upsertNFLPlayerStmt, err = db.PrepareContext(ctx, upsertNFLPlayer); err != nil {
 		return nil, fmt.Errorf("error preparing query UpsertNFLPlayer: %w", err)
 	}
@@ -195,11 +375,29 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.upsertPlayerSeasonStmt, err = db.PrepareContext(ctx, upsertPlayerSeason); err != nil {
 		return nil, fmt.Errorf("error preparing query UpsertPlayerSeason: %w", err)
 	}
+	if q.upsertSeasonStatStmt, err = db.PrepareContext(ctx, upsertSeasonStat); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertSeasonStat: %w", err)
+	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
+	if q.addPlayPlayerStmt != nil {
+		if cerr := q.addPlayPlayerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addPlayPlayerStmt: %w", cerr)
+		}
+	}
+	if q.createDriveStmt != nil {
+		if cerr := q.createDriveStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createDriveStmt: %w", cerr)
+		}
+	}
+	if q.createFantasyLeagueStmt != nil {
+		if cerr := q.createFantasyLeagueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createFantasyLeagueStmt: %w", cerr)
+		}
+	}
 	if q.createGameStmt != nil {
 		if cerr := q.createGameStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createGameStmt: %w", cerr)
@@ -220,16 +418,61 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createNFLTeamStmt: %w", cerr)
 		}
 	}
+	if q.createPlayStmt != nil {
+		if cerr := q.createPlayStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPlayStmt: %w", cerr)
+		}
+	}
 	if q.createPlayerSeasonStmt != nil {
 		if cerr := q.createPlayerSeasonStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createPlayerSeasonStmt: %w", cerr)
 		}
 	}
+	if q.createScrapeRunStmt != nil {
+		if cerr := q.createScrapeRunStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createScrapeRunStmt: %w", cerr)
+		}
+	}
+	if q.deleteAPIResponsesByEndpointStmt != nil {
+		if cerr := q.deleteAPIResponsesByEndpointStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAPIResponsesByEndpointStmt: %w", cerr)
+		}
+	}
+	if q.deleteAllAPIResponsesStmt != nil {
+		if cerr := q.deleteAllAPIResponsesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAllAPIResponsesStmt: %w", cerr)
+		}
+	}
+	if q.deleteExpiredAPIResponsesStmt != nil {
+		if cerr := q.deleteExpiredAPIResponsesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredAPIResponsesStmt: %w", cerr)
+		}
+	}
+	if q.deleteFantasyLeagueStmt != nil {
+		if cerr := q.deleteFantasyLeagueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteFantasyLeagueStmt: %w", cerr)
+		}
+	}
 	if q.deleteGameStmt != nil {
 		if cerr := q.deleteGameStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteGameStmt: %w", cerr)
 		}
 	}
+	if q.deleteGameDrivesStmt != nil {
+		if cerr := q.deleteGameDrivesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteGameDrivesStmt: %w", cerr)
+		}
+	}
+	if q.deleteGamePlayPlayersStmt != nil {
+		if cerr := q.deleteGamePlayPlayersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteGamePlayPlayersStmt: %w", cerr)
+		}
+	}
+	if q.deleteGamePlaysStmt != nil {
+		if cerr := q.deleteGamePlaysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteGamePlaysStmt: %w", cerr)
+		}
+	}
 	if q.deleteNFLPlayerStmt != nil {
 		if cerr := q.deleteNFLPlayerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteNFLPlayerStmt: %w", cerr)
@@ -250,6 +493,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deletePlayerSeasonStmt: %w", cerr)
 		}
 	}
+	if q.deletePlayerSeasonStatsStmt != nil {
+		if cerr := q.deletePlayerSeasonStatsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deletePlayerSeasonStatsStmt: %w", cerr)
+		}
+	}
+	if q.deleteTeamDepthChartStmt != nil {
+		if cerr := q.deleteTeamDepthChartStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTeamDepthChartStmt: %w", cerr)
+		}
+	}
+	if q.deleteTeamInjuriesStmt != nil {
+		if cerr := q.deleteTeamInjuriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTeamInjuriesStmt: %w", cerr)
+		}
+	}
+	if q.finishScrapeRunStmt != nil {
+		if cerr := q.finishScrapeRunStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing finishScrapeRunStmt: %w", cerr)
+		}
+	}
+	if q.getAPIResponseStmt != nil {
+		if cerr := q.getAPIResponseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAPIResponseStmt: %w", cerr)
+		}
+	}
+	if q.getAPIResponseSummaryStmt != nil {
+		if cerr := q.getAPIResponseSummaryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAPIResponseSummaryStmt: %w", cerr)
+		}
+	}
 	if q.getActiveNFLPlayersStmt != nil {
 		if cerr := q.getActiveNFLPlayersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getActiveNFLPlayersStmt: %w", cerr)
@@ -260,6 +533,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getActivePlayerSeasonsByYearStmt: %w", cerr)
 		}
 	}
+	if q.getAllFantasyLeaguesStmt != nil {
+		if cerr := q.getAllFantasyLeaguesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAllFantasyLeaguesStmt: %w", cerr)
+		}
+	}
 	if q.getAllGamesStmt != nil {
 		if cerr := q.getAllGamesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAllGamesStmt: %w", cerr)
@@ -285,16 +563,86 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getAllPlayerSeasonsStmt: %w", cerr)
 		}
 	}
+	if q.getCompletedGamesWithoutStatsStmt != nil {
+		if cerr := q.getCompletedGamesWithoutStatsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCompletedGamesWithoutStatsStmt: %w", cerr)
+		}
+	}
+	if q.getDepthChartsByWeekStmt != nil {
+		if cerr := q.getDepthChartsByWeekStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDepthChartsByWeekStmt: %w", cerr)
+		}
+	}
+	if q.getFantasyLeagueStmt != nil {
+		if cerr := q.getFantasyLeagueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getFantasyLeagueStmt: %w", cerr)
+		}
+	}
+	if q.getFantasySeasonsStmt != nil {
+		if cerr := q.getFantasySeasonsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getFantasySeasonsStmt: %w", cerr)
+		}
+	}
+	if q.getFirstWeekStmt != nil {
+		if cerr := q.getFirstWeekStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getFirstWeekStmt: %w", cerr)
+		}
+	}
 	if q.getGameStmt != nil {
 		if cerr := q.getGameStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getGameStmt: %w", cerr)
 		}
 	}
+	if q.getGameDrivesStmt != nil {
+		if cerr := q.getGameDrivesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGameDrivesStmt: %w", cerr)
+		}
+	}
+	if q.getGamePlayPlayersStmt != nil {
+		if cerr := q.getGamePlayPlayersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGamePlayPlayersStmt: %w", cerr)
+		}
+	}
+	if q.getGamePlaysStmt != nil {
+		if cerr := q.getGamePlaysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGamePlaysStmt: %w", cerr)
+		}
+	}
+	if q.getGameScoringPlaysStmt != nil {
+		if cerr := q.getGameScoringPlaysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGameScoringPlaysStmt: %w", cerr)
+		}
+	}
+	if q.getGameStatTotalsBySeasonStmt != nil {
+		if cerr := q.getGameStatTotalsBySeasonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGameStatTotalsBySeasonStmt: %w", cerr)
+		}
+	}
 	if q.getGamesBySeasonStmt != nil {
 		if cerr := q.getGamesBySeasonStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getGamesBySeasonStmt: %w", cerr)
 		}
 	}
+	if q.getInjuriesByPlayerStmt != nil {
+		if cerr := q.getInjuriesByPlayerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getInjuriesByPlayerStmt: %w", cerr)
+		}
+	}
+	if q.getInjuriesByWeekStmt != nil {
+		if cerr := q.getInjuriesByWeekStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getInjuriesByWeekStmt: %w", cerr)
+		}
+	}
+	if q.getLastSuccessfulScrapeRunStmt != nil {
+		if cerr := q.getLastSuccessfulScrapeRunStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLastSuccessfulScrapeRunStmt: %w", cerr)
+		}
+	}
+	if q.getLatestWeekByDateStmt != nil {
+		if cerr := q.getLatestWeekByDateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLatestWeekByDateStmt: %w", cerr)
+		}
+	}
 	if q.getNFLPlayerStmt != nil {
 		if cerr := q.getNFLPlayerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getNFLPlayerStmt: %w", cerr)
@@ -305,16 +653,46 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getNFLTeamStmt: %w", cerr)
 		}
 	}
+	if q.getPlayerDepthStmt != nil {
+		if cerr := q.getPlayerDepthStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPlayerDepthStmt: %w", cerr)
+		}
+	}
+	if q.getPlayerGameCountsBySeasonStmt != nil {
+		if cerr := q.getPlayerGameCountsBySeasonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPlayerGameCountsBySeasonStmt: %w", cerr)
+		}
+	}
+	if q.getPlayerGameStatsBySeasonStmt != nil {
+		if cerr := q.getPlayerGameStatsBySeasonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPlayerGameStatsBySeasonStmt: %w", cerr)
+		}
+	}
+	if q.getPlayerPlaysStmt != nil {
+		if cerr := q.getPlayerPlaysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPlayerPlaysStmt: %w", cerr)
+		}
+	}
 	if q.getPlayerSeasonStmt != nil {
 		if cerr := q.getPlayerSeasonStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getPlayerSeasonStmt: %w", cerr)
 		}
 	}
+	if q.getPlayerSeasonStatsStmt != nil {
+		if cerr := q.getPlayerSeasonStatsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPlayerSeasonStatsStmt: %w", cerr)
+		}
+	}
 	if q.getPlayerSeasonalStatsByTypeStmt != nil {
 		if cerr := q.getPlayerSeasonalStatsByTypeStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getPlayerSeasonalStatsByTypeStmt: %w", cerr)
 		}
 	}
+	if q.getPlayerSeasonsByPlayerStmt != nil {
+		if cerr := q.getPlayerSeasonsByPlayerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPlayerSeasonsByPlayerStmt: %w", cerr)
+		}
+	}
 	if q.getPlayerSeasonsByTeamStmt != nil {
 		if cerr := q.getPlayerSeasonsByTeamStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getPlayerSeasonsByTeamStmt: %w", cerr)
@@ -385,6 +763,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getPlayersByTeamStmt: %w", cerr)
 		}
 	}
+	if q.getSeasonGameStatsStmt != nil {
+		if cerr := q.getSeasonGameStatsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSeasonGameStatsStmt: %w", cerr)
+		}
+	}
+	if q.getSeasonScoringPlaysStmt != nil {
+		if cerr := q.getSeasonScoringPlaysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSeasonScoringPlaysStmt: %w", cerr)
+		}
+	}
+	if q.getSeasonStatsBySeasonStmt != nil {
+		if cerr := q.getSeasonStatsBySeasonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSeasonStatsBySeasonStmt: %w", cerr)
+		}
+	}
+	if q.getSeasonsStmt != nil {
+		if cerr := q.getSeasonsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSeasonsStmt: %w", cerr)
+		}
+	}
 	if q.getStatsByCategoryStmt != nil {
 		if cerr := q.getStatsByCategoryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getStatsByCategoryStmt: %w", cerr)
@@ -415,6 +813,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getStatsByTeamStmt: %w", cerr)
 		}
 	}
+	if q.getTeamDepthChartStmt != nil {
+		if cerr := q.getTeamDepthChartStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTeamDepthChartStmt: %w", cerr)
+		}
+	}
+	if q.getTeamPassAttemptsBySeasonStmt != nil {
+		if cerr := q.getTeamPassAttemptsBySeasonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTeamPassAttemptsBySeasonStmt: %w", cerr)
+		}
+	}
 	if q.getTeamStatsBySeasonStmt != nil {
 		if cerr := q.getTeamStatsBySeasonStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getTeamStatsBySeasonStmt: %w", cerr)
@@ -435,11 +843,66 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getTopPlayersByStatStmt: %w", cerr)
 		}
 	}
+	if q.getWeekGameStatsStmt != nil {
+		if cerr := q.getWeekGameStatsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWeekGameStatsStmt: %w", cerr)
+		}
+	}
+	if q.getWeekScoringPlaysStmt != nil {
+		if cerr := q.getWeekScoringPlaysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWeekScoringPlaysStmt: %w", cerr)
+		}
+	}
+	if q.getWeeksBySeasonStmt != nil {
+		if cerr := q.getWeeksBySeasonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWeeksBySeasonStmt: %w", cerr)
+		}
+	}
+	if q.listAPIResponsesStmt != nil {
+		if cerr := q.listAPIResponsesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAPIResponsesStmt: %w", cerr)
+		}
+	}
+	if q.listScrapeJobsBySeasonStmt != nil {
+		if cerr := q.listScrapeJobsBySeasonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listScrapeJobsBySeasonStmt: %w", cerr)
+		}
+	}
+	if q.listScrapeRunsStmt != nil {
+		if cerr := q.listScrapeRunsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listScrapeRunsStmt: %w", cerr)
+		}
+	}
+	if q.queueScrapeJobStmt != nil {
+		if cerr := q.queueScrapeJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing queueScrapeJobStmt: %w", cerr)
+		}
+	}
+	if q.recordScrapeJobStmt != nil {
+		if cerr := q.recordScrapeJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing recordScrapeJobStmt: %w", cerr)
+		}
+	}
+	if q.resetScrapeJobsStmt != nil {
+		if cerr := q.resetScrapeJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resetScrapeJobsStmt: %w", cerr)
+		}
+	}
+	if q.saveInjuryReportStmt != nil {
+		if cerr := q.saveInjuryReportStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing saveInjuryReportStmt: %w", cerr)
+		}
+	}
 	if q.searchPlayersStmt != nil {
 		if cerr := q.searchPlayersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing searchPlayersStmt: %w", cerr)
 		}
 	}
+	if q.updateFantasyLeagueStmt != nil {
+		if cerr := q.updateFantasyLeagueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateFantasyLeagueStmt: %w", cerr)
+		}
+	}
 	if q.updateGameStmt != nil {
 		if cerr := q.updateGameStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateGameStmt: %w", cerr)
@@ -465,11 +928,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updatePlayerSeasonStmt: %w", cerr)
 		}
 	}
+	if q.upsertAPIResponseStmt != nil {
+		if cerr := q.upsertAPIResponseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertAPIResponseStmt: %w", cerr)
+		}
+	}
+	if q.upsertDepthChartEntryStmt != nil {
+		if cerr := q.upsertDepthChartEntryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertDepthChartEntryStmt: %w", cerr)
+		}
+	}
+	if q.upsertFantasySeasonStmt != nil {
+		if cerr := q.upsertFantasySeasonStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertFantasySeasonStmt: %w", cerr)
+		}
+	}
 	if q.upsertGameStmt != nil {
 		if cerr := q.upsertGameStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing upsertGameStmt: %w", cerr)
 		}
 	}
+	if q.upsertInjuryStmt != nil {
+		if cerr := q.upsertInjuryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertInjuryStmt: %w", cerr)
+		}
+	}
 	if q.upsertNFLPlayerStmt != nil {
 		if cerr := q.upsertNFLPlayerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing upsertNFLPlayerStmt: %w", cerr)
@@ -485,6 +968,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing upsertPlayerSeasonStmt: %w", cerr)
 		}
 	}
+	if q.upsertSeasonStatStmt != nil {
+		if cerr := q.upsertSeasonStatStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertSeasonStatStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -524,29 +1012,68 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 type Queries struct {
 	db                                    DBTX
 	tx                                    *sql.Tx
+	addPlayPlayerStmt                     *sql.Stmt
+	createDriveStmt                       *sql.Stmt
+	createFantasyLeagueStmt               *sql.Stmt
 	createGameStmt                        *sql.Stmt
 	createNFLPlayerStmt                   *sql.Stmt
 	createNFLStatStmt                     *sql.Stmt
 	createNFLTeamStmt                     *sql.Stmt
+	createPlayStmt                        *sql.Stmt
 	createPlayerSeasonStmt                *sql.Stmt
+	createScrapeRunStmt                   *sql.Stmt
+	deleteAPIResponsesByEndpointStmt      *sql.Stmt
+	deleteAllAPIResponsesStmt             *sql.Stmt
+	deleteExpiredAPIResponsesStmt         *sql.Stmt
+	deleteFantasyLeagueStmt               *sql.Stmt
 	deleteGameStmt                        *sql.Stmt
+	deleteGameDrivesStmt                  *sql.Stmt
+	deleteGamePlayPlayersStmt             *sql.Stmt
+	deleteGamePlaysStmt                   *sql.Stmt
 	deleteNFLPlayerStmt                   *sql.Stmt
 	deleteNFLStatStmt                     *sql.Stmt
 	deleteNFLTeamStmt                     *sql.Stmt
 	deletePlayerSeasonStmt                *sql.Stmt
+	deletePlayerSeasonStatsStmt           *sql.Stmt
+	deleteTeamDepthChartStmt              *sql.Stmt
+	deleteTeamInjuriesStmt                *sql.Stmt
+	finishScrapeRunStmt                   *sql.Stmt
+	getAPIResponseStmt                    *sql.Stmt
+	getAPIResponseSummaryStmt             *sql.Stmt
 	getActiveNFLPlayersStmt               *sql.Stmt
 	getActivePlayerSeasonsByYearStmt      *sql.Stmt
+	getAllFantasyLeaguesStmt              *sql.Stmt
 	getAllGamesStmt                       *sql.Stmt
 	getAllGamesBySeasonAndWeekStmt        *sql.Stmt
 	getAllNFLPlayersStmt                  *sql.Stmt
 	getAllNFLTeamsStmt                    *sql.Stmt
 	getAllPlayerSeasonsStmt               *sql.Stmt
+	getCompletedGamesWithoutStatsStmt     *sql.Stmt
+	getDepthChartsByWeekStmt              *sql.Stmt
+	getFantasyLeagueStmt                  *sql.Stmt
+	getFantasySeasonsStmt                 *sql.Stmt
+	getFirstWeekStmt                      *sql.Stmt
 	getGameStmt                           *sql.Stmt
+	getGameDrivesStmt                     *sql.Stmt
+	getGamePlayPlayersStmt                *sql.Stmt
+	getGamePlaysStmt                      *sql.Stmt
+	getGameScoringPlaysStmt               *sql.Stmt
+	getGameStatTotalsBySeasonStmt         *sql.Stmt
 	getGamesBySeasonStmt                  *sql.Stmt
+	getInjuriesByPlayerStmt               *sql.Stmt
+	getInjuriesByWeekStmt                 *sql.Stmt
+	getLastSuccessfulScrapeRunStmt        *sql.Stmt
+	getLatestWeekByDateStmt               *sql.Stmt
 	getNFLPlayerStmt                      *sql.Stmt
 	getNFLTeamStmt                        *sql.Stmt
+	getPlayerDepthStmt                    *sql.Stmt
+	getPlayerGameCountsBySeasonStmt       *sql.Stmt
+	getPlayerGameStatsBySeasonStmt        *sql.Stmt
+	getPlayerPlaysStmt                    *sql.Stmt
 	getPlayerSeasonStmt                   *sql.Stmt
+	getPlayerSeasonStatsStmt              *sql.Stmt
 	getPlayerSeasonalStatsByTypeStmt      *sql.Stmt
+	getPlayerSeasonsByPlayerStmt          *sql.Stmt
 	getPlayerSeasonsByTeamStmt            *sql.Stmt
 	getPlayerSeasonsByYearStmt            *sql.Stmt
 	getPlayerStatAverageStmt              *sql.Stmt
@@ -561,55 +1088,116 @@ type Queries struct {
 	getPlayerWeeklyStatByTypeStmt         *sql.Stmt
 	getPlayersByPositionStmt              *sql.Stmt
 	getPlayersByTeamStmt                  *sql.Stmt
+	getSeasonGameStatsStmt                *sql.Stmt
+	getSeasonScoringPlaysStmt             *sql.Stmt
+	getSeasonStatsBySeasonStmt            *sql.Stmt
+	getSeasonsStmt                        *sql.Stmt
 	getStatsByCategoryStmt                *sql.Stmt
 	getStatsByGameStmt                    *sql.Stmt
 	getStatsByGameAndPlayerStmt           *sql.Stmt
 	getStatsByPlayerStmt                  *sql.Stmt
 	getStatsByStatTypeStmt                *sql.Stmt
 	getStatsByTeamStmt                    *sql.Stmt
+	getTeamDepthChartStmt                 *sql.Stmt
+	getTeamPassAttemptsBySeasonStmt       *sql.Stmt
 	getTeamStatsBySeasonStmt              *sql.Stmt
 	getTeamsByConferenceStmt              *sql.Stmt
 	getTeamsByDivisionStmt                *sql.Stmt
 	getTopPlayersByStatStmt               *sql.Stmt
+	getWeekGameStatsStmt                  *sql.Stmt
+	getWeekScoringPlaysStmt               *sql.Stmt
+	getWeeksBySeasonStmt                  *sql.Stmt
+	listAPIResponsesStmt                  *sql.Stmt
+	listScrapeJobsBySeasonStmt            *sql.Stmt
+	listScrapeRunsStmt                    *sql.Stmt
+	queueScrapeJobStmt                    *sql.Stmt
+	recordScrapeJobStmt                   *sql.Stmt
+	resetScrapeJobsStmt                   *sql.Stmt
+	saveInjuryReportStmt                  *sql.Stmt
 	searchPlayersStmt                     *sql.Stmt
+	updateFantasyLeagueStmt               *sql.Stmt
 	updateGameStmt                        *sql.Stmt
 	updateNFLPlayerStmt                   *sql.Stmt
 	updateNFLStatStmt                     *sql.Stmt
 	updateNFLTeamStmt                     *sql.Stmt
 	updatePlayerSeasonStmt                *sql.Stmt
+	upsertAPIResponseStmt                 *sql.Stmt
+	upsertDepthChartEntryStmt             *sql.Stmt
+	upsertFantasySeasonStmt               *sql.Stmt
 	upsertGameStmt                        *sql.Stmt
+	upsertInjuryStmt                      *sql.Stmt
 	upsertNFLPlayerStmt                   *sql.Stmt
 	upsertNFLStatStmt                     *sql.Stmt
 	upsertPlayerSeasonStmt                *sql.Stmt
+	upsertSeasonStatStmt                  *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
 		db:                                    tx,
 		tx:                                    tx,
+		addPlayPlayerStmt:                     q.addPlayPlayerStmt,
+		createDriveStmt:                       q.createDriveStmt,
+		createFantasyLeagueStmt:               q.createFantasyLeagueStmt,
 		createGameStmt:                        q.createGameStmt,
 		createNFLPlayerStmt:                   q.createNFLPlayerStmt,
 		createNFLStatStmt:                     q.createNFLStatStmt,
 		createNFLTeamStmt:                     q.createNFLTeamStmt,
+		createPlayStmt:                        q.createPlayStmt,
 		createPlayerSeasonStmt:                q.createPlayerSeasonStmt,
+		createScrapeRunStmt:                   q.createScrapeRunStmt,
+		deleteAPIResponsesByEndpointStmt:      q.deleteAPIResponsesByEndpointStmt,
+		deleteAllAPIResponsesStmt:             q.deleteAllAPIResponsesStmt,
+		deleteExpiredAPIResponsesStmt:         q.deleteExpiredAPIResponsesStmt,
+		deleteFantasyLeagueStmt:               q.deleteFantasyLeagueStmt,
 		deleteGameStmt:                        q.deleteGameStmt,
+		deleteGameDrivesStmt:                  q.deleteGameDrivesStmt,
+		deleteGamePlayPlayersStmt:             q.deleteGamePlayPlayersStmt,
+		deleteGamePlaysStmt:                   q.deleteGamePlaysStmt,
 		deleteNFLPlayerStmt:                   q.deleteNFLPlayerStmt,
 		deleteNFLStatStmt:                     q.deleteNFLStatStmt,
 		deleteNFLTeamStmt:                     q.deleteNFLTeamStmt,
 		deletePlayerSeasonStmt:                q.deletePlayerSeasonStmt,
+		deletePlayerSeasonStatsStmt:           q.deletePlayerSeasonStatsStmt,
+		deleteTeamDepthChartStmt:              q.deleteTeamDepthChartStmt,
+		deleteTeamInjuriesStmt:                q.deleteTeamInjuriesStmt,
+		finishScrapeRunStmt:                   q.finishScrapeRunStmt,
+		getAPIResponseStmt:                    q.getAPIResponseStmt,
+		getAPIResponseSummaryStmt:             q.getAPIResponseSummaryStmt,
 		getActiveNFLPlayersStmt:               q.getActiveNFLPlayersStmt,
 		getActivePlayerSeasonsByYearStmt:      q.getActivePlayerSeasonsByYearStmt,
+		getAllFantasyLeaguesStmt:              q.getAllFantasyLeaguesStmt,
 		getAllGamesStmt:                       q.getAllGamesStmt,
 		getAllGamesBySeasonAndWeekStmt:        q.getAllGamesBySeasonAndWeekStmt,
 		getAllNFLPlayersStmt:                  q.getAllNFLPlayersStmt,
 		getAllNFLTeamsStmt:                    q.getAllNFLTeamsStmt,
 		getAllPlayerSeasonsStmt:               q.getAllPlayerSeasonsStmt,
+		getCompletedGamesWithoutStatsStmt:     q.getCompletedGamesWithoutStatsStmt,
+		getDepthChartsByWeekStmt:              q.getDepthChartsByWeekStmt,
+		getFantasyLeagueStmt:                  q.getFantasyLeagueStmt,
+		getFantasySeasonsStmt:                 q.getFantasySeasonsStmt,
+		getFirstWeekStmt:                      q.getFirstWeekStmt,
 		getGameStmt:                           q.getGameStmt,
+		getGameDrivesStmt:                     q.getGameDrivesStmt,
+		getGamePlayPlayersStmt:                q.getGamePlayPlayersStmt,
+		getGamePlaysStmt:                      q.getGamePlaysStmt,
+		getGameScoringPlaysStmt:               q.getGameScoringPlaysStmt,
+		getGameStatTotalsBySeasonStmt:         q.getGameStatTotalsBySeasonStmt,
 		getGamesBySeasonStmt:                  q.getGamesBySeasonStmt,
+		getInjuriesByPlayerStmt:               q.getInjuriesByPlayerStmt,
+		getInjuriesByWeekStmt:                 q.getInjuriesByWeekStmt,
+		getLastSuccessfulScrapeRunStmt:        q.getLastSuccessfulScrapeRunStmt,
+		getLatestWeekByDateStmt:               q.getLatestWeekByDateStmt,
 		getNFLPlayerStmt:                      q.getNFLPlayerStmt,
 		getNFLTeamStmt:                        q.getNFLTeamStmt,
+		getPlayerDepthStmt:                    q.getPlayerDepthStmt,
+		getPlayerGameCountsBySeasonStmt:       q.getPlayerGameCountsBySeasonStmt,
+		getPlayerGameStatsBySeasonStmt:        q.getPlayerGameStatsBySeasonStmt,
+		getPlayerPlaysStmt:                    q.getPlayerPlaysStmt,
 		getPlayerSeasonStmt:                   q.getPlayerSeasonStmt,
+		getPlayerSeasonStatsStmt:              q.getPlayerSeasonStatsStmt,
 		getPlayerSeasonalStatsByTypeStmt:      q.getPlayerSeasonalStatsByTypeStmt,
+		getPlayerSeasonsByPlayerStmt:          q.getPlayerSeasonsByPlayerStmt,
 		getPlayerSeasonsByTeamStmt:            q.getPlayerSeasonsByTeamStmt,
 		getPlayerSeasonsByYearStmt:            q.getPlayerSeasonsByYearStmt,
 		getPlayerStatAverageStmt:              q.getPlayerStatAverageStmt,
@@ -624,25 +1212,47 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		getPlayerWeeklyStatByTypeStmt:         q.getPlayerWeeklyStatByTypeStmt,
 		getPlayersByPositionStmt:              q.getPlayersByPositionStmt,
 		getPlayersByTeamStmt:                  q.getPlayersByTeamStmt,
+		getSeasonGameStatsStmt:                q.getSeasonGameStatsStmt,
+		getSeasonScoringPlaysStmt:             q.getSeasonScoringPlaysStmt,
+		getSeasonStatsBySeasonStmt:            q.getSeasonStatsBySeasonStmt,
+		getSeasonsStmt:                        q.getSeasonsStmt,
 		getStatsByCategoryStmt:                q.getStatsByCategoryStmt,
 		getStatsByGameStmt:                    q.getStatsByGameStmt,
 		getStatsByGameAndPlayerStmt:           q.getStatsByGameAndPlayerStmt,
 		getStatsByPlayerStmt:                  q.getStatsByPlayerStmt,
 		getStatsByStatTypeStmt:                q.getStatsByStatTypeStmt,
 		getStatsByTeamStmt:                    q.getStatsByTeamStmt,
+		getTeamDepthChartStmt:                 q.getTeamDepthChartStmt,
+		getTeamPassAttemptsBySeasonStmt:       q.getTeamPassAttemptsBySeasonStmt,
 		getTeamStatsBySeasonStmt:              q.getTeamStatsBySeasonStmt,
 		getTeamsByConferenceStmt:              q.getTeamsByConferenceStmt,
 		getTeamsByDivisionStmt:                q.getTeamsByDivisionStmt,
 		getTopPlayersByStatStmt:               q.getTopPlayersByStatStmt,
+		getWeekGameStatsStmt:                  q.getWeekGameStatsStmt,
+		getWeekScoringPlaysStmt:               q.getWeekScoringPlaysStmt,
+		getWeeksBySeasonStmt:                  q.getWeeksBySeasonStmt,
+		listAPIResponsesStmt:                  q.listAPIResponsesStmt,
+		listScrapeJobsBySeasonStmt:            q.listScrapeJobsBySeasonStmt,
+		listScrapeRunsStmt:                    q.listScrapeRunsStmt,
+		queueScrapeJobStmt:                    q.queueScrapeJobStmt,
+		recordScrapeJobStmt:                   q.recordScrapeJobStmt,
+		resetScrapeJobsStmt:                   q.resetScrapeJobsStmt,
+		saveInjuryReportStmt:                  q.saveInjuryReportStmt,
 		searchPlayersStmt:                     q.searchPlayersStmt,
+		updateFantasyLeagueStmt:               q.updateFantasyLeagueStmt,
 		updateGameStmt:                        q.updateGameStmt,
 		updateNFLPlayerStmt:                   q.updateNFLPlayerStmt,
 		updateNFLStatStmt:                     q.updateNFLStatStmt,
 		updateNFLTeamStmt:                     q.updateNFLTeamStmt,
 		updatePlayerSeasonStmt:                q.updatePlayerSeasonStmt,
+		upsertAPIResponseStmt:                 q.upsertAPIResponseStmt,
+		upsertDepthChartEntryStmt:             q.upsertDepthChartEntryStmt,
+		upsertFantasySeasonStmt:               q.upsertFantasySeasonStmt,
 		upsertGameStmt:                        q.upsertGameStmt,
+		upsertInjuryStmt:                      q.upsertInjuryStmt,
 		upsertNFLPlayerStmt:                   q.upsertNFLPlayerStmt,
 		upsertNFLStatStmt:                     q.upsertNFLStatStmt,
 		upsertPlayerSeasonStmt:                q.upsertPlayerSeasonStmt,
+		upsertSeasonStatStmt:                  q.upsertSeasonStatStmt,
 	}
 }
@@ -53,7 +53,7 @@ func (q *Queries) DeleteGame(ctx context.Context, eventID int64) error {
 }
 
 const getAllGames = `-- name: GetAllGames :many
-SELECT event_id, date, name, short_name, season, week, away_team, home_team FROM nfl_games
+SELECT event_id, date, name, short_name, season, week, away_team, home_team, away_score, home_score, completed FROM nfl_games
 ORDER BY date DESC
 `
 
@@ -75,6 +75,9 @@ func (q *Queries) GetAllGames(ctx context.Context) ([]*NflGame, error) {
 			&i.Week,
 			&i.AwayTeam,
 			&i.HomeTeam,
+			&i.AwayScore,
+			&i.HomeScore,
+			&i.Completed,
 		); err != nil {
 			return nil, err
 		}
@@ -90,7 +93,7 @@ func (q *Queries) GetAllGames(ctx context.Context) ([]*NflGame, error) {
 }
 
 const getAllGamesBySeasonAndWeek = `-- name: GetAllGamesBySeasonAndWeek :many
-SELECT event_id, date, name, short_name, season, week, away_team, home_team FROM nfl_games
+SELECT event_id, date, name, short_name, season, week, away_team, home_team, away_score, home_score, completed FROM nfl_games
 WHERE season = ? AND week = ?
 ORDER BY date ASC
 `
@@ -118,6 +121,9 @@ func (q *Queries) GetAllGamesBySeasonAndWeek(ctx context.Context, arg GetAllGame
 			&i.Week,
 			&i.AwayTeam,
 			&i.HomeTeam,
+			&i.AwayScore,
+			&i.HomeScore,
+			&i.Completed,
 		); err != nil {
 			return nil, err
 		}
@@ -132,8 +138,71 @@ func (q *Queries) GetAllGamesBySeasonAndWeek(ctx context.Context, arg GetAllGame
 	return items, nil
 }
 
+const getCompletedGamesWithoutStats = `-- name: GetCompletedGamesWithoutStats :many
+SELECT event_id, date, name, short_name, season, week, away_team, home_team, away_score, home_score, completed FROM nfl_games
+WHERE season = ? AND completed
+  AND NOT EXISTS (SELECT 1 FROM nfl_stats WHERE nfl_stats.game_id = nfl_games.event_id)
+ORDER BY date ASC, event_id ASC
+`
+
+// Get a season's final games that don't have any stats yet
+func (q *Queries) GetCompletedGamesWithoutStats(ctx context.Context, season int64) ([]*NflGame, error) {
+	rows, err := q.query(ctx, q.getCompletedGamesWithoutStatsStmt, getCompletedGamesWithoutStats, season)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*NflGame{}
+	for rows.Next() {
+		var i NflGame
+		if err := rows.Scan(
+			&i.EventID,
+			&i.Date,
+			&i.Name,
+			&i.ShortName,
+			&i.Season,
+			&i.Week,
+			&i.AwayTeam,
+			&i.HomeTeam,
+			&i.AwayScore,
+			&i.HomeScore,
+			&i.Completed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFirstWeek = `-- name: GetFirstWeek :one
+SELECT season, week FROM nfl_games
+WHERE season = ?
+ORDER BY date ASC, week ASC
+LIMIT 1
+`
+
+type GetFirstWeekRow struct {
+	Season int64 `json:"season"`
+	Week   int64 `json:"week"`
+}
+
+// Get the week of the earliest game in a season
+func (q *Queries) GetFirstWeek(ctx context.Context, season int64) (*GetFirstWeekRow, error) {
+	row := q.queryRow(ctx, q.getFirstWeekStmt, getFirstWeek, season)
+	var i GetFirstWeekRow
+	err := row.Scan(&i.Season, &i.Week)
+	return &i, err
+}
+
 const getGame = `-- name: GetGame :one
-SELECT event_id, date, name, short_name, season, week, away_team, home_team FROM nfl_games
+SELECT event_id, date, name, short_name, season, week, away_team, home_team, away_score, home_score, completed FROM nfl_games
 WHERE event_id = ?
 `
 
@@ -149,10 +218,92 @@ func (q *Queries) GetGame(ctx context.Context, eventID int64) (*NflGame, error)
 		&i.Week,
 		&i.AwayTeam,
 		&i.HomeTeam,
+		&i.AwayScore,
+		&i.HomeScore,
+		&i.Completed,
 	)
 	return &i, err
 }
 
+const getLatestWeekByDate = `-- name: GetLatestWeekByDate :one
+SELECT season, week FROM nfl_games
+WHERE date <= ?
+ORDER BY date DESC, week DESC
+LIMIT 1
+`
+
+type GetLatestWeekByDateRow struct {
+	Season int64 `json:"season"`
+	Week   int64 `json:"week"`
+}
+
+// Get the week of the latest game scheduled on or before a date
+func (q *Queries) GetLatestWeekByDate(ctx context.Context, date string) (*GetLatestWeekByDateRow, error) {
+	row := q.queryRow(ctx, q.getLatestWeekByDateStmt, getLatestWeekByDate, date)
+	var i GetLatestWeekByDateRow
+	err := row.Scan(&i.Season, &i.Week)
+	return &i, err
+}
+
+const getSeasons = `-- name: GetSeasons :many
+SELECT DISTINCT season FROM nfl_games
+ORDER BY season DESC
+`
+
+// Get every season with scheduled games, newest first
+func (q *Queries) GetSeasons(ctx context.Context) ([]int64, error) {
+	rows, err := q.query(ctx, q.getSeasonsStmt, getSeasons)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var season int64
+		if err := rows.Scan(&season); err != nil {
+			return nil, err
+		}
+		items = append(items, season)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWeeksBySeason = `-- name: GetWeeksBySeason :many
+SELECT DISTINCT week FROM nfl_games
+WHERE season = ?
+ORDER BY week ASC
+`
+
+// Get every week with scheduled games in a season
+func (q *Queries) GetWeeksBySeason(ctx context.Context, season int64) ([]int64, error) {
+	rows, err := q.query(ctx, q.getWeeksBySeasonStmt, getWeeksBySeason, season)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var week int64
+		if err := rows.Scan(&week); err != nil {
+			return nil, err
+		}
+		items = append(items, week)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateGame = `-- name: UpdateGame :exec
 UPDATE nfl_games
 SET date = ?,
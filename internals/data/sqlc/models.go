@@ -8,15 +8,111 @@ import (
 	"database/sql"
 )
 
-type NflGame struct {
-	EventID   int64  `json:"event_id"`
-	Date      string `json:"date"`
+type ApiResponse struct {
+	Url          string         `json:"url"`
+	Endpoint     string         `json:"endpoint"`
+	Body         []byte         `json:"body"`
+	Etag         sql.NullString `json:"etag"`
+	LastModified sql.NullString `json:"last_modified"`
+	FetchedAt    string         `json:"fetched_at"`
+	ExpiresAt    sql.NullString `json:"expires_at"`
+}
+
+type FantasyLeague struct {
+	LeagueID  int64  `json:"league_id"`
 	Name      string `json:"name"`
-	ShortName string `json:"short_name"`
+	Rules     string `json:"rules"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type FantasySeason struct {
+	LeagueID  int64  `json:"league_id"`
 	Season    int64  `json:"season"`
+	Teams     string `json:"teams"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
 	Week      int64  `json:"week"`
-	AwayTeam  string `json:"away_team"`
-	HomeTeam  string `json:"home_team"`
+}
+
+type NflDepthChart struct {
+	Season   int64  `json:"season"`
+	Week     int64  `json:"week"`
+	TeamID   string `json:"team_id"`
+	Slot     string `json:"slot"`
+	Position string `json:"position"`
+	Depth    int64  `json:"depth"`
+	PlayerID string `json:"player_id"`
+}
+
+type NflDrive struct {
+	DriveID        string `json:"drive_id"`
+	GameID         int64  `json:"game_id"`
+	TeamID         string `json:"team_id"`
+	Sequence       int64  `json:"sequence"`
+	Period         int64  `json:"period"`
+	YardsToEndzone int64  `json:"yards_to_endzone"`
+	Yards          int64  `json:"yards"`
+	Plays          int64  `json:"plays"`
+	Result         string `json:"result"`
+	Scoring        bool   `json:"scoring"`
+}
+
+type NflGame struct {
+	EventID   int64         `json:"event_id"`
+	Date      string        `json:"date"`
+	Name      string        `json:"name"`
+	ShortName string        `json:"short_name"`
+	Season    int64         `json:"season"`
+	Week      int64         `json:"week"`
+	AwayTeam  string        `json:"away_team"`
+	HomeTeam  string        `json:"home_team"`
+	AwayScore sql.NullInt64 `json:"away_score"`
+	HomeScore sql.NullInt64 `json:"home_score"`
+	Completed bool          `json:"completed"`
+}
+
+type NflInjury struct {
+	PlayerID   string         `json:"player_id"`
+	Season     int64          `json:"season"`
+	Week       int64          `json:"week"`
+	TeamID     string         `json:"team_id"`
+	Status     string         `json:"status"`
+	BodyPart   sql.NullString `json:"body_part"`
+	Detail     sql.NullString `json:"detail"`
+	InjuryDate sql.NullString `json:"injury_date"`
+}
+
+type NflInjuryReport struct {
+	Season    int64  `json:"season"`
+	Week      int64  `json:"week"`
+	TeamID    string `json:"team_id"`
+	ScrapedAt string `json:"scraped_at"`
+}
+
+type NflPlay struct {
+	PlayID         string        `json:"play_id"`
+	GameID         int64         `json:"game_id"`
+	DriveID        string        `json:"drive_id"`
+	Sequence       int64         `json:"sequence"`
+	TeamID         string        `json:"team_id"`
+	Period         int64         `json:"period"`
+	Clock          string        `json:"clock"`
+	PlayType       string        `json:"play_type"`
+	Result         string        `json:"result"`
+	EspnType       string        `json:"espn_type"`
+	Down           sql.NullInt64 `json:"down"`
+	Distance       sql.NullInt64 `json:"distance"`
+	YardsToEndzone int64         `json:"yards_to_endzone"`
+	Yards          int64         `json:"yards"`
+	Scoring        bool          `json:"scoring"`
+	Description    string        `json:"description"`
+}
+
+type NflPlayPlayer struct {
+	PlayID   string `json:"play_id"`
+	PlayerID string `json:"player_id"`
+	Role     string `json:"role"`
 }
 
 type NflPlayer struct {
@@ -49,6 +145,15 @@ type NflPlayerSeason struct {
 	Status     sql.NullString `json:"status"`
 }
 
+type NflSeasonStat struct {
+	PlayerID   string  `json:"player_id"`
+	Season     int64   `json:"season"`
+	SeasonType int64   `json:"season_type"`
+	Category   string  `json:"category"`
+	StatType   string  `json:"stat_type"`
+	StatValue  float64 `json:"stat_value"`
+}
+
 type NflStat struct {
 	StatID    int64   `json:"stat_id"`
 	GameID    int64   `json:"game_id"`
@@ -72,3 +177,25 @@ type NflTeam struct {
 	SecondaryColor sql.NullString `json:"secondary_color"`
 	LogoUrl        sql.NullString `json:"logo_url"`
 }
+
+type ScrapeJob struct {
+	Scraper   string         `json:"scraper"`
+	Season    int64          `json:"season"`
+	Unit      string         `json:"unit"`
+	Status    string         `json:"status"`
+	Error     sql.NullString `json:"error"`
+	Attempts  int64          `json:"attempts"`
+	UpdatedAt string         `json:"updated_at"`
+}
+
+type ScrapeRun struct {
+	RunID      int64          `json:"run_id"`
+	Command    string         `json:"command"`
+	Seasons    string         `json:"seasons"`
+	Week       sql.NullInt64  `json:"week"`
+	Status     string         `json:"status"`
+	Summary    string         `json:"summary"`
+	Error      sql.NullString `json:"error"`
+	StartedAt  string         `json:"started_at"`
+	FinishedAt sql.NullString `json:"finished_at"`
+}
@@ -155,6 +155,43 @@ func (q *Queries) GetPlayerSeason(ctx context.Context, arg GetPlayerSeasonParams
 	return &i, err
 }
 
+const getPlayerSeasonsByPlayer = `-- name: GetPlayerSeasonsByPlayer :many
+SELECT player_id, season_year, team_id, jersey, active, experience, status FROM nfl_player_seasons
+WHERE player_id = ?
+ORDER BY season_year DESC
+`
+
+func (q *Queries) GetPlayerSeasonsByPlayer(ctx context.Context, playerID string) ([]*NflPlayerSeason, error) {
+	rows, err := q.query(ctx, q.getPlayerSeasonsByPlayerStmt, getPlayerSeasonsByPlayer, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*NflPlayerSeason{}
+	for rows.Next() {
+		var i NflPlayerSeason
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.SeasonYear,
+			&i.TeamID,
+			&i.Jersey,
+			&i.Active,
+			&i.Experience,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPlayerSeasonsByTeam = `-- name: GetPlayerSeasonsByTeam :many
 SELECT ps.player_id, ps.season_year, ps.team_id, ps.jersey, ps.active, ps.experience, ps.status
 FROM nfl_player_seasons ps
@@ -10,30 +10,92 @@ import (
 )
 
 type Querier interface {
+	AddPlayPlayer(ctx context.Context, arg AddPlayPlayerParams) error
+	CreateDrive(ctx context.Context, arg CreateDriveParams) error
+	CreateFantasyLeague(ctx context.Context, arg CreateFantasyLeagueParams) (*FantasyLeague, error)
 	CreateGame(ctx context.Context, arg CreateGameParams) error
 	CreateNFLPlayer(ctx context.Context, arg CreateNFLPlayerParams) error
 	CreateNFLStat(ctx context.Context, arg CreateNFLStatParams) error
 	CreateNFLTeam(ctx context.Context, arg CreateNFLTeamParams) error
+	CreatePlay(ctx context.Context, arg CreatePlayParams) error
 	CreatePlayerSeason(ctx context.Context, arg CreatePlayerSeasonParams) error
+	CreateScrapeRun(ctx context.Context, arg CreateScrapeRunParams) (*ScrapeRun, error)
+	DeleteAPIResponsesByEndpoint(ctx context.Context, endpoint string) (int64, error)
+	DeleteAllAPIResponses(ctx context.Context) (int64, error)
+	// Delete responses that expired before the given time
+	DeleteExpiredAPIResponses(ctx context.Context, expiresAt sql.NullString) (int64, error)
+	DeleteFantasyLeague(ctx context.Context, leagueID int64) error
 	DeleteGame(ctx context.Context, eventID int64) error
+	DeleteGameDrives(ctx context.Context, gameID int64) error
+	// Clear the players in a game's plays before saving its plays again
+	DeleteGamePlayPlayers(ctx context.Context, gameID int64) error
+	DeleteGamePlays(ctx context.Context, gameID int64) error
 	DeleteNFLPlayer(ctx context.Context, playerID string) error
 	DeleteNFLStat(ctx context.Context, statID int64) error
 	DeleteNFLTeam(ctx context.Context, teamID string) error
 	DeletePlayerSeason(ctx context.Context, arg DeletePlayerSeasonParams) error
+	// Clear a player's season totals before saving new ones
+	DeletePlayerSeasonStats(ctx context.Context, arg DeletePlayerSeasonStatsParams) error
+	// Clear a team's chart for a week before saving a new one
+	DeleteTeamDepthChart(ctx context.Context, arg DeleteTeamDepthChartParams) error
+	// Clear a team's report for a week before saving a new one
+	DeleteTeamInjuries(ctx context.Context, arg DeleteTeamInjuriesParams) error
+	FinishScrapeRun(ctx context.Context, arg FinishScrapeRunParams) error
+	GetAPIResponse(ctx context.Context, url string) (*ApiResponse, error)
+	// Count cached responses and their total size
+	GetAPIResponseSummary(ctx context.Context) (*GetAPIResponseSummaryRow, error)
 	GetActiveNFLPlayers(ctx context.Context) ([]*NflPlayer, error)
 	GetActivePlayerSeasonsByYear(ctx context.Context, seasonYear int64) ([]*NflPlayerSeason, error)
+	// Get every saved league, most recently updated first
+	GetAllFantasyLeagues(ctx context.Context) ([]*FantasyLeague, error)
 	GetAllGames(ctx context.Context) ([]*NflGame, error)
 	GetAllGamesBySeasonAndWeek(ctx context.Context, arg GetAllGamesBySeasonAndWeekParams) ([]*NflGame, error)
 	GetAllNFLPlayers(ctx context.Context) ([]*NflPlayer, error)
 	GetAllNFLTeams(ctx context.Context) ([]*NflTeam, error)
 	GetAllPlayerSeasons(ctx context.Context) ([]*NflPlayerSeason, error)
+	// Get a season's final games that don't have any stats yet
+	GetCompletedGamesWithoutStats(ctx context.Context, season int64) ([]*NflGame, error)
+	// Get every team's depth chart in effect for a week
+	GetDepthChartsByWeek(ctx context.Context, arg GetDepthChartsByWeekParams) ([]*NflDepthChart, error)
+	GetFantasyLeague(ctx context.Context, leagueID int64) (*FantasyLeague, error)
+	// Get every season a league has played, newest first
+	GetFantasySeasons(ctx context.Context, leagueID int64) ([]*FantasySeason, error)
+	// Get the week of the earliest game in a season
+	GetFirstWeek(ctx context.Context, season int64) (*GetFirstWeekRow, error)
 	GetGame(ctx context.Context, eventID int64) (*NflGame, error)
+	GetGameDrives(ctx context.Context, gameID int64) ([]*NflDrive, error)
+	// Get the players involved in each of a game's plays
+	GetGamePlayPlayers(ctx context.Context, gameID int64) ([]*NflPlayPlayer, error)
+	GetGamePlays(ctx context.Context, gameID int64) ([]*NflPlay, error)
+	// Get the scoring plays and two-point tries in a game, with each player's part in them (for play bonuses)
+	GetGameScoringPlays(ctx context.Context, gameID int64) ([]*GetGameScoringPlaysRow, error)
+	// Get every player's box score totals for each category and stat type in a season
+	GetGameStatTotalsBySeason(ctx context.Context, season int64) ([]*GetGameStatTotalsBySeasonRow, error)
 	GetGamesBySeason(ctx context.Context, season int64) ([]*NflGame, error)
+	// Get a player's injury designations, newest first
+	GetInjuriesByPlayer(ctx context.Context, playerID string) ([]*NflInjury, error)
+	// Get the injury reports in effect for a week: each team's latest one saved on or before it
+	GetInjuriesByWeek(ctx context.Context, arg GetInjuriesByWeekParams) ([]*NflInjury, error)
+	// Get the latest run of a command that succeeded
+	GetLastSuccessfulScrapeRun(ctx context.Context, command string) (*ScrapeRun, error)
+	// Get the week of the latest game scheduled on or before a date
+	GetLatestWeekByDate(ctx context.Context, date string) (*GetLatestWeekByDateRow, error)
 	GetNFLPlayer(ctx context.Context, playerID string) (*NflPlayer, error)
 	GetNFLTeam(ctx context.Context, teamID string) (*NflTeam, error)
+	// Get a player's slots on the depth charts in effect for a week, deepest last
+	GetPlayerDepth(ctx context.Context, arg GetPlayerDepthParams) ([]*NflDepthChart, error)
+	// Get how many games each player has box score stats for in a season
+	GetPlayerGameCountsBySeason(ctx context.Context, season int64) ([]*GetPlayerGameCountsBySeasonRow, error)
+	// Get every stat a player recorded in each game of a season
+	GetPlayerGameStatsBySeason(ctx context.Context, arg GetPlayerGameStatsBySeasonParams) ([]*GetPlayerGameStatsBySeasonRow, error)
+	// Get every play a player was involved in during a season, with their part in it
+	GetPlayerPlays(ctx context.Context, arg GetPlayerPlaysParams) ([]*GetPlayerPlaysRow, error)
 	GetPlayerSeason(ctx context.Context, arg GetPlayerSeasonParams) (*NflPlayerSeason, error)
+	// Get a player's season totals as ESPN reports them
+	GetPlayerSeasonStats(ctx context.Context, arg GetPlayerSeasonStatsParams) ([]*NflSeasonStat, error)
 	// Get seasonal stats for a player across multiple seasons (for comparison)
 	GetPlayerSeasonalStatsByType(ctx context.Context, arg GetPlayerSeasonalStatsByTypeParams) ([]*GetPlayerSeasonalStatsByTypeRow, error)
+	GetPlayerSeasonsByPlayer(ctx context.Context, playerID string) ([]*NflPlayerSeason, error)
 	GetPlayerSeasonsByTeam(ctx context.Context, arg GetPlayerSeasonsByTeamParams) ([]*NflPlayerSeason, error)
 	GetPlayerSeasonsByYear(ctx context.Context, seasonYear int64) ([]*NflPlayerSeason, error)
 	// Get the average of a specific stat type for a player
@@ -59,28 +121,66 @@ type Querier interface {
 	GetPlayerWeeklyStatByType(ctx context.Context, arg GetPlayerWeeklyStatByTypeParams) ([]*GetPlayerWeeklyStatByTypeRow, error)
 	GetPlayersByPosition(ctx context.Context, position string) ([]*NflPlayer, error)
 	GetPlayersByTeam(ctx context.Context, teamID sql.NullString) ([]*NflPlayer, error)
+	// Get every player's stats for each game of a season (for fantasy point rankings)
+	GetSeasonGameStats(ctx context.Context, season int64) ([]*GetSeasonGameStatsRow, error)
+	// Get the scoring plays and two-point tries in a season, with each player's part in them
+	GetSeasonScoringPlays(ctx context.Context, season int64) ([]*GetSeasonScoringPlaysRow, error)
+	// Get every player's season totals as ESPN reports them
+	GetSeasonStatsBySeason(ctx context.Context, arg GetSeasonStatsBySeasonParams) ([]*NflSeasonStat, error)
+	// Get every season with scheduled games, newest first
+	GetSeasons(ctx context.Context) ([]int64, error)
 	GetStatsByCategory(ctx context.Context, category string) ([]*NflStat, error)
 	GetStatsByGame(ctx context.Context, gameID int64) ([]*NflStat, error)
 	GetStatsByGameAndPlayer(ctx context.Context, arg GetStatsByGameAndPlayerParams) ([]*NflStat, error)
 	GetStatsByPlayer(ctx context.Context, playerID string) ([]*NflStat, error)
 	GetStatsByStatType(ctx context.Context, statType string) ([]*NflStat, error)
 	GetStatsByTeam(ctx context.Context, teamID string) ([]*NflStat, error)
+	// Get a team's depth chart in effect for a week: the latest one scraped on or before it
+	GetTeamDepthChart(ctx context.Context, arg GetTeamDepthChartParams) ([]*NflDepthChart, error)
+	// Get how many passes each team threw in each game of a season
+	GetTeamPassAttemptsBySeason(ctx context.Context, season int64) ([]*GetTeamPassAttemptsBySeasonRow, error)
 	// Get team-level stats for a specific season
 	GetTeamStatsBySeason(ctx context.Context, arg GetTeamStatsBySeasonParams) ([]*GetTeamStatsBySeasonRow, error)
 	GetTeamsByConference(ctx context.Context, conference string) ([]*NflTeam, error)
 	GetTeamsByDivision(ctx context.Context, division string) ([]*NflTeam, error)
 	// Get top N players for a specific stat type in a season
 	GetTopPlayersByStat(ctx context.Context, arg GetTopPlayersByStatParams) ([]*GetTopPlayersByStatRow, error)
+	// Get every player's stats for each game of a week (for fantasy scoreboards)
+	GetWeekGameStats(ctx context.Context, arg GetWeekGameStatsParams) ([]*GetWeekGameStatsRow, error)
+	// Get the scoring plays and two-point tries in a week of a season, with each player's part in them
+	GetWeekScoringPlays(ctx context.Context, arg GetWeekScoringPlaysParams) ([]*GetWeekScoringPlaysRow, error)
+	// Get every week with scheduled games in a season
+	GetWeeksBySeason(ctx context.Context, season int64) ([]int64, error)
+	// List cached responses without their bodies, most recently fetched first
+	ListAPIResponses(ctx context.Context) ([]*ListAPIResponsesRow, error)
+	ListScrapeJobsBySeason(ctx context.Context, season int64) ([]*ScrapeJob, error)
+	// List the latest runs, newest first
+	ListScrapeRuns(ctx context.Context, limit int64) ([]*ScrapeRun, error)
+	// Add a pending job, unless it's already in the ledger
+	QueueScrapeJob(ctx context.Context, arg QueueScrapeJobParams) error
+	RecordScrapeJob(ctx context.Context, arg RecordScrapeJobParams) error
+	// Mark a scraper's jobs in a season pending, so they're all done again
+	ResetScrapeJobs(ctx context.Context, arg ResetScrapeJobsParams) (int64, error)
+	// Record that a team's report was saved for a week, even if nobody was on it
+	SaveInjuryReport(ctx context.Context, arg SaveInjuryReportParams) error
 	SearchPlayers(ctx context.Context, arg SearchPlayersParams) ([]*NflPlayer, error)
+	UpdateFantasyLeague(ctx context.Context, arg UpdateFantasyLeagueParams) error
 	UpdateGame(ctx context.Context, arg UpdateGameParams) error
 	UpdateNFLPlayer(ctx context.Context, arg UpdateNFLPlayerParams) error
 	UpdateNFLStat(ctx context.Context, arg UpdateNFLStatParams) error
 	UpdateNFLTeam(ctx context.Context, arg UpdateNFLTeamParams) error
 	UpdatePlayerSeason(ctx context.Context, arg UpdatePlayerSeasonParams) error
+	// Save a response, replacing any earlier copy of the same URL
+	UpsertAPIResponse(ctx context.Context, arg UpsertAPIResponseParams) error
+	UpsertDepthChartEntry(ctx context.Context, arg UpsertDepthChartEntryParams) error
+	// Save a league's teams for a season, replacing any earlier draft
+	UpsertFantasySeason(ctx context.Context, arg UpsertFantasySeasonParams) error
 	UpsertGame(ctx context.Context, arg UpsertGameParams) error
+	UpsertInjury(ctx context.Context, arg UpsertInjuryParams) error
 	UpsertNFLPlayer(ctx context.Context, arg UpsertNFLPlayerParams) error
 	UpsertNFLStat(ctx context.Context, arg UpsertNFLStatParams) error
 	UpsertPlayerSeason(ctx context.Context, arg UpsertPlayerSeasonParams) error
+	UpsertSeasonStat(ctx context.Context, arg UpsertSeasonStatParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -50,7 +50,7 @@ func (q *Queries) DeleteNFLStat(ctx context.Context, statID int64) error {
 }
 
 const getGamesBySeason = `-- name: GetGamesBySeason :many
-SELECT event_id, date, name, short_name, season, week, away_team, home_team FROM nfl_games
+SELECT event_id, date, name, short_name, season, week, away_team, home_team, away_score, home_score, completed FROM nfl_games
 WHERE season = ?
 ORDER BY week, date
 `
@@ -73,6 +73,69 @@ func (q *Queries) GetGamesBySeason(ctx context.Context, season int64) ([]*NflGam
 			&i.Week,
 			&i.AwayTeam,
 			&i.HomeTeam,
+			&i.AwayScore,
+			&i.HomeScore,
+			&i.Completed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayerGameStatsBySeason = `-- name: GetPlayerGameStatsBySeason :many
+SELECT 
+  s.game_id,
+  g.week,
+  s.category,
+  s.stat_type,
+  s.stat_value
+FROM 
+  nfl_stats s
+JOIN 
+  nfl_games g ON s.game_id = g.event_id
+WHERE 
+  s.player_id = ? AND g.season = ?
+ORDER BY 
+  g.week, s.game_id, s.category, s.stat_type
+`
+
+type GetPlayerGameStatsBySeasonParams struct {
+	PlayerID string `json:"player_id"`
+	Season   int64  `json:"season"`
+}
+
+type GetPlayerGameStatsBySeasonRow struct {
+	GameID    int64   `json:"game_id"`
+	Week      int64   `json:"week"`
+	Category  string  `json:"category"`
+	StatType  string  `json:"stat_type"`
+	StatValue float64 `json:"stat_value"`
+}
+
+// Get every stat a player recorded in each game of a season
+func (q *Queries) GetPlayerGameStatsBySeason(ctx context.Context, arg GetPlayerGameStatsBySeasonParams) ([]*GetPlayerGameStatsBySeasonRow, error) {
+	rows, err := q.query(ctx, q.getPlayerGameStatsBySeasonStmt, getPlayerGameStatsBySeason, arg.PlayerID, arg.Season)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*GetPlayerGameStatsBySeasonRow{}
+	for rows.Next() {
+		var i GetPlayerGameStatsBySeasonRow
+		if err := rows.Scan(
+			&i.GameID,
+			&i.Week,
+			&i.Category,
+			&i.StatType,
+			&i.StatValue,
 		); err != nil {
 			return nil, err
 		}
@@ -601,6 +664,66 @@ func (q *Queries) GetPlayerWeeklyStatByType(ctx context.Context, arg GetPlayerWe
 	return items, nil
 }
 
+const getSeasonGameStats = `-- name: GetSeasonGameStats :many
+SELECT 
+  s.player_id,
+  p.position,
+  s.game_id,
+  s.category,
+  s.stat_type,
+  s.stat_value
+FROM 
+  nfl_stats s
+JOIN 
+  nfl_games g ON s.game_id = g.event_id
+JOIN 
+  nfl_players p ON s.player_id = p.player_id
+WHERE 
+  g.season = ?
+ORDER BY 
+  s.player_id, s.game_id
+`
+
+type GetSeasonGameStatsRow struct {
+	PlayerID  string  `json:"player_id"`
+	Position  string  `json:"position"`
+	GameID    int64   `json:"game_id"`
+	Category  string  `json:"category"`
+	StatType  string  `json:"stat_type"`
+	StatValue float64 `json:"stat_value"`
+}
+
+// Get every player's stats for each game of a season (for fantasy point rankings)
+func (q *Queries) GetSeasonGameStats(ctx context.Context, season int64) ([]*GetSeasonGameStatsRow, error) {
+	rows, err := q.query(ctx, q.getSeasonGameStatsStmt, getSeasonGameStats, season)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*GetSeasonGameStatsRow{}
+	for rows.Next() {
+		var i GetSeasonGameStatsRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.Position,
+			&i.GameID,
+			&i.Category,
+			&i.StatType,
+			&i.StatValue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getStatsByCategory = `-- name: GetStatsByCategory :many
 SELECT stat_id, game_id, player_id, team_id, category, stat_type, stat_value FROM nfl_stats
 WHERE category = ?
@@ -955,6 +1078,71 @@ func (q *Queries) GetTopPlayersByStat(ctx context.Context, arg GetTopPlayersBySt
 	return items, nil
 }
 
+const getWeekGameStats = `-- name: GetWeekGameStats :many
+SELECT 
+  s.player_id,
+  p.position,
+  s.game_id,
+  s.category,
+  s.stat_type,
+  s.stat_value
+FROM 
+  nfl_stats s
+JOIN 
+  nfl_games g ON s.game_id = g.event_id
+JOIN 
+  nfl_players p ON s.player_id = p.player_id
+WHERE 
+  g.season = ? AND g.week = ?
+ORDER BY 
+  s.player_id, s.game_id
+`
+
+type GetWeekGameStatsParams struct {
+	Season int64 `json:"season"`
+	Week   int64 `json:"week"`
+}
+
+type GetWeekGameStatsRow struct {
+	PlayerID  string  `json:"player_id"`
+	Position  string  `json:"position"`
+	GameID    int64   `json:"game_id"`
+	Category  string  `json:"category"`
+	StatType  string  `json:"stat_type"`
+	StatValue float64 `json:"stat_value"`
+}
+
+// Get every player's stats for each game of a week (for fantasy scoreboards)
+func (q *Queries) GetWeekGameStats(ctx context.Context, arg GetWeekGameStatsParams) ([]*GetWeekGameStatsRow, error) {
+	rows, err := q.query(ctx, q.getWeekGameStatsStmt, getWeekGameStats, arg.Season, arg.Week)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*GetWeekGameStatsRow{}
+	for rows.Next() {
+		var i GetWeekGameStatsRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.Position,
+			&i.GameID,
+			&i.Category,
+			&i.StatType,
+			&i.StatValue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateNFLStat = `-- name: UpdateNFLStat :exec
 UPDATE nfl_stats
 SET stat_value = ?
@@ -0,0 +1,210 @@
+package espn
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+)
+
+// CachedResponse is a response body saved by a Cache, along with the
+// validators the server sent for it
+type CachedResponse struct {
+	URL          string
+	Endpoint     string // Kind of request, e.g. "scoreboard" or "athlete"
+	Body         []byte
+	ETag         string
+	LastModified string
+	FetchedAt    time.Time // When the body was downloaded or last revalidated
+	ExpiresAt    time.Time // When to revalidate it, or zero if never
+}
+
+// Fresh reports whether the response can be used without asking the server
+func (r *CachedResponse) Fresh(now time.Time) bool {
+	return r.ExpiresAt.IsZero() || now.Before(r.ExpiresAt)
+}
+
+// Cache stores raw responses so a client only downloads what changed. A
+// client logs cache errors and carries on without it.
+type Cache interface {
+	// Get returns the response saved for url, or nil if there isn't one
+	Get(ctx context.Context, url string) (*CachedResponse, error)
+	Put(ctx context.Context, response *CachedResponse) error
+}
+
+// Endpoints a cached response can be for
+const (
+	EndpointScoreboard = "scoreboard" // A week's games
+	EndpointSummary    = "summary"    // A game's box score
+	EndpointTeams      = "teams"      // A page of the team list
+	EndpointTeam       = "team"       // A team's details
+	EndpointRoster     = "roster"     // A team's athletes in a season
+	EndpointAthlete    = "athlete"    // A player's details
+	EndpointInjuries   = "injuries"   // A team's injury report or an entry on it
+	EndpointDepthChart = "depthchart" // A team's depth charts in a season
+	EndpointStatistics = "statistics" // A player's season totals
+	EndpointOther      = "other"
+)
+
+// endpointPatterns match paths after a base URL to endpoints, with * matching
+// any one segment
+var endpointPatterns = []struct {
+	api, pattern, endpoint string
+}{
+	{"site", "scoreboard", EndpointScoreboard},
+	{"site", "summary", EndpointSummary},
+	{"site", "teams/*", EndpointTeam},
+	{"core", "teams", EndpointTeams},
+	{"core", "seasons/*/teams/*/athletes", EndpointRoster},
+	{"core", "athletes/*", EndpointAthlete},
+	{"core", "teams/*/injuries", EndpointInjuries},
+	{"core", "athletes/*/injuries/*", EndpointInjuries},
+	{"core", "seasons/*/teams/*/depthcharts", EndpointDepthChart},
+	{"core", "seasons/*/types/*/athletes/*/statistics", EndpointStatistics},
+}
+
+// Endpoint returns which endpoint a URL under the client's base URLs is for
+func (c *Client) Endpoint(rawURL string) string {
+	api, path, _, ok := c.splitURL(rawURL)
+	if !ok {
+		return EndpointOther
+	}
+	for _, p := range endpointPatterns {
+		if p.api == api && matchPath(p.pattern, path) {
+			return p.endpoint
+		}
+	}
+	return EndpointOther
+}
+
+// matchPath reports whether a slash-separated path matches a pattern
+func matchPath(pattern, path string) bool {
+	patternParts, pathParts := strings.Split(pattern, "/"), strings.Split(path, "/")
+	if len(patternParts) != len(pathParts) {
+		return false
+	}
+	for i, part := range patternParts {
+		if part != "*" && part != pathParts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// CachePolicy decides how long cached responses stay fresh before they're
+// revalidated. A TTL of zero never expires.
+type CachePolicy struct {
+	TTLs    map[string]time.Duration // By endpoint
+	Default time.Duration            // For endpoints without a TTL
+	// Final replaces the TTL of scoreboards and summaries once all of their
+	// games are final, since finished games don't change
+	Final time.Duration
+}
+
+// DefaultCachePolicy keeps finished games forever and anything still in
+// play for a few minutes
+func DefaultCachePolicy() CachePolicy {
+	return CachePolicy{
+		TTLs: map[string]time.Duration{
+			EndpointScoreboard: 5 * time.Minute,
+			EndpointSummary:    5 * time.Minute,
+			EndpointTeams:      7 * 24 * time.Hour,
+			EndpointTeam:       7 * 24 * time.Hour,
+			EndpointRoster:     24 * time.Hour,
+			EndpointAthlete:    24 * time.Hour,
+			EndpointInjuries:   time.Hour,
+			EndpointDepthChart: 24 * time.Hour,
+			EndpointStatistics: 24 * time.Hour,
+		},
+		Default: 24 * time.Hour,
+		Final:   0,
+	}
+}
+
+// TTL returns how long a response from an endpoint stays fresh
+func (p CachePolicy) TTL(endpoint string, body []byte) time.Duration {
+	if (endpoint == EndpointScoreboard || endpoint == EndpointSummary) && gamesFinal(endpoint, body) {
+		return p.Final
+	}
+	if ttl, ok := p.TTLs[endpoint]; ok {
+		return ttl
+	}
+	return p.Default
+}
+
+// gameStatus is the part of a game's status the policy needs
+type gameStatus struct {
+	Status struct {
+		Type struct {
+			Completed bool `json:"completed"`
+		} `json:"type"`
+	} `json:"status"`
+}
+
+// gamesFinal reports whether every game in a scoreboard or summary is over
+func gamesFinal(endpoint string, body []byte) bool {
+	var games []gameStatus
+	if endpoint == EndpointScoreboard {
+		var scoreboard struct {
+			Events []gameStatus `json:"events"`
+		}
+		if err := json.Unmarshal(body, &scoreboard); err != nil {
+			return false
+		}
+		games = scoreboard.Events
+	} else {
+		var summary struct {
+			Header struct {
+				Competitions []gameStatus `json:"competitions"`
+			} `json:"header"`
+		}
+		if err := json.Unmarshal(body, &summary); err != nil {
+			return false
+		}
+		games = summary.Header.Competitions
+	}
+
+	for _, game := range games {
+		if !game.Status.Type.Completed {
+			return false
+		}
+	}
+	return len(games) > 0
+}
+
+// cached returns the cached response for url, or nil if there's no cache
+// or no response
+func (c *Client) cached(ctx context.Context, url string) *CachedResponse {
+	if c.config.Cache == nil {
+		return nil
+	}
+	response, err := c.config.Cache.Get(ctx, url)
+	if err != nil {
+		log.Printf("Warning: error reading %s from the cache: %v", url, err)
+		return nil
+	}
+	return response
+}
+
+// store saves a downloaded or revalidated response to the cache
+func (c *Client) store(ctx context.Context, url string, body []byte, etag, lastModified string) {
+	if c.config.Cache == nil {
+		return
+	}
+	now := time.Now().UTC()
+	response := &CachedResponse{
+		URL:          url,
+		Endpoint:     c.Endpoint(url),
+		Body:         body,
+		ETag:         etag,
+		LastModified: lastModified,
+		FetchedAt:    now,
+	}
+	if ttl := c.config.CachePolicy.TTL(response.Endpoint, body); ttl > 0 {
+		response.ExpiresAt = now.Add(ttl)
+	}
+	if err := c.config.Cache.Put(ctx, response); err != nil {
+		log.Printf("Warning: error caching %s: %v", url, err)
+	}
+}
@@ -0,0 +1,128 @@
+package espn
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// memoryCache is a Cache in a map
+type memoryCache struct {
+	mu        sync.Mutex
+	responses map[string]*CachedResponse
+}
+
+func (c *memoryCache) Get(ctx context.Context, url string) (*CachedResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.responses[url], nil
+}
+
+func (c *memoryCache) Put(ctx context.Context, response *CachedResponse) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses[response.URL] = response
+	return nil
+}
+
+func TestConditionalRequests(t *testing.T) {
+	var calls, conditional atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditional.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Write([]byte(`{"team": {"id": "1", "abbreviation": "ATL"}}`))
+	}))
+	defer server.Close()
+
+	cache := &memoryCache{responses: make(map[string]*CachedResponse)}
+	config := testClient(server).Config()
+	config.Cache = cache
+	client := NewClient(config)
+	ctx := context.Background()
+
+	// The first request is downloaded and cached, the second is fresh
+	for range 2 {
+		if team, err := client.Team(ctx, "1"); err != nil || team.Abbreviation != "ATL" {
+			t.Fatalf("Expected ATL, got %+v (%v)", team, err)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected a fresh cached response not to be requested, got %d requests", calls.Load())
+	}
+	cached := cache.responses[server.URL+"/teams/1"]
+	if cached == nil || cached.Endpoint != EndpointTeam || cached.ETag != `"v1"` || cached.Fresh(time.Now().Add(8*24*time.Hour)) {
+		t.Fatalf("Expected a team cached with its ETag for a week, got %+v", cached)
+	}
+
+	// Once it expires it's revalidated, and a 304 keeps the cached body
+	cached.ExpiresAt = time.Now().Add(-time.Minute)
+	if team, err := client.Team(ctx, "1"); err != nil || team.Abbreviation != "ATL" {
+		t.Fatalf("Expected ATL from the cache, got %+v (%v)", team, err)
+	}
+	if conditional.Load() != 1 {
+		t.Errorf("Expected an expired response to be revalidated")
+	}
+	if refreshed := cache.responses[server.URL+"/teams/1"]; !refreshed.Fresh(time.Now()) || string(refreshed.Body) == "" {
+		t.Errorf("Expected a revalidated response to be fresh again, got %+v", refreshed)
+	}
+
+	metrics := client.Metrics()
+	if metrics.Requests != 2 || metrics.CacheHits != 1 || metrics.NotModified != 1 {
+		t.Errorf("Expected 2 requests, a cache hit and a 304, got %+v", metrics)
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	client := NewClient(Config{SiteURL: "http://espn.test/site", CoreURL: "http://espn.test/core"})
+	tests := map[string]string{
+		"http://espn.test/site/scoreboard?dates=2024&seasontype=2&week=1":      EndpointScoreboard,
+		"http://espn.test/site/summary?event=401671744":                        EndpointSummary,
+		"http://espn.test/site/teams/1":                                        EndpointTeam,
+		"http://espn.test/core/teams?page=1":                                   EndpointTeams,
+		"http://espn.test/core/seasons/2024/teams/1/athletes?limit=200":        EndpointRoster,
+		"http://espn.test/core/athletes/3139477":                               EndpointAthlete,
+		"http://espn.test/core/athletes/3139477/statistics":                    EndpointOther,
+		"http://espn.test/core/teams/1/injuries?limit=200":                     EndpointInjuries,
+		"http://espn.test/core/athletes/3139477/injuries/-1234":                EndpointInjuries,
+		"http://espn.test/core/seasons/2024/teams/1/depthcharts":               EndpointDepthChart,
+		"http://espn.test/core/seasons/2024/types/2/athletes/14880/statistics": EndpointStatistics,
+		"http://elsewhere.test/site/teams/1":                                   EndpointOther,
+	}
+	for url, expected := range tests {
+		if got := client.Endpoint(url); got != expected {
+			t.Errorf("Expected %s to be %q, got %q", url, expected, got)
+		}
+	}
+}
+
+func TestCachePolicy(t *testing.T) {
+	policy := DefaultCachePolicy()
+	tests := []struct {
+		endpoint string
+		body     string
+		ttl      time.Duration
+	}{
+		{EndpointScoreboard, `{"events": [{"status": {"type": {"completed": true}}}, {"status": {"type": {"completed": true}}}]}`, 0},
+		{EndpointScoreboard, `{"events": [{"status": {"type": {"completed": true}}}, {"status": {"type": {"completed": false}}}]}`, 5 * time.Minute},
+		{EndpointScoreboard, `{"events": []}`, 5 * time.Minute},
+		{EndpointSummary, `{"header": {"competitions": [{"status": {"type": {"completed": true}}}]}}`, 0},
+		{EndpointSummary, `{"header": {"competitions": [{"status": {"type": {"completed": false}}}]}}`, 5 * time.Minute},
+		{EndpointSummary, `not JSON`, 5 * time.Minute},
+		{EndpointAthlete, `{}`, 24 * time.Hour},
+		{EndpointOther, `{}`, 24 * time.Hour},
+	}
+	for _, test := range tests {
+		if ttl := policy.TTL(test.endpoint, []byte(test.body)); ttl != test.ttl {
+			t.Errorf("Expected %s %s to stay fresh for %s, got %s", test.endpoint, test.body, test.ttl, ttl)
+		}
+	}
+}
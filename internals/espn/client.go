@@ -0,0 +1,374 @@
+// Package espn is a client for the ESPN APIs the scrapers load NFL data from
+package espn
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Default base URLs of the two ESPN APIs
+const (
+	DefaultSiteURL = "https://site.api.espn.com/apis/site/v2/sports/football/nfl"
+	DefaultCoreURL = "https://sports.core.api.espn.com/v2/sports/football/leagues/nfl"
+)
+
+// Config configures a Client
+type Config struct {
+	SiteURL    string        // Base URL of the site API: scoreboards, teams and game summaries
+	CoreURL    string        // Base URL of the core API: team lists, rosters and athletes
+	Timeout    time.Duration // Timeout for a single request, including reading the body
+	MaxRetries int           // Retries after a throttled, failed or 5xx request
+	MinBackoff time.Duration // Wait before the first retry, doubled for each one after
+	MaxBackoff time.Duration // Longest wait between retries, including Retry-After
+	RateLimit  float64       // Requests per second across everything using the client
+	Burst      int           // Requests allowed at once before the rate limit applies
+	UserAgent  string
+	HTTPClient *http.Client // Defaults to a client with Timeout
+	RecordDir  string       // Saves every successful response here as a fixture, when set
+
+	Cache       Cache       // Saves raw responses so unchanged ones aren't downloaded again
+	CachePolicy CachePolicy // How long cached responses stay fresh
+	Revalidate  bool        // Checks every cached response with the server, however fresh
+}
+
+// DefaultConfig returns the settings the scrapers use. The base URLs can be
+// overridden with $GRIDIRONGO_ESPN_SITE_URL and $GRIDIRONGO_ESPN_CORE_URL.
+func DefaultConfig() Config {
+	config := Config{
+		SiteURL:     DefaultSiteURL,
+		CoreURL:     DefaultCoreURL,
+		Timeout:     30 * time.Second,
+		MaxRetries:  4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		RateLimit:   50,
+		Burst:       10,
+		UserAgent:   "Mozilla/5.0 (Windows NT 10.0; Win64; x64)",
+		CachePolicy: DefaultCachePolicy(),
+	}
+	if url := os.Getenv("GRIDIRONGO_ESPN_SITE_URL"); url != "" {
+		config.SiteURL = url
+	}
+	if url := os.Getenv("GRIDIRONGO_ESPN_CORE_URL"); url != "" {
+		config.CoreURL = url
+	}
+	return config
+}
+
+// Client makes rate limited requests to the ESPN APIs, retrying failures
+// with exponential backoff. It is safe for concurrent use, and every
+// request made through it shares one rate limit.
+type Client struct {
+	config  Config
+	http    *http.Client
+	limiter *rate.Limiter
+
+	mu      sync.Mutex
+	metrics Metrics
+}
+
+// NewClient creates a client, filling in defaults for unset config fields
+func NewClient(config Config) *Client {
+	defaults := DefaultConfig()
+	if config.SiteURL == "" {
+		config.SiteURL = defaults.SiteURL
+	}
+	if config.CoreURL == "" {
+		config.CoreURL = defaults.CoreURL
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaults.Timeout
+	}
+	if config.MaxRetries < 0 {
+		config.MaxRetries = 0
+	}
+	if config.MinBackoff <= 0 {
+		config.MinBackoff = defaults.MinBackoff
+	}
+	if config.MaxBackoff < config.MinBackoff {
+		config.MaxBackoff = max(defaults.MaxBackoff, config.MinBackoff)
+	}
+	if config.RateLimit <= 0 {
+		config.RateLimit = defaults.RateLimit
+	}
+	if config.Burst <= 0 {
+		config.Burst = 1
+	}
+	if config.CachePolicy.TTLs == nil {
+		config.CachePolicy = defaults.CachePolicy
+	}
+	config.SiteURL = strings.TrimSuffix(config.SiteURL, "/")
+	config.CoreURL = strings.TrimSuffix(config.CoreURL, "/")
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: config.Timeout}
+	}
+	return &Client{
+		config:  config,
+		http:    httpClient,
+		limiter: rate.NewLimiter(rate.Limit(config.RateLimit), config.Burst),
+		metrics: Metrics{Statuses: make(map[int]int64)},
+	}
+}
+
+// Config returns the client's settings, with defaults filled in
+func (c *Client) Config() Config {
+	return c.config
+}
+
+// StatusError is returned for a response that isn't 200 OK once retries
+// are used up, or straight away for statuses that aren't worth retrying
+type StatusError struct {
+	URL        string
+	StatusCode int
+	Body       string // Start of the response body
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("API returned non-OK status: %d for %s. Response: %s", e.StatusCode, e.URL, e.Body)
+}
+
+// IsNotFound reports whether err is a 404 from the API
+func IsNotFound(err error) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound
+}
+
+// retryable reports whether a request that got status should be tried again
+func retryable(status int) bool {
+	return status == http.StatusTooManyRequests || status == http.StatusRequestTimeout || status >= 500
+}
+
+// getJSON fetches url and decodes its JSON body into v
+func (c *Client) getJSON(ctx context.Context, url string, v any) error {
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to decode JSON response from %s: %w", url, err)
+	}
+	return nil
+}
+
+// get fetches url and returns its body, retrying throttled requests, 5xx
+// responses and network errors. Fresh cached responses are returned without
+// a request, and stale ones are revalidated with the server.
+func (c *Client) get(ctx context.Context, url string) ([]byte, error) {
+	cached := c.cached(ctx, url)
+	if cached != nil && !c.config.Revalidate && cached.Fresh(time.Now()) {
+		c.record(func(m *Metrics) { m.CacheHits++ })
+		c.recordFixture(url, cached.Body)
+		return cached.Body, nil
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limiter error: %w", err)
+		}
+
+		resp, retryAfter, err := c.do(ctx, url, cached)
+		if err == nil {
+			if resp.notModified {
+				c.record(func(m *Metrics) { m.NotModified++ })
+				resp.body = cached.Body
+				resp.etag = cmp.Or(resp.etag, cached.ETag)
+				resp.lastModified = cmp.Or(resp.lastModified, cached.LastModified)
+			}
+			c.store(ctx, url, resp.body, resp.etag, resp.lastModified)
+			c.recordFixture(url, resp.body)
+			return resp.body, nil
+		}
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("request cancelled: %w", ctx.Err())
+		}
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) && !retryable(statusErr.StatusCode) {
+			c.record(func(m *Metrics) { m.Failures++ })
+			return nil, err
+		}
+		if attempt >= c.config.MaxRetries {
+			c.record(func(m *Metrics) { m.Failures++ })
+			return nil, fmt.Errorf("giving up after %d attempts: %w", attempt+1, err)
+		}
+
+		wait := c.backoff(attempt, retryAfter)
+		log.Printf("Retrying %s in %s after: %v", url, wait.Round(time.Millisecond), err)
+		c.record(func(m *Metrics) { m.Retries++ })
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("request cancelled: %w", ctx.Err())
+		case <-time.After(wait):
+		}
+	}
+}
+
+// response is a successful reply to a request
+type response struct {
+	body         []byte
+	etag         string
+	lastModified string
+	notModified  bool // The cached copy is still current, and body is empty
+}
+
+// do makes a single request, conditional on the cached copy if there is
+// one. It returns a 200 or 304 response, or the Retry-After delay the
+// server asked for along with the error.
+func (c *Client) do(ctx context.Context, url string, cached *CachedResponse) (*response, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.config.UserAgent)
+	req.Header.Set("Accept", "application/json")
+	if cached != nil {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		c.record(func(m *Metrics) {
+			m.Requests++
+			m.NetworkErrors++
+			m.Latency += time.Since(start)
+		})
+		return nil, 0, fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	c.record(func(m *Metrics) {
+		m.Requests++
+		m.Statuses[resp.StatusCode]++
+		m.Bytes += int64(len(body))
+		m.Latency += time.Since(start)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	result := &response{
+		body:         body,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+	}
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return result, 0, nil
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		result.body, result.notModified = nil, true
+		return result, 0, nil
+	}
+
+	snippet := string(body)
+	if len(snippet) > 200 {
+		snippet = snippet[:200] + "..."
+	}
+	statusErr := &StatusError{URL: url, StatusCode: resp.StatusCode, Body: snippet}
+	return nil, parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()), statusErr
+}
+
+// backoff returns how long to wait before retry number attempt: the server's
+// Retry-After if it sent one, otherwise an exponential delay with jitter.
+// Both are capped at MaxBackoff.
+func (c *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return min(retryAfter, c.config.MaxBackoff)
+	}
+	delay := c.config.MaxBackoff
+	if attempt < 30 {
+		delay = min(c.config.MinBackoff<<attempt, c.config.MaxBackoff)
+	}
+	// Wait between half and all of the delay so workers don't retry in step
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// parseRetryAfter reads a Retry-After header, given in seconds or as an
+// HTTP date. It returns 0 when the header is missing or invalid.
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(strings.TrimSpace(header)); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(date.Sub(now), 0)
+	}
+	return 0
+}
+
+// Metrics counts the requests a client has made
+type Metrics struct {
+	Requests      int64         // HTTP requests sent, retries included
+	Retries       int64         // Requests that were retried
+	Failures      int64         // Calls that gave up with an error
+	NetworkErrors int64         // Requests that got no response
+	Statuses      map[int]int64 // Responses by status code
+	Bytes         int64         // Response body bytes read
+	Latency       time.Duration // Total time spent waiting on responses
+	CacheHits     int64         // Calls answered from the cache without a request
+	NotModified   int64         // Cached responses the server said were unchanged
+}
+
+// record updates the metrics under the client's lock
+func (c *Client) record(update func(m *Metrics)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	update(&c.metrics)
+}
+
+// Metrics returns a snapshot of the client's request metrics
+func (c *Client) Metrics() Metrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	metrics := c.metrics
+	metrics.Statuses = make(map[int]int64, len(c.metrics.Statuses))
+	for status, count := range c.metrics.Statuses {
+		metrics.Statuses[status] = count
+	}
+	return metrics
+}
+
+// String summarizes the metrics on one line
+func (m Metrics) String() string {
+	average := time.Duration(0)
+	if m.Requests > 0 {
+		average = m.Latency / time.Duration(m.Requests)
+	}
+	return fmt.Sprintf("%d requests, %d retries (%d throttled, %d server errors, %d network errors), %d failed, %.1f MB, %s average latency, %d cached, %d not modified",
+		m.Requests, m.Retries, m.Statuses[http.StatusTooManyRequests], m.serverErrors(), m.NetworkErrors, m.Failures,
+		float64(m.Bytes)/(1<<20), average.Round(time.Millisecond), m.CacheHits, m.NotModified)
+}
+
+// serverErrors counts 5xx responses
+func (m Metrics) serverErrors() int64 {
+	var count int64
+	for status, n := range m.Statuses {
+		if status >= 500 {
+			count += n
+		}
+	}
+	return count
+}
@@ -0,0 +1,151 @@
+package espn
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testClient returns a client for server with short backoffs
+func testClient(server *httptest.Server) *Client {
+	return NewClient(Config{
+		SiteURL:    server.URL,
+		CoreURL:    server.URL,
+		MaxRetries: 2,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+		RateLimit:  1000,
+	})
+}
+
+func TestRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.Write([]byte(`{"team": {"id": "1", "abbreviation": "ATL"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	team, err := client.Team(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if team.Abbreviation != "ATL" {
+		t.Errorf("Expected ATL, got %q", team.Abbreviation)
+	}
+
+	metrics := client.Metrics()
+	if metrics.Requests != 3 || metrics.Retries != 2 || metrics.Failures != 0 {
+		t.Errorf("Expected 3 requests and 2 retries, got %+v", metrics)
+	}
+	if metrics.Statuses[http.StatusTooManyRequests] != 1 || metrics.Statuses[http.StatusBadGateway] != 1 {
+		t.Errorf("Expected a 429 and a 502 to be counted, got %v", metrics.Statuses)
+	}
+}
+
+func TestGivesUp(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.URL.Path == "/athletes/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	if _, err := client.Athlete(context.Background(), "1"); err == nil {
+		t.Errorf("Expected an error after retries were used up")
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected the first try and 2 retries, got %d requests", calls.Load())
+	}
+
+	// A 404 isn't retried
+	calls.Store(0)
+	_, err := client.Athlete(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected a 404 not to be retried, got %d requests", calls.Load())
+	}
+	if failures := client.Metrics().Failures; failures != 2 {
+		t.Errorf("Expected 2 failures, got %d", failures)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	client := NewClient(Config{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second})
+
+	for attempt, delay := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		for range 20 {
+			if wait := client.backoff(attempt, 0); wait < delay/2 || wait > delay {
+				t.Errorf("Expected retry %d to wait between %s and %s, got %s", attempt, delay/2, delay, wait)
+			}
+		}
+	}
+
+	if wait := client.backoff(0, 300*time.Millisecond); wait != 300*time.Millisecond {
+		t.Errorf("Expected Retry-After to set the wait, got %s", wait)
+	}
+	if wait := client.backoff(0, time.Hour); wait != time.Second {
+		t.Errorf("Expected Retry-After to be capped at the max backoff, got %s", wait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 9, 8, 12, 0, 0, 0, time.UTC)
+	tests := map[string]time.Duration{
+		"":                              0,
+		"5":                             5 * time.Second,
+		"soon":                          0,
+		"Sun, 08 Sep 2024 12:00:30 GMT": 30 * time.Second,
+		"Sun, 08 Sep 2024 11:00:00 GMT": 0,
+	}
+	for header, expected := range tests {
+		if got := parseRetryAfter(header, now); got != expected {
+			t.Errorf("Expected %q to wait %s, got %s", header, expected, got)
+		}
+	}
+}
+
+func TestRefID(t *testing.T) {
+	tests := map[string]string{
+		"http://sports.core.api.espn.com/v2/sports/football/leagues/nfl/teams/12?lang=en&region=us": "12",
+		"http://sports.core.api.espn.com/v2/sports/football/leagues/nfl/athletes/3139477":           "3139477",
+		"": "",
+	}
+	for ref, expected := range tests {
+		if got := RefID(ref); got != expected {
+			t.Errorf("Expected %q from %q, got %q", expected, ref, got)
+		}
+	}
+}
+
+func TestRefPathID(t *testing.T) {
+	ref := "http://sports.core.api.espn.com/v2/sports/football/leagues/nfl/athletes/4430807/injuries/-10450?lang=en&region=us"
+	tests := map[string]string{
+		"athletes": "4430807",
+		"injuries": "-10450",
+		"teams":    "",
+	}
+	for collection, expected := range tests {
+		if got := RefPathID(ref, collection); got != expected {
+			t.Errorf("Expected %q after %s, got %q", expected, collection, got)
+		}
+	}
+}
@@ -0,0 +1,483 @@
+package espn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ScoreboardResponse is a week of games from the site API's scoreboard
+type ScoreboardResponse struct {
+	Events []Event `json:"events"`
+}
+
+// Event is a game on the scoreboard
+type Event struct {
+	ID           string        `json:"id"`
+	Date         string        `json:"date"`
+	Name         string        `json:"name"`
+	ShortName    string        `json:"shortName"`
+	Season       Season        `json:"season"`
+	Week         Week          `json:"week"`
+	Competitions []Competition `json:"competitions"`
+	Status       EventStatus   `json:"status"`
+}
+
+// Competition is the matchup within a game event
+type Competition struct {
+	Competitors []Competitor `json:"competitors"`
+}
+
+// Competitor is one team's side of a competition
+type Competitor struct {
+	HomeAway string `json:"homeAway"` // "home" or "away"
+	Score    string `json:"score"`
+}
+
+// EventStatus is the progress of a game event
+type EventStatus struct {
+	Type struct {
+		State     string `json:"state"` // "pre", "in" or "post"
+		Completed bool   `json:"completed"`
+	} `json:"type"`
+}
+
+// Season is an event's season
+type Season struct {
+	Year int `json:"year"`
+}
+
+// Week is an event's week of the season
+type Week struct {
+	Number int `json:"number"`
+}
+
+// Ref is a link to another core API resource
+type Ref struct {
+	Ref string `json:"$ref"`
+	ID  string `json:"id"`
+}
+
+// RefID returns the ID at the end of a core API reference URL, e.g. "12" for
+// ".../teams/12?lang=en"
+func RefID(ref string) string {
+	ref, _, _ = strings.Cut(ref, "?")
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// RefPathID returns the ID following a collection in a core API reference
+// URL, e.g. "3139477" for collection "athletes" in
+// ".../athletes/3139477/injuries/-1234", or "" if the collection isn't in it
+func RefPathID(ref, collection string) string {
+	ref, _, _ = strings.Cut(ref, "?")
+	parts := strings.Split(ref, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == collection {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+// RefList is a page of references from the core API
+type RefList struct {
+	Items     []Ref `json:"items"`
+	PageIndex int   `json:"pageIndex"`
+	PageCount int   `json:"pageCount"`
+}
+
+// TeamDetails is a team from the site API
+type TeamDetails struct {
+	ID             string       `json:"id"`
+	UID            string       `json:"uid"`
+	Slug           string       `json:"slug"`
+	Abbreviation   string       `json:"abbreviation"`
+	DisplayName    string       `json:"displayName"`
+	ShortName      string       `json:"shortName"`
+	Name           string       `json:"name"`
+	Nickname       string       `json:"nickname"`
+	Location       string       `json:"location"`
+	Color          string       `json:"color"`
+	AlternateColor string       `json:"alternateColor"`
+	IsActive       bool         `json:"isActive"`
+	IsAllStar      bool         `json:"isAllStar"`
+	Logo           string       `json:"logo"`
+	Links          []TeamLink   `json:"links"`
+	Venue          TeamVenue    `json:"venue"`
+	Conference     TeamCategory `json:"conference"`
+	Division       TeamCategory `json:"division"`
+}
+
+// TeamLink is a link related to a team
+type TeamLink struct {
+	Rel        []string `json:"rel"`
+	Href       string   `json:"href"`
+	Text       string   `json:"text"`
+	IsExternal bool     `json:"isExternal"`
+	IsPremium  bool     `json:"isPremium"`
+}
+
+// TeamVenue is a team's home stadium
+type TeamVenue struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// TeamCategory is a division or conference
+type TeamCategory struct {
+	ID           string `json:"id"`
+	UID          string `json:"uid"`
+	Name         string `json:"name"`
+	Abbreviation string `json:"abbreviation"`
+}
+
+// Athlete is a player from the core API
+type Athlete struct {
+	ID          string  `json:"id"`
+	UID         string  `json:"uid"`
+	GUID        string  `json:"guid"`
+	FirstName   string  `json:"firstName"`
+	LastName    string  `json:"lastName"`
+	FullName    string  `json:"fullName"`
+	DisplayName string  `json:"displayName"`
+	ShortName   string  `json:"shortName"`
+	Weight      float64 `json:"weight"` // Using float64 as API returns decimal values like 213.0
+	Height      float64 `json:"height"` // Using float64 as API returns decimal values like 74.0
+	Jersey      string  `json:"jersey"`
+	Age         int     `json:"age,omitempty"` // Age is optional as it might not always be present
+	DateOfBirth string  `json:"dateOfBirth,omitempty"`
+	Position    struct {
+		ID           string `json:"id"`
+		Name         string `json:"name"`
+		Abbreviation string `json:"abbreviation"`
+		DisplayName  string `json:"displayName"`
+	} `json:"position"`
+	Team struct {
+		ID           string `json:"id"`
+		UID          string `json:"uid"`
+		Slug         string `json:"slug"`
+		Location     string `json:"location"`
+		Name         string `json:"name"`
+		Abbreviation string `json:"abbreviation"`
+		DisplayName  string `json:"displayName"`
+		ShortName    string `json:"shortName"`
+		Color        string `json:"color"`
+	} `json:"team"`
+	College struct {
+		ID           string `json:"id"`
+		Name         string `json:"name"`
+		Abbreviation string `json:"abbreviation"`
+	} `json:"college"`
+	Status struct {
+		ID           string `json:"id"`
+		Name         string `json:"name"`
+		Type         string `json:"type"`
+		Abbreviation string `json:"abbreviation"`
+	} `json:"status"`
+	Experience struct {
+		Years int `json:"years"`
+	} `json:"experience"`
+	Active          bool   `json:"active"`
+	HeadshotImgURL  string `json:"headshotImgUrl,omitempty"`  // Image URL may be at this level
+	HeadshotImgHref string `json:"headshotImgHref,omitempty"` // Or might be here
+	Draft           struct {
+		Year      int `json:"year"`
+		Round     int `json:"round"`
+		Selection int `json:"selection"`
+		Team      struct {
+			ID           string `json:"id"`
+			DisplayName  string `json:"displayName"`
+			Abbreviation string `json:"abbreviation"`
+		} `json:"team"`
+	} `json:"draft"`
+	Headshot struct {
+		Href string `json:"href"` // Or might be in this nested structure
+		Alt  string `json:"alt"`
+	} `json:"headshot"`
+	Linked bool `json:"linked"`
+}
+
+// Injury is an entry on a team's injury report from the core API
+type Injury struct {
+	ID           string `json:"id"`
+	Status       string `json:"status"` // e.g. "Questionable", "Out" or "Injured Reserve"
+	Date         string `json:"date"`
+	ShortComment string `json:"shortComment"`
+	LongComment  string `json:"longComment"`
+	Athlete      Ref    `json:"athlete"`
+	Team         Ref    `json:"team"`
+	Type         struct {
+		Name         string `json:"name"`
+		Description  string `json:"description"`
+		Abbreviation string `json:"abbreviation"`
+	} `json:"type"`
+	Details struct {
+		Type       string `json:"type"` // Body part, e.g. "Ankle"
+		Location   string `json:"location"`
+		Detail     string `json:"detail"`
+		Side       string `json:"side"`
+		ReturnDate string `json:"returnDate"`
+	} `json:"details"`
+}
+
+// DepthCharts are a team's depth charts in a season from the core API: one
+// for each of its offensive, defensive and special teams formations
+type DepthCharts struct {
+	Items []struct {
+		ID        string                        `json:"id"`
+		Name      string                        `json:"name"` // e.g. "3WR 1TE" or "Base 4-3 D"
+		Positions map[string]DepthChartPosition `json:"positions"`
+	} `json:"items"`
+}
+
+// DepthChartPosition is a position on a depth chart and its players in
+// order, keyed by a lowercase slot like "qb", "lde" or "pk"
+type DepthChartPosition struct {
+	Position struct {
+		ID           string `json:"id"`
+		Name         string `json:"name"`
+		Abbreviation string `json:"abbreviation"`
+	} `json:"position"`
+	Athletes []struct {
+		Slot    int `json:"slot"`
+		Rank    int `json:"rank"` // Depth, starting at 1 for the starter
+		Athlete Ref `json:"athlete"`
+	} `json:"athletes"`
+}
+
+// Season types in core API paths
+const (
+	SeasonTypeRegular = 2
+	SeasonTypePost    = 3
+)
+
+// AthleteStatistics are a player's season totals from the core API, grouped
+// into categories like "passing" or "general"
+type AthleteStatistics struct {
+	Splits struct {
+		Categories []struct {
+			Name  string `json:"name"`
+			Stats []struct {
+				Name  string  `json:"name"` // e.g. "passingYards"
+				Value float64 `json:"value"`
+			} `json:"stats"`
+		} `json:"categories"`
+	} `json:"splits"`
+}
+
+// Drive is a team's possession in a game summary
+type Drive struct {
+	ID   string `json:"id"`
+	Team struct {
+		ID string `json:"id"`
+	} `json:"team"`
+	Start struct {
+		Period struct {
+			Number int `json:"number"`
+		} `json:"period"`
+		YardLine int `json:"yardLine"` // Yards from the team's own goal line
+	} `json:"start"`
+	Yards          int    `json:"yards"`
+	IsScore        bool   `json:"isScore"`
+	OffensivePlays int    `json:"offensivePlays"`
+	Result         string `json:"result"` // e.g. "Touchdown", "Punt" or "Interception"
+	Plays          []Play `json:"plays"`
+}
+
+// Play is a play in a drive. Its type's text, like "Pass Reception" or
+// "Field Goal Good", says what happened; the participants are the players
+// involved, by their part in it, e.g. "passer" or "receiver".
+type Play struct {
+	ID   string `json:"id"`
+	Type struct {
+		ID   string `json:"id"`
+		Text string `json:"text"`
+	} `json:"type"`
+	Text   string `json:"text"`
+	Period struct {
+		Number int `json:"number"`
+	} `json:"period"`
+	Clock struct {
+		DisplayValue string `json:"displayValue"`
+	} `json:"clock"`
+	ScoringPlay bool `json:"scoringPlay"`
+	Start       struct {
+		Down           int `json:"down"` // 0 for kickoffs and tries
+		Distance       int `json:"distance"`
+		YardsToEndzone int `json:"yardsToEndzone"`
+		Team           struct {
+			ID string `json:"id"`
+		} `json:"team"`
+	} `json:"start"`
+	StatYardage  int `json:"statYardage"`
+	Participants []struct {
+		Athlete struct {
+			ID string `json:"id"`
+		} `json:"athlete"`
+		Type string `json:"type"`
+	} `json:"participants"`
+}
+
+// GameSummary is a game's box score, leaders and drives from the site API
+type GameSummary struct {
+	Header struct {
+		ID string `json:"id"`
+	} `json:"header"`
+	Boxscore struct {
+		Teams []struct {
+			Team struct {
+				ID           string `json:"id"`
+				Abbreviation string `json:"abbreviation"`
+			} `json:"team"`
+			Statistics []struct {
+				Name         string   `json:"name"`
+				DisplayName  string   `json:"displayName"`
+				Keys         []string `json:"keys"`
+				Labels       []string `json:"labels"`
+				Descriptions []string `json:"descriptions"`
+			} `json:"statistics"`
+		} `json:"teams"`
+		Players []struct {
+			Team struct {
+				ID string `json:"id"`
+			} `json:"team"`
+			Statistics []struct {
+				Name         string   `json:"name"`
+				Keys         []string `json:"keys"`
+				Labels       []string `json:"labels"`
+				Descriptions []string `json:"descriptions"`
+				Athletes     []struct {
+					Athlete struct {
+						ID          string `json:"id"`
+						DisplayName string `json:"displayName"`
+					} `json:"athlete"`
+					Stats []string `json:"stats"`
+				} `json:"athletes"`
+			} `json:"statistics"`
+		} `json:"players"`
+	} `json:"boxscore"`
+	Leaders []struct {
+		Team struct {
+			ID string `json:"id"`
+		} `json:"team"`
+		Leaders []struct {
+			Name        string `json:"name"`
+			DisplayName string `json:"displayName"`
+			Leaders     []struct {
+				Athlete struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"athlete"`
+				Value float64 `json:"value"`
+			} `json:"leaders"`
+		} `json:"leaders"`
+	} `json:"leaders"`
+	Drives struct {
+		Previous []Drive `json:"previous"` // In the order they happened
+	} `json:"drives"`
+}
+
+// Scoreboard fetches a regular season week's games
+func (c *Client) Scoreboard(ctx context.Context, season, week int) (*ScoreboardResponse, error) {
+	url := fmt.Sprintf("%s/scoreboard?dates=%d&seasontype=2&week=%d", c.config.SiteURL, season, week)
+	var response ScoreboardResponse
+	if err := c.getJSON(ctx, url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// Teams fetches a page of references to NFL teams, starting from page 1
+func (c *Client) Teams(ctx context.Context, page int) (*RefList, error) {
+	url := fmt.Sprintf("%s/teams?page=%d", c.config.CoreURL, page)
+	var response RefList
+	if err := c.getJSON(ctx, url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// Team fetches a team's details
+func (c *Client) Team(ctx context.Context, teamID string) (*TeamDetails, error) {
+	url := fmt.Sprintf("%s/teams/%s", c.config.SiteURL, teamID)
+	var response struct {
+		Team TeamDetails `json:"team"`
+	}
+	if err := c.getJSON(ctx, url, &response); err != nil {
+		return nil, err
+	}
+	return &response.Team, nil
+}
+
+// TeamRoster fetches references to the athletes on a team's roster in a season
+func (c *Client) TeamRoster(ctx context.Context, season int, teamID string) (*RefList, error) {
+	url := fmt.Sprintf("%s/seasons/%d/teams/%s/athletes?limit=200", c.config.CoreURL, season, teamID)
+	var response RefList
+	if err := c.getJSON(ctx, url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// Athlete fetches a player's details
+func (c *Client) Athlete(ctx context.Context, playerID string) (*Athlete, error) {
+	url := fmt.Sprintf("%s/athletes/%s", c.config.CoreURL, playerID)
+	var response Athlete
+	if err := c.getJSON(ctx, url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GameSummary fetches a game's box score
+func (c *Client) GameSummary(ctx context.Context, eventID int64) (*GameSummary, error) {
+	url := fmt.Sprintf("%s/summary?event=%d", c.config.SiteURL, eventID)
+	var response GameSummary
+	if err := c.getJSON(ctx, url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// TeamInjuries fetches references to the entries on a team's current injury
+// report
+func (c *Client) TeamInjuries(ctx context.Context, teamID string) (*RefList, error) {
+	url := fmt.Sprintf("%s/teams/%s/injuries?limit=200", c.config.CoreURL, teamID)
+	var response RefList
+	if err := c.getJSON(ctx, url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// Injury fetches an entry on a player's injury history
+func (c *Client) Injury(ctx context.Context, playerID, injuryID string) (*Injury, error) {
+	url := fmt.Sprintf("%s/athletes/%s/injuries/%s", c.config.CoreURL, playerID, injuryID)
+	var response Injury
+	if err := c.getJSON(ctx, url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// AthleteStatistics fetches a player's totals for a season type, e.g.
+// SeasonTypeRegular. Players who didn't play in it get a 404.
+func (c *Client) AthleteStatistics(ctx context.Context, season, seasonType int, playerID string) (*AthleteStatistics, error) {
+	url := fmt.Sprintf("%s/seasons/%d/types/%d/athletes/%s/statistics", c.config.CoreURL, season, seasonType, playerID)
+	var response AthleteStatistics
+	if err := c.getJSON(ctx, url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// TeamDepthCharts fetches a team's depth charts in a season, which for the
+// current season are as of today and for past seasons as they ended
+func (c *Client) TeamDepthCharts(ctx context.Context, season int, teamID string) (*DepthCharts, error) {
+	url := fmt.Sprintf("%s/seasons/%d/teams/%s/depthcharts", c.config.CoreURL, season, teamID)
+	var response DepthCharts
+	if err := c.getJSON(ctx, url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
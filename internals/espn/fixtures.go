@@ -0,0 +1,134 @@
+package espn
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Fixture files are laid out by API, endpoint path and query parameters,
+// e.g. site/scoreboard/dates=2024&seasontype=2&week=1.json or
+// core/athletes/3139477.json
+
+// fixturePath returns the file, relative to a fixtures directory, holding
+// the response for a request to an API ("site" or "core")
+func fixturePath(api, path string, query url.Values) string {
+	path = strings.Trim(path, "/")
+	if len(query) > 0 {
+		path += "/" + query.Encode() // Encode sorts by key
+	}
+	return filepath.Join(api, filepath.FromSlash(path)+".json")
+}
+
+// fixturePathForURL returns the fixture file for a URL the client requests,
+// or "" if it isn't under either base URL
+func (c *Client) fixturePathForURL(rawURL string) string {
+	api, path, query, ok := c.splitURL(rawURL)
+	if !ok {
+		return ""
+	}
+	return fixturePath(api, path, query)
+}
+
+// splitURL splits a URL under one of the base URLs into its API ("site" or
+// "core"), the endpoint path after the base URL and the query parameters
+func (c *Client) splitURL(rawURL string) (api, path string, query url.Values, ok bool) {
+	for _, api := range []string{"site", "core"} {
+		base := c.config.SiteURL
+		if api == "core" {
+			base = c.config.CoreURL
+		}
+		rest, ok := strings.CutPrefix(rawURL, base)
+		if !ok || (rest != "" && rest[0] != '/' && rest[0] != '?') {
+			continue
+		}
+		parsed, err := url.Parse(rest)
+		if err != nil {
+			return "", "", nil, false
+		}
+		return api, strings.Trim(parsed.Path, "/"), parsed.Query(), true
+	}
+	return "", "", nil, false
+}
+
+// recordFixture saves a response body to the record directory, if there is one
+func (c *Client) recordFixture(rawURL string, body []byte) {
+	if c.config.RecordDir == "" {
+		return
+	}
+	path := c.fixturePathForURL(rawURL)
+	if path == "" {
+		log.Printf("Warning: not recording %s: not under the ESPN base URLs", rawURL)
+		return
+	}
+
+	path = filepath.Join(c.config.RecordDir, path)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		log.Printf("Warning: error creating fixture directory for %s: %v", rawURL, err)
+		return
+	}
+	if err := os.WriteFile(path, body, 0o644); err != nil {
+		log.Printf("Warning: error recording %s: %v", rawURL, err)
+	}
+}
+
+// FixtureServer is a local stand-in for ESPN that replays responses saved
+// by a client with RecordDir set. Requests without a fixture get a 404.
+type FixtureServer struct {
+	*httptest.Server
+	Dir string
+
+	mu     sync.Mutex
+	misses []string
+}
+
+// NewFixtureServer starts a server replaying the fixtures in dir. Close it
+// when done.
+func NewFixtureServer(dir string) *FixtureServer {
+	server := &FixtureServer{Dir: dir}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serve))
+	return server
+}
+
+// serve answers a request with its fixture file
+func (s *FixtureServer) serve(w http.ResponseWriter, r *http.Request) {
+	api, path, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if api != "site" && api != "core" {
+		http.NotFound(w, r)
+		return
+	}
+
+	body, err := os.ReadFile(filepath.Join(s.Dir, fixturePath(api, path, r.URL.Query())))
+	if err != nil {
+		s.mu.Lock()
+		s.misses = append(s.misses, r.URL.RequestURI())
+		s.mu.Unlock()
+		http.Error(w, fmt.Sprintf("no fixture for %s", r.URL.RequestURI()), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// Config returns client settings that send every request to the server
+func (s *FixtureServer) Config() Config {
+	config := DefaultConfig()
+	config.SiteURL = s.URL + "/site"
+	config.CoreURL = s.URL + "/core"
+	config.RateLimit = 1000
+	config.Burst = 100
+	return config
+}
+
+// Misses returns the requests that had no fixture, in the order they came
+func (s *FixtureServer) Misses() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.misses...)
+}
@@ -0,0 +1,58 @@
+package espn
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFixturePathForURL(t *testing.T) {
+	client := NewClient(Config{SiteURL: "http://espn.test/site/", CoreURL: "http://espn.test/core"})
+	tests := map[string]string{
+		"http://espn.test/site/scoreboard?week=1&dates=2024&seasontype=2": "site/scoreboard/dates=2024&seasontype=2&week=1.json",
+		"http://espn.test/core/athletes/3139477":                          "core/athletes/3139477.json",
+		"http://espn.test/sitemap":                                        "",
+		"http://elsewhere.test/site/teams/1":                              "",
+	}
+	for url, expected := range tests {
+		if got := client.fixturePathForURL(url); got != filepath.FromSlash(expected) {
+			t.Errorf("Expected %q for %s, got %q", expected, url, got)
+		}
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "site", "teams", "1.json")
+	os.MkdirAll(filepath.Dir(path), 0o755)
+	if err := os.WriteFile(path, []byte(`{"team": {"id": "1", "abbreviation": "ATL"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	server := NewFixtureServer(dir)
+	defer server.Close()
+
+	// Recording from the server writes the same file elsewhere
+	config := server.Config()
+	config.RecordDir = t.TempDir()
+	client := NewClient(config)
+	team, err := client.Team(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if team.Abbreviation != "ATL" {
+		t.Errorf("Expected ATL, got %q", team.Abbreviation)
+	}
+	recorded, err := os.ReadFile(filepath.Join(config.RecordDir, "site", "teams", "1.json"))
+	if err != nil || string(recorded) != `{"team": {"id": "1", "abbreviation": "ATL"}}` {
+		t.Errorf("Expected the response to be recorded, got %q (%v)", recorded, err)
+	}
+
+	// Requests without a fixture are a 404, and remembered
+	if _, err := client.Team(context.Background(), "2"); !IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+	if misses := server.Misses(); len(misses) != 1 || misses[0] != "/site/teams/2" {
+		t.Errorf("Expected one miss for team 2, got %v", misses)
+	}
+}
@@ -0,0 +1,175 @@
+package league
+
+import "fmt"
+
+// BracketGame is a single-elimination playoff game
+type BracketGame struct {
+	Round     int // 0 for the first round
+	Week      int
+	High      *Team // Better seeded team, nil until decided
+	Low       *Team // Worse seeded team, nil until decided or for a bye
+	HighSeed  int
+	LowSeed   int
+	HighScore float64
+	LowScore  float64
+	Bye       bool // The high seed advances without playing
+	Played    bool
+	Winner    *Team
+}
+
+// winnerSeed returns the seed of the game's winner
+func (g *BracketGame) winnerSeed() int {
+	if g.Winner == g.Low {
+		return g.LowSeed
+	}
+	return g.HighSeed
+}
+
+// Bracket is a league's playoffs, filled in as playoff weeks are scored
+type Bracket struct {
+	Rounds   [][]*BracketGame
+	Champion *Team // nil until the final is played
+}
+
+// RoundName names a playoff round, e.g. "Semifinals"
+func (b *Bracket) RoundName(round int) string {
+	switch len(b.Rounds) - round {
+	case 1:
+		return "Championship"
+	case 2:
+		return "Semifinals"
+	case 3:
+		return "Quarterfinals"
+	default:
+		return fmt.Sprintf("Round %d", round+1)
+	}
+}
+
+// PlayoffSeeds picks the playoff teams from standings. With divisions,
+// division winners take the top seeds and the best remaining records fill
+// the wildcard spots.
+func PlayoffSeeds(standings []*Standing, count int) []*Team {
+	count = min(count, len(standings))
+	seeds := make([]*Team, 0, count)
+	seeded := make(map[*Team]bool)
+
+	// Standings are already ranked, so the first team seen in a division won it
+	divisions := make(map[string]bool)
+	for _, standing := range standings {
+		division := standing.Team.Division
+		if division == "" || divisions[division] || len(seeds) == count {
+			continue
+		}
+		divisions[division] = true
+		seeds = append(seeds, standing.Team)
+		seeded[standing.Team] = true
+	}
+
+	for _, standing := range standings {
+		if len(seeds) == count {
+			break
+		}
+		if !seeded[standing.Team] {
+			seeds = append(seeds, standing.Team)
+		}
+	}
+	return seeds
+}
+
+// bracketOrder returns seeds 1..size in bracket position order, so the top
+// seeds can only meet in the latest rounds (1 v 8, 4 v 5, 2 v 7, 3 v 6)
+func bracketOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		n := len(order) * 2
+		next := make([]int, 0, n)
+		for _, seed := range order {
+			next = append(next, seed, n+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// PlayBracket builds a single-elimination bracket for the seeded teams,
+// starting in startWeek with one round a week. Top seeds get byes when the
+// number of teams isn't a power of two. Games are played for weeks in
+// weekScores; ties go to the better seed.
+func PlayBracket(seeds []*Team, startWeek int, roster PositionRoster, weekScores map[int]map[string]*PlayerWeek) *Bracket {
+	bracket := &Bracket{}
+	if len(seeds) < 2 {
+		return bracket
+	}
+
+	size := 1
+	for size < len(seeds) {
+		size *= 2
+	}
+
+	// First round pairings from the bracket order
+	order := bracketOrder(size)
+	var round []*BracketGame
+	for i := 0; i < size; i += 2 {
+		high, low := min(order[i], order[i+1]), max(order[i], order[i+1])
+		game := &BracketGame{Week: startWeek, High: seeds[high-1], HighSeed: high}
+		if low > len(seeds) {
+			game.Bye, game.Played, game.Winner = true, true, game.High
+		} else {
+			game.Low, game.LowSeed = seeds[low-1], low
+		}
+		round = append(round, game)
+	}
+
+	for r := 0; ; r++ {
+		for _, game := range round {
+			playGame(game, roster, weekScores)
+		}
+		bracket.Rounds = append(bracket.Rounds, round)
+		if len(round) == 1 {
+			bracket.Champion = round[0].Winner
+			return bracket
+		}
+
+		// Winners of neighbouring games meet in the next round
+		next := make([]*BracketGame, len(round)/2)
+		for i := range next {
+			game := &BracketGame{Round: r + 1, Week: startWeek + r + 1}
+			for _, feeder := range round[2*i : 2*i+2] {
+				if feeder.Winner == nil {
+					continue
+				}
+				seed := feeder.winnerSeed()
+				switch {
+				case game.High == nil:
+					game.High, game.HighSeed = feeder.Winner, seed
+				case seed < game.HighSeed:
+					game.Low, game.LowSeed = game.High, game.HighSeed
+					game.High, game.HighSeed = feeder.Winner, seed
+				default:
+					game.Low, game.LowSeed = feeder.Winner, seed
+				}
+			}
+			next[i] = game
+		}
+		round = next
+	}
+}
+
+// playGame scores a bracket game once both teams are known and its week has scores
+func playGame(game *BracketGame, roster PositionRoster, weekScores map[int]map[string]*PlayerWeek) {
+	if game.Played || game.High == nil || game.Low == nil {
+		return
+	}
+	scores, ok := weekScores[game.Week]
+	if !ok {
+		return
+	}
+
+	game.HighScore = ScoreTeam(game.High.ForWeek(game.Week), roster, scores).Total
+	game.LowScore = ScoreTeam(game.Low.ForWeek(game.Week), roster, scores).Total
+	game.Played = true
+	game.Winner = game.High
+	if game.LowScore > game.HighScore {
+		game.Winner = game.Low
+	}
+}
@@ -0,0 +1,102 @@
+package league
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBracketOrder(t *testing.T) {
+	expected := []int{1, 8, 4, 5, 2, 7, 3, 6}
+	if order := bracketOrder(8); !reflect.DeepEqual(order, expected) {
+		t.Errorf("Expected %v, got %v", expected, order)
+	}
+}
+
+// bracketTeams creates teams with one quarterback each, "qb1" for the first team
+func bracketTeams(count int) []*Team {
+	teams := testTeams(count)
+	for i, team := range teams {
+		team.Roster = []Player{{ID: fmt.Sprintf("qb%d", i+1), Position: "QB"}}
+	}
+	return teams
+}
+
+func TestPlayBracket(t *testing.T) {
+	roster := PositionRoster{newSlot("QB", 1), newSlot("BN", 1)}
+	seeds := bracketTeams(6)
+
+	// Nothing played yet: the top two seeds have byes
+	bracket := PlayBracket(seeds, 15, roster, nil)
+	if len(bracket.Rounds) != 3 {
+		t.Fatalf("Expected 3 rounds, got %d", len(bracket.Rounds))
+	}
+	if bracket.RoundName(0) != "Quarterfinals" || bracket.RoundName(2) != "Championship" {
+		t.Errorf("Unexpected round names %q and %q", bracket.RoundName(0), bracket.RoundName(2))
+	}
+	byes := 0
+	for _, game := range bracket.Rounds[0] {
+		if game.Bye {
+			byes++
+			if game.HighSeed > 2 {
+				t.Errorf("Expected only seeds 1 and 2 to have byes, got seed %d", game.HighSeed)
+			}
+		}
+	}
+	if byes != 2 {
+		t.Errorf("Expected 2 byes, got %d", byes)
+	}
+	if bracket.Champion != nil {
+		t.Errorf("Expected no champion before the playoffs")
+	}
+
+	// Seed 5 upsets seed 4, then seed 1 beats seed 5 and seed 3 tops seed 2
+	weekScores := map[int]map[string]*PlayerWeek{
+		15: {"qb3": {Points: 30}, "qb6": {Points: 20}, "qb4": {Points: 10}, "qb5": {Points: 25}},
+		16: {"qb1": {Points: 40}, "qb5": {Points: 10}, "qb2": {Points: 20}, "qb3": {Points: 20}},
+	}
+	bracket = PlayBracket(seeds, 15, roster, weekScores)
+
+	semifinal := bracket.Rounds[1][0]
+	if semifinal.High != seeds[0] || semifinal.Low != seeds[4] || semifinal.Winner != seeds[0] {
+		t.Errorf("Expected seed 1 to beat seed 5, got %+v", semifinal)
+	}
+	// A tie goes to the better seed
+	if winner := bracket.Rounds[1][1].Winner; winner != seeds[1] {
+		t.Errorf("Expected seed 2 to win the tie, got %v", winner)
+	}
+
+	final := bracket.Rounds[2][0]
+	if final.Week != 17 || final.Played || final.High != seeds[0] || final.Low != seeds[1] {
+		t.Errorf("Expected an unplayed week 17 final between seeds 1 and 2, got %+v", final)
+	}
+
+	weekScores[17] = map[string]*PlayerWeek{"qb1": {Points: 5}, "qb2": {Points: 50}}
+	bracket = PlayBracket(seeds, 15, roster, weekScores)
+	if bracket.Champion != seeds[1] {
+		t.Errorf("Expected seed 2 to win the championship, got %v", bracket.Champion)
+	}
+}
+
+func TestPlayoffSeeds(t *testing.T) {
+	teams := testTeams(6)
+	AssignDivisions(teams, 2)
+
+	// Ranked standings: the East takes the top three spots
+	var standings []*Standing
+	for _, i := range []int{0, 2, 4, 1, 3, 5} {
+		standings = append(standings, &Standing{Team: teams[i]})
+	}
+
+	seeds := PlayoffSeeds(standings, 4)
+	expected := []*Team{teams[0], teams[1], teams[2], teams[4]}
+	if !reflect.DeepEqual(seeds, expected) {
+		t.Errorf("Expected the West winner to be seeded second, got %v", seeds)
+	}
+
+	AssignDivisions(teams, 0)
+	seeds = PlayoffSeeds(standings, 2)
+	if seeds[0] != teams[0] || seeds[1] != teams[2] {
+		t.Errorf("Expected the top two records without divisions, got %v", seeds)
+	}
+}
@@ -0,0 +1,93 @@
+package league
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+)
+
+// LastNFLWeek is the last week of the NFL postseason, for loading a season's
+// latest depth charts
+const LastNFLWeek = 22
+
+const (
+	seasonGames  = 17 // Games an NFL team plays in the regular season
+	backupGames  = 6  // Games a backup can expect to start for an injured or resting starter
+	reserveGames = 2  // Deeper players only start when the starter and backup are both out
+)
+
+// DepthChartEntry is a player's place on their team's depth chart
+type DepthChartEntry struct {
+	TeamID   string `json:"team_id"`
+	Slot     string `json:"slot"`     // e.g. "QB", "LDE" or "KR"
+	Position string `json:"position"` // Position abbreviation of the slot, e.g. "DE"
+	Depth    int    `json:"depth"`    // 1 for the starter
+	PlayerID string `json:"player_id"`
+}
+
+// DepthChart holds every team's depth chart entries for a week, keyed by
+// player ID. A nil DepthChart has no one on it.
+type DepthChart map[string][]DepthChartEntry
+
+// LoadDepthChart returns the depth charts in effect for a week: each team's
+// latest chart scraped on or before it
+func LoadDepthChart(ctx context.Context, queries sqlc.Querier, season, week int64) (DepthChart, error) {
+	rows, err := queries.GetDepthChartsByWeek(ctx, sqlc.GetDepthChartsByWeekParams{Season: season, Week: week})
+	if err != nil {
+		return nil, fmt.Errorf("error getting week %d depth charts: %w", week, err)
+	}
+	chart := make(DepthChart)
+	for _, row := range rows {
+		chart[row.PlayerID] = append(chart[row.PlayerID], DepthChartEntryFromRow(row))
+	}
+	return chart, nil
+}
+
+// DepthChartEntryFromRow converts a stored depth chart entry
+func DepthChartEntryFromRow(row *sqlc.NflDepthChart) DepthChartEntry {
+	return DepthChartEntry{
+		TeamID:   row.TeamID,
+		Slot:     row.Slot,
+		Position: row.Position,
+		Depth:    int(row.Depth),
+		PlayerID: row.PlayerID,
+	}
+}
+
+// Depth returns a player's highest place on the chart at a position, so a
+// running back's kick return slot doesn't count, or 0 if they aren't on it
+func (d DepthChart) Depth(playerID, position string) int {
+	depth := 0
+	for _, entry := range d[playerID] {
+		if entry.Position == position && (depth == 0 || entry.Depth < depth) {
+			depth = entry.Depth
+		}
+	}
+	return depth
+}
+
+// depthGames is how many games a player at a depth is expected to play a
+// starter's role: all of them for starters, and for backups the games they'd
+// fill in for an injured starter
+func depthGames(depth int) float64 {
+	switch depth {
+	case 1:
+		return seasonGames
+	case 2:
+		return backupGames
+	default:
+		return reserveGames
+	}
+}
+
+// ProjectPoints projects a player's fantasy points over a season from their
+// points per game and depth chart place, so a starter who missed games isn't
+// penalized and a former starter who's now a backup isn't overrated. Players
+// with no depth (0) are projected at the points they scored.
+func ProjectPoints(summary *PlayerSeasonSummary, depth int) float64 {
+	if depth == 0 {
+		return summary.Points
+	}
+	return summary.PointsPerGame() * depthGames(depth)
+}
@@ -1 +1,328 @@
 package league
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// Errors returned when a pick can't be made
+var (
+	ErrDraftComplete = errors.New("draft is complete")
+	ErrNotOnTheClock = errors.New("pick is not on the clock")
+)
+
+// latePositions are drafted by bots only once every remaining pick is needed
+// for an open starting spot, since their scoring is hard to predict
+var latePositions = map[string]bool{"K": true, "PK": true, "DST": true}
+
+// DraftPick is a player selected in a draft
+type DraftPick struct {
+	Number int // Overall pick number, starting at 1
+	Round  int // Round, starting at 1
+	Team   int // Index of the drafting team
+	Player *RankedPlayer
+	Auto   bool // Picked by a bot or by auto-pick
+}
+
+// Draft runs a snake draft over a pool of ranked players. Rounds cover every
+// roster slot that players in the pool can fill, so slots such as DST are
+// skipped when no team defenses have been scraped. Players on injured
+// reserve can also be drafted into reserve (IR) slots, which aren't rounds
+// since they don't count toward roster size. Draft is safe for concurrent
+// use.
+type Draft struct {
+	mu       sync.Mutex
+	roster   PositionRoster
+	teams    []*Team
+	rounds   int
+	pool     []*RankedPlayer // Ordered by rank
+	injuries Injuries
+	taken    map[string]bool
+	picks    []DraftPick
+}
+
+// NewDraft creates a draft for the teams, in draft order, using the league's
+// roster and the injury report in effect for the draft
+func NewDraft(rules *LeagueRules, teams []*Team, pool []*RankedPlayer, injuries Injuries) (*Draft, error) {
+	if len(teams) < 2 {
+		return nil, fmt.Errorf("a draft needs at least 2 teams, got %d", len(teams))
+	}
+
+	pool = append([]*RankedPlayer(nil), pool...)
+	sort.SliceStable(pool, func(i, j int) bool {
+		return pool[i].Rank < pool[j].Rank
+	})
+
+	// Only draft slots that some player in the pool can fill
+	var roster PositionRoster
+	rounds := 0
+	for _, slot := range rules.RosterPositions {
+		if slot.Count == 0 {
+			continue
+		}
+		for _, player := range pool {
+			if slot.Accepts(player.Position) {
+				roster = append(roster, slot)
+				if !slot.Reserve {
+					rounds += slot.Count
+				}
+				break
+			}
+		}
+	}
+	if rounds == 0 {
+		return nil, fmt.Errorf("no players available to fill the roster")
+	}
+	if len(pool) < rounds*len(teams) {
+		return nil, fmt.Errorf("not enough players for the draft: need %d, have %d", rounds*len(teams), len(pool))
+	}
+
+	taken := make(map[string]bool)
+	for _, team := range teams {
+		for _, player := range team.Roster {
+			taken[player.ID] = true
+		}
+	}
+
+	return &Draft{
+		roster:   roster,
+		teams:    teams,
+		rounds:   rounds,
+		pool:     pool,
+		injuries: injuries,
+		taken:    taken,
+	}, nil
+}
+
+// Teams returns the teams in draft order
+func (d *Draft) Teams() []*Team {
+	return d.teams
+}
+
+// Rounds returns the number of rounds in the draft
+func (d *Draft) Rounds() int {
+	return d.rounds
+}
+
+// Roster returns the roster slots filled by the draft
+func (d *Draft) Roster() PositionRoster {
+	return d.roster
+}
+
+// TotalPicks returns the number of picks in the draft
+func (d *Draft) TotalPicks() int {
+	return d.rounds * len(d.teams)
+}
+
+// PickSlot returns the round and team index of an overall pick number.
+// Even rounds reverse the draft order.
+func (d *Draft) PickSlot(number int) (round, team int) {
+	index := number - 1
+	round = index/len(d.teams) + 1
+	team = index % len(d.teams)
+	if round%2 == 0 {
+		team = len(d.teams) - 1 - team
+	}
+	return round, team
+}
+
+// Done reports whether every pick has been made
+func (d *Draft) Done() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.picks) >= d.TotalPicks()
+}
+
+// OnTheClock returns the next pick to be made with no player, or false once
+// the draft is complete
+func (d *Draft) OnTheClock() (DraftPick, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.onTheClock()
+}
+
+func (d *Draft) onTheClock() (DraftPick, bool) {
+	if len(d.picks) >= d.TotalPicks() {
+		return DraftPick{}, false
+	}
+	number := len(d.picks) + 1
+	round, team := d.PickSlot(number)
+	return DraftPick{Number: number, Round: round, Team: team}, true
+}
+
+// Picks returns the picks made so far in order
+func (d *Draft) Picks() []DraftPick {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]DraftPick(nil), d.picks...)
+}
+
+// Available returns the undrafted players in rank order
+func (d *Draft) Available() []*RankedPlayer {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	available := make([]*RankedPlayer, 0, len(d.pool))
+	for _, player := range d.pool {
+		if !d.taken[player.ID] {
+			available = append(available, player)
+		}
+	}
+	return available
+}
+
+// Lineup returns a team's roster spots filled with its drafted players
+func (d *Draft) Lineup(team int) []LineupSlot {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	spots, _ := d.roster.Assign(d.teams[team].Roster, d.injuries)
+	return spots
+}
+
+// CanDraft reports whether a team has an open roster spot the player can fill
+func (d *Draft) CanDraft(team int, player *RankedPlayer) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	fits, _ := d.fits(team, player.Player)
+	return fits
+}
+
+// fits reports whether a team has an open spot for a player and whether
+// that spot is a starting spot
+func (d *Draft) fits(team int, player Player) (fits, starter bool) {
+	spots, _ := d.roster.Assign(d.teams[team].Roster, d.injuries)
+	return openSpot(spots, player.Position, d.injuries.OnReserve(player.ID))
+}
+
+// openSpot reports whether any open spot accepts a position and whether one
+// of those is a starting spot. Players on injured reserve go to an open
+// reserve spot when there is one, as Assign puts them there.
+func openSpot(spots []LineupSlot, position string, reserve bool) (fits, starter bool) {
+	if reserve && slices.ContainsFunc(spots, func(spot LineupSlot) bool {
+		return spot.Player == nil && spot.Slot.Reserve && spot.Slot.Accepts(position)
+	}) {
+		return true, false
+	}
+	for _, spot := range spots {
+		if spot.Player == nil && spot.Slot.Accepts(position) && !spot.Slot.Reserve {
+			fits = true
+			if spot.Slot.IsStarter() {
+				return true, true
+			}
+		}
+	}
+	return fits, false
+}
+
+// Pick drafts a player for the team on the clock
+func (d *Draft) Pick(playerID string) (DraftPick, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	pick, ok := d.onTheClock()
+	if !ok {
+		return DraftPick{}, ErrDraftComplete
+	}
+
+	for _, player := range d.pool {
+		if player.ID != playerID {
+			continue
+		}
+		if d.taken[playerID] {
+			return DraftPick{}, fmt.Errorf("%s has already been drafted", player.Name)
+		}
+		if fits, _ := d.fits(pick.Team, player.Player); !fits {
+			return DraftPick{}, fmt.Errorf("%s has no open roster spot for a %s", d.teams[pick.Team].Name, player.Position)
+		}
+		pick.Player = player
+		d.record(pick)
+		return pick, nil
+	}
+	return DraftPick{}, fmt.Errorf("player %s is not in the draft pool", playerID)
+}
+
+// AutoPick drafts the best available player for the team on the clock.
+// Starting spots are filled before the bench once the remaining picks are
+// all needed for starters, and kickers and defenses are left until then.
+func (d *Draft) AutoPick() (DraftPick, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	pick, ok := d.onTheClock()
+	if !ok {
+		return DraftPick{}, ErrDraftComplete
+	}
+	return d.autoPick(pick)
+}
+
+// AutoPickAt auto-picks only if the given overall pick is on the clock, so
+// a delayed request can't pick for the wrong team
+func (d *Draft) AutoPickAt(number int) (DraftPick, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	pick, ok := d.onTheClock()
+	if !ok {
+		return DraftPick{}, ErrDraftComplete
+	}
+	if pick.Number != number {
+		return DraftPick{}, ErrNotOnTheClock
+	}
+	return d.autoPick(pick)
+}
+
+// autoPick chooses and records the player for an auto pick
+func (d *Draft) autoPick(pick DraftPick) (DraftPick, error) {
+	spots, _ := d.roster.Assign(d.teams[pick.Team].Roster, d.injuries)
+	remaining, openStarters := 0, 0
+	for _, spot := range spots {
+		if spot.Player == nil && !spot.Slot.Reserve {
+			remaining++
+			if spot.Slot.IsStarter() {
+				openStarters++
+			}
+		}
+	}
+	needStarters := remaining <= openStarters
+
+	var fallback *RankedPlayer
+	for _, player := range d.pool {
+		if d.taken[player.ID] {
+			continue
+		}
+		fits, starter := openSpot(spots, player.Position, d.injuries.OnReserve(player.ID))
+		if !fits || (needStarters && !starter) {
+			continue
+		}
+		if latePositions[player.Position] && !needStarters {
+			// Only taken early if nothing else fits
+			if fallback == nil {
+				fallback = player
+			}
+			continue
+		}
+		pick.Player = player
+		break
+	}
+	if pick.Player == nil {
+		pick.Player = fallback
+	}
+	if pick.Player == nil {
+		return DraftPick{}, fmt.Errorf("no available player fits %s's roster", d.teams[pick.Team].Name)
+	}
+
+	pick.Auto = true
+	d.record(pick)
+	return pick, nil
+}
+
+// record adds a pick to the draft and the drafting team's roster
+func (d *Draft) record(pick DraftPick) {
+	d.picks = append(d.picks, pick)
+	d.taken[pick.Player.ID] = true
+	team := d.teams[pick.Team]
+	team.Roster = append(team.Roster, pick.Player.Player)
+}
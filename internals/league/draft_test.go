@@ -0,0 +1,182 @@
+package league
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// testPool returns a ranked pool with count players at each position
+func testPool(count int, positions ...string) []*RankedPlayer {
+	var players []Player
+	summaries := make(map[string]*PlayerSeasonSummary)
+	for _, position := range positions {
+		for i := 0; i < count; i++ {
+			id := fmt.Sprintf("%s%d", position, i+1)
+			players = append(players, Player{ID: id, Name: id, Position: position})
+			// Interleave positions so each has top-ranked players
+			summaries[id] = &PlayerSeasonSummary{PlayerID: id, Games: 1, Points: float64(1000 - i*10 - len(position))}
+		}
+	}
+	return RankPlayers(players, summaries, DefaultRules().RosterPositions, nil)
+}
+
+func testTeams(count int) []*Team {
+	teams := make([]*Team, count)
+	for i := range teams {
+		teams[i] = &Team{ID: i + 1, Name: fmt.Sprintf("Team %d", i+1), Bot: true}
+	}
+	return teams
+}
+
+func TestDraftSnakeOrder(t *testing.T) {
+	draft, err := NewDraft(DefaultRules(), testTeams(4), testPool(40, "QB", "RB", "WR", "TE", "K"), nil)
+	if err != nil {
+		t.Fatalf("Error creating draft: %v", err)
+	}
+
+	expected := []struct{ round, team int }{{1, 0}, {1, 1}, {1, 2}, {1, 3}, {2, 3}, {2, 2}, {2, 1}, {2, 0}, {3, 0}}
+	for i, want := range expected {
+		round, team := draft.PickSlot(i + 1)
+		if round != want.round || team != want.team {
+			t.Errorf("Pick %d: expected round %d team %d, got round %d team %d", i+1, want.round, want.team, round, team)
+		}
+	}
+
+	// No DST players in the pool, so the DST slot is skipped
+	if draft.Rounds() != DefaultRules().TotalRosterSize()-1 {
+		t.Errorf("Expected %d rounds without DST, got %d", DefaultRules().TotalRosterSize()-1, draft.Rounds())
+	}
+}
+
+func TestDraftPick(t *testing.T) {
+	draft, err := NewDraft(DefaultRules(), testTeams(2), testPool(40, "QB", "RB", "WR", "TE", "K"), nil)
+	if err != nil {
+		t.Fatalf("Error creating draft: %v", err)
+	}
+
+	pick, err := draft.Pick("QB1")
+	if err != nil {
+		t.Fatalf("Error making pick: %v", err)
+	}
+	if pick.Number != 1 || pick.Team != 0 || pick.Player.ID != "QB1" || pick.Auto {
+		t.Errorf("Unexpected pick: %+v", pick)
+	}
+	if !draft.Teams()[0].HasPlayer("QB1") {
+		t.Errorf("Expected QB1 on the first team's roster")
+	}
+
+	if _, err := draft.Pick("QB1"); err == nil {
+		t.Errorf("Expected error drafting a player twice")
+	}
+	if _, err := draft.Pick("nobody"); err == nil {
+		t.Errorf("Expected error drafting a player outside the pool")
+	}
+
+	// Snake order gives team 1 picks 1, 4, 5, 8, 9, 12, 13 and 16
+	for _, id := range []string{"QB2", "QB3", "QB4", "QB5", "QB6", "QB7", "QB8", "QB9", "QB10", "QB11", "QB12", "QB13", "QB14", "RB1"} {
+		if _, err := draft.Pick(id); err != nil {
+			t.Fatalf("Error drafting %s: %v", id, err)
+		}
+	}
+
+	// Team 1 now has QB and 6 bench spots filled, so a seventh QB doesn't fit
+	next, _ := draft.OnTheClock()
+	if next.Team != 0 {
+		t.Fatalf("Expected team 1 on the clock, got team %d", next.Team+1)
+	}
+	if _, err := draft.Pick("QB16"); err == nil {
+		t.Errorf("Expected error drafting a QB with no open spot")
+	}
+	if draft.CanDraft(0, &RankedPlayer{Player: Player{Position: "QB"}}) {
+		t.Errorf("Expected CanDraft to reject a QB with no open spot")
+	}
+}
+
+func TestDraftAutoPickFillsRosters(t *testing.T) {
+	rules := DefaultRules()
+	draft, err := NewDraft(rules, testTeams(4), testPool(40, "QB", "RB", "WR", "TE", "K"), nil)
+	if err != nil {
+		t.Fatalf("Error creating draft: %v", err)
+	}
+
+	// Delayed auto picks for a later pick are ignored
+	if _, err := draft.AutoPickAt(2); !errors.Is(err, ErrNotOnTheClock) {
+		t.Errorf("Expected ErrNotOnTheClock for pick 2, got %v", err)
+	}
+	if pick, err := draft.AutoPickAt(1); err != nil || pick.Number != 1 {
+		t.Errorf("Expected pick 1 to be made, got %+v, %v", pick, err)
+	}
+
+	for !draft.Done() {
+		pick, err := draft.AutoPick()
+		if err != nil {
+			t.Fatalf("Error auto-picking: %v", err)
+		}
+		if !pick.Auto {
+			t.Errorf("Expected auto pick to be marked as auto")
+		}
+
+		// Kickers wait until the bench is full
+		if pick.Player.Position == "K" && pick.Round <= draft.Rounds()/2 {
+			t.Errorf("Kicker drafted too early in round %d", pick.Round)
+		}
+	}
+
+	if _, err := draft.AutoPick(); !errors.Is(err, ErrDraftComplete) {
+		t.Errorf("Expected ErrDraftComplete after the last pick, got %v", err)
+	}
+	if len(draft.Picks()) != draft.TotalPicks() {
+		t.Errorf("Expected %d picks, got %d", draft.TotalPicks(), len(draft.Picks()))
+	}
+
+	// Every team has a full starting lineup
+	for i, team := range draft.Teams() {
+		for _, spot := range draft.Lineup(i) {
+			if spot.Player == nil {
+				t.Errorf("%s has an open %s spot", team.Name, spot.Slot.Slot)
+			}
+		}
+	}
+}
+
+func TestDraftReserve(t *testing.T) {
+	pool := testPool(40, "QB", "RB", "WR", "TE", "K")
+	healthy, err := NewDraft(DefaultRules(), testTeams(2), pool, nil)
+	if err != nil {
+		t.Fatalf("Error creating draft: %v", err)
+	}
+
+	// IR slots aren't rounds, but a player on injured reserve can be drafted
+	// into one without taking a spot that counts toward roster size
+	rules := DefaultRules()
+	rules.SetPositionCount("IR", 1)
+	injuries := Injuries{"RB1": {Status: InjuryIR}}
+	draft, err := NewDraft(rules, testTeams(2), pool, injuries)
+	if err != nil {
+		t.Fatalf("Error creating draft: %v", err)
+	}
+	if draft.Rounds() != healthy.Rounds() {
+		t.Errorf("Expected %d rounds, got %d", healthy.Rounds(), draft.Rounds())
+	}
+	if _, err := draft.Pick("RB1"); err != nil {
+		t.Fatalf("Error drafting RB1: %v", err)
+	}
+	for _, spot := range draft.Lineup(0) {
+		if spot.Slot.Reserve && (spot.Player == nil || spot.Player.ID != "RB1") {
+			t.Errorf("Expected RB1 on IR, got %+v", spot.Player)
+		}
+		if !spot.Slot.Reserve && spot.Player != nil {
+			t.Errorf("Expected RB1 to leave the %s spot open, got %s", spot.Slot.Slot, spot.Player.ID)
+		}
+	}
+}
+
+func TestNewDraftErrors(t *testing.T) {
+	if _, err := NewDraft(DefaultRules(), testTeams(1), testPool(10, "QB"), nil); err == nil {
+		t.Errorf("Expected error for a single team draft")
+	}
+	if _, err := NewDraft(DefaultRules(), testTeams(4), testPool(2, "QB", "RB", "WR", "TE", "K"), nil); err == nil {
+		t.Errorf("Expected error when the pool is too small")
+	}
+}
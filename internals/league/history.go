@@ -0,0 +1,104 @@
+package league
+
+import "sort"
+
+// TeamSeason is a team's result in one season of a league
+type TeamSeason struct {
+	Year      int64
+	Team      *Team
+	Rank      int // Regular season finish
+	Wins      int
+	Losses    int
+	Ties      int
+	PointsFor float64
+	Champion  bool
+}
+
+// WeekRecord is a team's score in a single week
+type WeekRecord struct {
+	Year   int64
+	Week   int
+	Team   *Team
+	Points float64
+}
+
+// StreakRecord is a team's run of consecutive wins in a season
+type StreakRecord struct {
+	Year   int64
+	Team   *Team
+	Length int
+}
+
+// History summarises every season a league has played. Teams are matched
+// across seasons by name.
+type History struct {
+	Champions     []TeamSeason            // Each finished season's champion, newest first
+	HighScore     *WeekRecord             // Highest single-week score, nil before any games
+	LongestStreak *StreakRecord           // Longest regular season win streak, nil before any wins
+	Teams         []string                // Team names, alphabetically
+	TeamSeasons   map[string][]TeamSeason // Team name -> seasons played, newest first
+}
+
+// LeagueHistory builds a league's history from its season reports
+func LeagueHistory(reports []*SeasonReport) *History {
+	sorted := append([]*SeasonReport{}, reports...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Season.Year > sorted[j].Season.Year
+	})
+
+	history := &History{TeamSeasons: make(map[string][]TeamSeason)}
+	for _, report := range sorted {
+		year := report.Season.Year
+
+		for i, standing := range report.Standings {
+			result := TeamSeason{
+				Year:      year,
+				Team:      standing.Team,
+				Rank:      i + 1,
+				Wins:      standing.Wins,
+				Losses:    standing.Losses,
+				Ties:      standing.Ties,
+				PointsFor: standing.PointsFor,
+				Champion:  report.Bracket != nil && report.Bracket.Champion == standing.Team,
+			}
+			if result.Champion {
+				history.Champions = append(history.Champions, result)
+			}
+
+			name := standing.Team.Name
+			if _, ok := history.TeamSeasons[name]; !ok {
+				history.Teams = append(history.Teams, name)
+			}
+			history.TeamSeasons[name] = append(history.TeamSeasons[name], result)
+
+			if length := LongestWinStreak(standing.Team, report.Results); length > 0 &&
+				(history.LongestStreak == nil || length > history.LongestStreak.Length) {
+				history.LongestStreak = &StreakRecord{Year: year, Team: standing.Team, Length: length}
+			}
+		}
+
+		// Regular season and playoff scores both count toward the weekly record
+		consider := func(week int, team *Team, points float64) {
+			if history.HighScore == nil || points > history.HighScore.Points {
+				history.HighScore = &WeekRecord{Year: year, Week: week, Team: team, Points: points}
+			}
+		}
+		for _, result := range report.Results {
+			consider(result.Week, result.Home, result.HomeScore)
+			consider(result.Week, result.Away, result.AwayScore)
+		}
+		if report.Bracket != nil {
+			for _, round := range report.Bracket.Rounds {
+				for _, game := range round {
+					if game.Played && !game.Bye {
+						consider(game.Week, game.High, game.HighScore)
+						consider(game.Week, game.Low, game.LowScore)
+					}
+				}
+			}
+		}
+	}
+
+	sort.Strings(history.Teams)
+	return history
+}
@@ -0,0 +1,47 @@
+package league
+
+import "testing"
+
+func TestLeagueHistory(t *testing.T) {
+	old := bracketTeams(2)
+	recent := bracketTeams(2)
+	recent[0].Name, recent[1].Name = "Team 2", "Team 1" // Same names, new draft order
+
+	reports := []*SeasonReport{
+		{
+			Season: &Season{Year: 2023, Teams: old},
+			Results: []GameResult{
+				{Week: 1, Home: old[0], Away: old[1], HomeScore: 150, AwayScore: 90},
+				{Week: 2, Home: old[1], Away: old[0], HomeScore: 80, AwayScore: 95},
+			},
+			Bracket: &Bracket{Champion: old[0]},
+		},
+		{
+			Season: &Season{Year: 2024, Teams: recent},
+			Results: []GameResult{
+				{Week: 1, Home: recent[0], Away: recent[1], HomeScore: 100, AwayScore: 110},
+			},
+			Bracket: &Bracket{},
+		},
+	}
+	for _, report := range reports {
+		report.Standings = Standings(report.Season.Teams, report.Results)
+	}
+
+	history := LeagueHistory(reports)
+
+	if len(history.Champions) != 1 || history.Champions[0].Year != 2023 || history.Champions[0].Team != old[0] {
+		t.Errorf("Expected Team 1 to be the only champion, in 2023, got %+v", history.Champions)
+	}
+	if history.HighScore == nil || history.HighScore.Points != 150 || history.HighScore.Year != 2023 {
+		t.Errorf("Expected a 150 point high score in 2023, got %+v", history.HighScore)
+	}
+	if history.LongestStreak == nil || history.LongestStreak.Length != 2 || history.LongestStreak.Team != old[0] {
+		t.Errorf("Expected Team 1's 2 game win streak, got %+v", history.LongestStreak)
+	}
+
+	seasons := history.TeamSeasons["Team 1"]
+	if len(seasons) != 2 || seasons[0].Year != 2024 || seasons[0].Wins != 1 || seasons[1].Wins != 2 || !seasons[1].Champion {
+		t.Errorf("Unexpected Team 1 history: %+v", seasons)
+	}
+}
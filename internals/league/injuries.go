@@ -0,0 +1,124 @@
+package league
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+)
+
+// Injury designations from NFL injury reports
+const (
+	InjuryOut          = data.InjuryOut
+	InjuryDoubtful     = data.InjuryDoubtful
+	InjuryQuestionable = data.InjuryQuestionable
+	InjuryIR           = data.InjuryIR // Injured reserve
+)
+
+// Injury is a player's designation on a week's injury report
+type Injury struct {
+	PlayerID string `json:"player_id"`
+	Week     int    `json:"week"` // Week of the report
+	Status   string `json:"status"`
+	BodyPart string `json:"body_part,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+	Date     string `json:"date,omitempty"` // YYYY-MM-DD
+}
+
+// Tag abbreviates the designation for player lists, e.g. "Q" for questionable
+func (i Injury) Tag() string {
+	switch i.Status {
+	case InjuryOut:
+		return "O"
+	case InjuryDoubtful:
+		return "D"
+	case InjuryQuestionable:
+		return "Q"
+	default:
+		return i.Status
+	}
+}
+
+// String describes the injury, e.g. "Questionable (Hamstring)"
+func (i Injury) String() string {
+	if i.BodyPart == "" {
+		return i.Status
+	}
+	return fmt.Sprintf("%s (%s)", i.Status, i.BodyPart)
+}
+
+// Unavailable reports whether the designation rules the player out
+func (i Injury) Unavailable() bool {
+	return i.Status == InjuryOut || i.Status == InjuryIR
+}
+
+// severity ranks designations from healthy (0) to ruled out, so automatic
+// lineups start the healthiest players
+func (i Injury) severity() int {
+	switch {
+	case i.Unavailable():
+		return 2
+	case i.Status == InjuryDoubtful:
+		return 1
+	default:
+		return 0 // Questionable players usually play
+	}
+}
+
+// Injuries are the designations in effect for a week, keyed by player ID. A
+// nil Injuries treats everyone as healthy.
+type Injuries map[string]Injury
+
+// LoadInjuries returns the injury report in effect for a week: the latest one
+// scraped on or before it
+func LoadInjuries(ctx context.Context, queries sqlc.Querier, season, week int64) (Injuries, error) {
+	rows, err := queries.GetInjuriesByWeek(ctx, sqlc.GetInjuriesByWeekParams{Season: season, Week: week})
+	if err != nil {
+		return nil, fmt.Errorf("error getting week %d injuries: %w", week, err)
+	}
+	injuries := make(Injuries, len(rows))
+	for _, row := range rows {
+		injuries[row.PlayerID] = InjuryFromRow(row)
+	}
+	return injuries, nil
+}
+
+// InjuryFromRow converts a stored injury designation
+func InjuryFromRow(row *sqlc.NflInjury) Injury {
+	return Injury{
+		PlayerID: row.PlayerID,
+		Week:     int(row.Week),
+		Status:   row.Status,
+		BodyPart: row.BodyPart.String,
+		Detail:   row.Detail.String,
+		Date:     row.InjuryDate.String,
+	}
+}
+
+// Unavailable reports whether a player is ruled out
+func (in Injuries) Unavailable(playerID string) bool {
+	injury, ok := in[playerID]
+	return ok && injury.Unavailable()
+}
+
+// OnReserve reports whether a player is on injured reserve, so can fill a
+// reserve slot
+func (in Injuries) OnReserve(playerID string) bool {
+	return in[playerID].Status == InjuryIR
+}
+
+// byHealth orders players from healthiest to ruled out, keeping roster order
+// among players with the same designation
+func (in Injuries) byHealth(players []Player) []Player {
+	if len(in) == 0 {
+		return players
+	}
+	sorted := slices.Clone(players)
+	slices.SortStableFunc(sorted, func(a, b Player) int {
+		return cmp.Compare(in[a.ID].severity(), in[b.ID].severity())
+	})
+	return sorted
+}
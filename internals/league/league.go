@@ -1 +1,90 @@
 package league
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+)
+
+// League is a fantasy league saved in the database
+type League struct {
+	ID    int64 // 0 until the league is saved
+	Rules *LeagueRules
+}
+
+// leagueFromRow decodes a saved league
+func leagueFromRow(row *sqlc.FantasyLeague) (*League, error) {
+	rules, err := FromJSON(row.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("error loading rules for league %q: %w", row.Name, err)
+	}
+	return &League{ID: row.LeagueID, Rules: rules}, nil
+}
+
+// SaveLeague validates a league's rules and saves it, creating the league
+// if it hasn't been saved before
+func SaveLeague(ctx context.Context, queries sqlc.Querier, league *League) error {
+	league.Rules.Name = strings.TrimSpace(league.Rules.Name)
+	if league.Rules.Name == "" {
+		return fmt.Errorf("league must have a name")
+	}
+	if err := league.Rules.ValidateRules(); err != nil {
+		return err
+	}
+
+	rulesJSON, err := league.Rules.ToJSON()
+	if err != nil {
+		return err
+	}
+
+	if league.ID == 0 {
+		row, err := queries.CreateFantasyLeague(ctx, sqlc.CreateFantasyLeagueParams{
+			Name:  league.Rules.Name,
+			Rules: rulesJSON,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating league %q: %w", league.Rules.Name, err)
+		}
+		league.ID = row.LeagueID
+		return nil
+	}
+
+	err = queries.UpdateFantasyLeague(ctx, sqlc.UpdateFantasyLeagueParams{
+		Name:     league.Rules.Name,
+		Rules:    rulesJSON,
+		LeagueID: league.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating league %q: %w", league.Rules.Name, err)
+	}
+	return nil
+}
+
+// LoadLeague loads a saved league by ID
+func LoadLeague(ctx context.Context, queries sqlc.Querier, id int64) (*League, error) {
+	row, err := queries.GetFantasyLeague(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting league %d: %w", id, err)
+	}
+	return leagueFromRow(row)
+}
+
+// ListLeagues returns every saved league, most recently updated first
+func ListLeagues(ctx context.Context, queries sqlc.Querier) ([]*League, error) {
+	rows, err := queries.GetAllFantasyLeagues(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting leagues: %w", err)
+	}
+
+	leagues := make([]*League, 0, len(rows))
+	for _, row := range rows {
+		league, err := leagueFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		leagues = append(leagues, league)
+	}
+	return leagues, nil
+}
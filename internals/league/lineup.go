@@ -0,0 +1,119 @@
+package league
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SetLineup sets the players a team starts from its next week on. Each
+// starter must be on the roster, and together they must fit the roster's
+// starting slots. Starting spots left open are filled from the bench in
+// roster order. No starters switches the team back to automatic lineups.
+func SetLineup(team *Team, roster PositionRoster, starters []string) error {
+	players := make([]Player, 0, len(starters))
+	for _, id := range starters {
+		i := slices.IndexFunc(team.Roster, func(p Player) bool { return p.ID == id })
+		if i < 0 {
+			return fmt.Errorf("player %s isn't on %s's roster", id, team.Name)
+		}
+		if slices.ContainsFunc(players, func(p Player) bool { return p.ID == id }) {
+			return fmt.Errorf("%s is listed more than once", team.Roster[i].Name)
+		}
+		players = append(players, team.Roster[i])
+	}
+
+	spots, overflow := roster.Assign(players, nil)
+	if len(overflow) > 0 {
+		return fmt.Errorf("no starting spot is open for %s (%s)", overflow[0].Name, overflow[0].Position)
+	}
+	for _, spot := range spots {
+		if spot.Player != nil && !spot.Slot.IsStarter() {
+			return fmt.Errorf("no starting spot is open for %s (%s)", spot.Player.Name, spot.Player.Position)
+		}
+	}
+
+	team.Starters = slices.Clone(starters)
+	return nil
+}
+
+// ValidateLineup checks starters against a week's injury report. Starting a
+// player who is out or on injured reserve is an error; doubtful and
+// questionable starters come back as warnings.
+func ValidateLineup(team *Team, starters []string, injuries Injuries) ([]string, error) {
+	var warnings, out []string
+	for _, id := range starters {
+		injury, ok := injuries[id]
+		if !ok {
+			continue
+		}
+		name := id
+		if i := slices.IndexFunc(team.Roster, func(p Player) bool { return p.ID == id }); i >= 0 {
+			name = team.Roster[i].Name
+		}
+		switch {
+		case injury.Unavailable():
+			out = append(out, fmt.Sprintf("%s is %s", name, injury))
+		case injury.Status == InjuryDoubtful || injury.Status == InjuryQuestionable:
+			warnings = append(warnings, fmt.Sprintf("%s is %s", name, injury))
+		}
+	}
+	if len(out) > 0 {
+		return warnings, fmt.Errorf("can't start injured players: %s", strings.Join(out, ", "))
+	}
+	return warnings, nil
+}
+
+// lineup returns the team's roster with its starters first, so they take
+// the starting spots when the roster is assigned. Automatic lineups and open
+// spots go to the healthiest players, in roster order.
+func (t *Team) lineup(injuries Injuries) []Player {
+	if len(t.Starters) == 0 {
+		return injuries.byHealth(t.Roster)
+	}
+	players := make([]Player, 0, len(t.Roster))
+	for _, id := range t.Starters {
+		if i := slices.IndexFunc(t.Roster, func(p Player) bool { return p.ID == id }); i >= 0 {
+			players = append(players, t.Roster[i])
+		}
+	}
+	for _, player := range injuries.byHealth(t.Roster) {
+		if !slices.Contains(t.Starters, player.ID) {
+			players = append(players, player)
+		}
+	}
+	return players
+}
+
+// StarterIDs returns the IDs of the players the team's current lineup starts
+// given a week's injuries
+func (t *Team) StarterIDs(roster PositionRoster, injuries Injuries) []string {
+	spots, _ := roster.Assign(t.lineup(injuries), injuries)
+	var ids []string
+	for _, spot := range spots {
+		if spot.Player != nil && spot.Slot.IsStarter() {
+			ids = append(ids, spot.Player.ID)
+		}
+	}
+	return ids
+}
+
+// ForWeek returns the team as it lined up in a week: with the starters
+// locked in when the week was played, or as it is now for weeks to come
+func (t *Team) ForWeek(week int) *Team {
+	starters, ok := t.Lineups[week]
+	if !ok {
+		return t
+	}
+	team := *t
+	team.Starters = starters
+	return &team
+}
+
+// lockLineup records the team's current starters as its lineup for a week
+func (t *Team) lockLineup(week int, roster PositionRoster, injuries Injuries) {
+	if t.Lineups == nil {
+		t.Lineups = make(map[int][]string)
+	}
+	t.Lineups[week] = t.StarterIDs(roster, injuries)
+}
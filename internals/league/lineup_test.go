@@ -0,0 +1,115 @@
+package league
+
+import (
+	"reflect"
+	"testing"
+)
+
+// lineupTeam has two quarterbacks and a running back, with "qb1" drafted first
+func lineupTeam() *Team {
+	return &Team{ID: 1, Name: "Team 1", Roster: []Player{
+		{ID: "qb1", Name: "QB One", Position: "QB"},
+		{ID: "qb2", Name: "QB Two", Position: "QB"},
+		{ID: "rb1", Name: "RB One", Position: "RB"},
+	}}
+}
+
+func TestSetLineup(t *testing.T) {
+	roster := PositionRoster{newSlot("QB", 1), newSlot("RB", 1), newSlot("BN", 2)}
+	team := lineupTeam()
+
+	// Automatic lineups start players in roster order
+	if starters := team.StarterIDs(roster, nil); !reflect.DeepEqual(starters, []string{"qb1", "rb1"}) {
+		t.Errorf("Expected qb1 and rb1 to start, got %v", starters)
+	}
+
+	if err := SetLineup(team, roster, []string{"qb2"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The open RB spot is filled from the bench
+	if starters := team.StarterIDs(roster, nil); !reflect.DeepEqual(starters, []string{"qb2", "rb1"}) {
+		t.Errorf("Expected qb2 and rb1 to start, got %v", starters)
+	}
+
+	invalid := map[string][]string{
+		"not on the roster": {"wr1"},
+		"listed twice":      {"qb2", "qb2"},
+		"two quarterbacks":  {"qb1", "qb2"},
+	}
+	for name, starters := range invalid {
+		if err := SetLineup(team, roster, starters); err == nil {
+			t.Errorf("Expected an error for %s", name)
+		}
+	}
+	if !reflect.DeepEqual(team.Starters, []string{"qb2"}) {
+		t.Errorf("Expected a rejected lineup to leave the starters alone, got %v", team.Starters)
+	}
+}
+
+func TestInjuredLineups(t *testing.T) {
+	roster := PositionRoster{newSlot("QB", 1), newSlot("RB", 1), newSlot("BN", 2)}
+	team := lineupTeam()
+	injuries := Injuries{
+		"qb1": {PlayerID: "qb1", Status: InjuryOut, BodyPart: "Ankle"},
+		"rb1": {PlayerID: "rb1", Status: InjuryQuestionable},
+	}
+
+	// Automatic lineups start the healthy backup and the questionable starter
+	if starters := team.StarterIDs(roster, injuries); !reflect.DeepEqual(starters, []string{"qb2", "rb1"}) {
+		t.Errorf("Expected qb2 and rb1 to start, got %v", starters)
+	}
+
+	warnings, err := ValidateLineup(team, []string{"qb2", "rb1"}, injuries)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(warnings, []string{"RB One is Questionable"}) {
+		t.Errorf("Expected a warning for rb1, got %v", warnings)
+	}
+	if _, err := ValidateLineup(team, []string{"qb1"}, injuries); err == nil {
+		t.Errorf("Expected an error starting a player who is out")
+	}
+
+	// A lineup that's been set is locked in as is
+	if err := SetLineup(team, roster, []string{"qb1"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if starters := team.StarterIDs(roster, injuries); !reflect.DeepEqual(starters, []string{"qb1", "rb1"}) {
+		t.Errorf("Expected qb1 and rb1 to start, got %v", starters)
+	}
+}
+
+func TestLockedLineups(t *testing.T) {
+	rules := DefaultRules()
+	rules.RosterPositions = PositionRoster{newSlot("QB", 1), newSlot("RB", 1), newSlot("BN", 2)}
+	rules.PlayoffWeekStart = 3
+	rules.PlayoffTeams = 2
+
+	team := lineupTeam()
+	season := &Season{Year: 2024, Teams: []*Team{team, lineupTeam()}}
+	scores := map[string]*PlayerWeek{"qb1": {Points: 20}, "qb2": {Points: 5}}
+
+	if _, err := season.Advance(rules, []int{2}, nil); err == nil {
+		t.Errorf("Expected an error advancing into a week that isn't final")
+	}
+	if week, err := season.Advance(rules, []int{1, 2}, nil); err != nil || week != 1 {
+		t.Fatalf("Expected to advance to week 1, got %d: %v", week, err)
+	}
+
+	// Benching qb1 after week 1 doesn't change week 1's score
+	if err := SetLineup(team, rules.RosterPositions, []string{"qb2"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if score := ScoreTeam(team.ForWeek(1), rules.RosterPositions, scores); score.Total != 20 {
+		t.Errorf("Expected week 1 to keep qb1's 20 points, got %.0f", score.Total)
+	}
+	if score := ScoreTeam(team.ForWeek(2), rules.RosterPositions, scores); score.Total != 5 {
+		t.Errorf("Expected week 2 to start qb2 for 5 points, got %.0f", score.Total)
+	}
+
+	// Two regular season weeks and a one-round playoff
+	season.Week = 3
+	if _, err := season.Advance(rules, []int{1, 2, 3, 4}, nil); err == nil {
+		t.Errorf("Expected an error advancing past the championship")
+	}
+}
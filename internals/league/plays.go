@@ -0,0 +1,335 @@
+package league
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+)
+
+// Play types, normalized from ESPN's
+const (
+	PlayPass       = data.PlayPass // Includes interceptions, but not sacks
+	PlayRush       = data.PlayRush
+	PlaySack       = data.PlaySack
+	PlayFieldGoal  = data.PlayFieldGoal
+	PlayExtraPoint = data.PlayExtraPoint
+	PlayTwoPoint   = data.PlayTwoPoint
+	PlayPunt       = data.PlayPunt
+	PlayKickoff    = data.PlayKickoff
+	PlayPenalty    = data.PlayPenalty
+	PlayOther      = data.PlayOther
+)
+
+// Play results, for passes, kicks and two-point tries
+const (
+	ResultComplete    = data.ResultComplete
+	ResultIncomplete  = data.ResultIncomplete
+	ResultIntercepted = data.ResultIntercepted
+	ResultGood        = data.ResultGood
+	ResultMissed      = data.ResultMissed
+	ResultBlocked     = data.ResultBlocked
+)
+
+// Parts a player can have in a play
+const (
+	RolePasser   = "passer"
+	RoleRusher   = "rusher"
+	RoleReceiver = "receiver" // The intended receiver, whether or not it was caught
+	RoleKicker   = "kicker"
+)
+
+// RedZoneYards is how close to the end zone a play has to start to be in
+// the red zone
+const RedZoneYards = 20
+
+// PlayerPlay is a play a player was involved in
+type PlayerPlay struct {
+	PlayID         string `json:"play_id"`
+	GameID         int64  `json:"game_id"`
+	Week           int    `json:"week"`
+	TeamID         string `json:"team_id"` // Team with the ball
+	Role           string `json:"role"`
+	Type           string `json:"type"`
+	Result         string `json:"result,omitempty"`
+	Down           int    `json:"down,omitempty"`
+	Distance       int    `json:"distance,omitempty"`
+	YardsToEndzone int    `json:"yards_to_endzone"`
+	Yards          int    `json:"yards"`
+	Scoring        bool   `json:"scoring"`
+	Description    string `json:"description"`
+}
+
+// RedZone reports whether the play was a pass or run from inside the red zone
+func (p PlayerPlay) RedZone() bool {
+	return (p.Type == PlayPass || p.Type == PlayRush || p.Type == PlaySack) && p.YardsToEndzone <= RedZoneYards
+}
+
+// FieldGoal is a field goal attempt
+type FieldGoal struct {
+	Week     int    `json:"week"`
+	Distance int    `json:"distance"`
+	Result   string `json:"result"`
+}
+
+// PlaySplits sum up a player's plays in a season
+type PlaySplits struct {
+	PlayerID string `json:"player_id"`
+	Season   int    `json:"season"`
+	Games    int    `json:"games"`
+	Plays    int    `json:"plays"`
+
+	PassAttempts      int `json:"pass_attempts"`
+	Completions       int `json:"completions"`
+	PassingYards      int `json:"passing_yards"`
+	PassingTouchdowns int `json:"passing_touchdowns"`
+	Interceptions     int `json:"interceptions"`
+	Sacked            int `json:"sacked"`
+
+	Rushes            int `json:"rushes"`
+	RushingYards      int `json:"rushing_yards"`
+	RushingTouchdowns int `json:"rushing_touchdowns"`
+
+	Targets             int `json:"targets"`
+	Receptions          int `json:"receptions"`
+	ReceivingYards      int `json:"receiving_yards"`
+	ReceivingTouchdowns int `json:"receiving_touchdowns"`
+	TeamPassAttempts    int `json:"team_pass_attempts"` // In the games the player was involved in
+
+	RedZonePassAttempts int `json:"red_zone_pass_attempts"`
+	RedZoneRushes       int `json:"red_zone_rushes"`
+	RedZoneTargets      int `json:"red_zone_targets"`
+
+	TouchdownYards        []int       `json:"touchdown_yards"` // Length of each rushing and receiving touchdown
+	PassingTouchdownYards []int       `json:"passing_touchdown_yards"`
+	FieldGoals            []FieldGoal `json:"field_goals"`
+}
+
+// TargetShare is the share of their team's passes thrown to the player, or 0
+// if their team didn't pass
+func (s *PlaySplits) TargetShare() float64 {
+	if s.TeamPassAttempts == 0 {
+		return 0
+	}
+	return float64(s.Targets) / float64(s.TeamPassAttempts)
+}
+
+// LongTouchdowns counts the player's rushing and receiving touchdowns of at
+// least a number of yards
+func (s *PlaySplits) LongTouchdowns(yards int) int {
+	count := 0
+	for _, length := range s.TouchdownYards {
+		if length >= yards {
+			count++
+		}
+	}
+	return count
+}
+
+// FieldGoalsMade counts the player's made field goals from at least a
+// distance
+func (s *PlaySplits) FieldGoalsMade(distance int) int {
+	count := 0
+	for _, fg := range s.FieldGoals {
+		if fg.Result == ResultGood && fg.Distance >= distance {
+			count++
+		}
+	}
+	return count
+}
+
+// ScoringPlays turns a player's touchdowns, two-point conversions and made
+// field goals into the plays ScoreGame scores, like 40+ yard touchdowns and
+// field goals by distance
+func ScoringPlays(plays []PlayerPlay) []Play {
+	var scoring []Play
+	for _, play := range plays {
+		if p, ok := scoringPlay(play); ok {
+			scoring = append(scoring, p)
+		}
+	}
+	return scoring
+}
+
+// scoringPlay turns a play into one ScoreGame scores, if the player scored
+// on it
+func scoringPlay(play PlayerPlay) (Play, bool) {
+	if play.Type == PlayTwoPoint && play.Result == ResultGood {
+		switch play.Role {
+		case RolePasser:
+			return Play{Category: "passing", StatType: "twoPointConversions"}, true
+		case RoleRusher:
+			return Play{Category: "rushing", StatType: "twoPointConversions"}, true
+		case RoleReceiver:
+			return Play{Category: "receiving", StatType: "twoPointConversions"}, true
+		}
+	}
+	if !play.Scoring {
+		return Play{}, false
+	}
+	switch {
+	case play.Role == RolePasser && play.Type == PlayPass && play.Result == ResultComplete:
+		return Play{Category: "passing", StatType: "passingTouchdowns", Yards: float64(play.Yards)}, true
+	case play.Role == RoleRusher && play.Type == PlayRush:
+		return Play{Category: "rushing", StatType: "rushingTouchdowns", Yards: float64(play.Yards)}, true
+	case play.Role == RoleReceiver && play.Type == PlayPass && play.Result == ResultComplete:
+		return Play{Category: "receiving", StatType: "receivingTouchdowns", Yards: float64(play.Yards)}, true
+	case play.Role == RoleKicker && play.Type == PlayFieldGoal && play.Result == ResultGood:
+		return Play{Category: "kicking", StatType: "fieldGoalsMade", Yards: float64(fieldGoalDistance(play))}, true
+	}
+	return Play{}, false
+}
+
+// LoadPlayerPlays returns every play a player was involved in during a
+// season, in order
+func LoadPlayerPlays(ctx context.Context, queries sqlc.Querier, playerID string, season int64) ([]PlayerPlay, error) {
+	rows, err := queries.GetPlayerPlays(ctx, sqlc.GetPlayerPlaysParams{PlayerID: playerID, Season: season})
+	if err != nil {
+		return nil, fmt.Errorf("error getting plays for player %s: %w", playerID, err)
+	}
+	plays := make([]PlayerPlay, len(rows))
+	for i, row := range rows {
+		plays[i] = PlayerPlay{
+			PlayID:         row.PlayID,
+			GameID:         row.GameID,
+			Week:           int(row.Week),
+			TeamID:         row.TeamID,
+			Role:           row.Role,
+			Type:           row.PlayType,
+			Result:         row.Result,
+			Down:           int(row.Down.Int64),
+			Distance:       int(row.Distance.Int64),
+			YardsToEndzone: int(row.YardsToEndzone),
+			Yards:          int(row.Yards),
+			Scoring:        row.Scoring,
+			Description:    row.Description,
+		}
+	}
+	return plays, nil
+}
+
+// LoadPlaySplits sums up a player's plays in a season
+func LoadPlaySplits(ctx context.Context, queries sqlc.Querier, playerID string, season int64) (*PlaySplits, error) {
+	plays, err := LoadPlayerPlays(ctx, queries, playerID, season)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queries.GetTeamPassAttemptsBySeason(ctx, season)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %d team pass attempts: %w", season, err)
+	}
+	attempts := make(map[teamGame]int, len(rows))
+	for _, row := range rows {
+		attempts[teamGame{row.GameID, row.TeamID}] = int(row.Attempts)
+	}
+	return splitPlays(playerID, int(season), plays, attempts), nil
+}
+
+// teamGame is a team's side of a game
+type teamGame struct {
+	gameID int64
+	teamID string
+}
+
+// splitPlays sums up a player's plays, given how many passes each team threw
+// in each game
+func splitPlays(playerID string, season int, plays []PlayerPlay, teamAttempts map[teamGame]int) *PlaySplits {
+	splits := &PlaySplits{
+		PlayerID:              playerID,
+		Season:                season,
+		TouchdownYards:        []int{},
+		PassingTouchdownYards: []int{},
+		FieldGoals:            []FieldGoal{},
+	}
+	games := make(map[int64]bool)
+	offense := make(map[teamGame]bool) // Games the player was on offense in
+	counted := make(map[string]bool)   // Play IDs, since a player can have two parts in one
+	for _, play := range plays {
+		games[play.GameID] = true
+		if !counted[play.PlayID] {
+			counted[play.PlayID] = true
+			splits.Plays++
+		}
+
+		switch play.Role {
+		case RolePasser:
+			offense[teamGame{play.GameID, play.TeamID}] = true
+			if play.Type == PlaySack {
+				splits.Sacked++
+				continue
+			}
+			if play.Type != PlayPass {
+				continue
+			}
+			splits.PassAttempts++
+			if play.RedZone() {
+				splits.RedZonePassAttempts++
+			}
+			switch play.Result {
+			case ResultComplete:
+				splits.Completions++
+				splits.PassingYards += play.Yards
+				if play.Scoring {
+					splits.PassingTouchdowns++
+					splits.PassingTouchdownYards = append(splits.PassingTouchdownYards, play.Yards)
+				}
+			case ResultIntercepted:
+				splits.Interceptions++
+			}
+		case RoleRusher:
+			offense[teamGame{play.GameID, play.TeamID}] = true
+			if play.Type != PlayRush {
+				continue
+			}
+			splits.Rushes++
+			splits.RushingYards += play.Yards
+			if play.RedZone() {
+				splits.RedZoneRushes++
+			}
+			if play.Scoring {
+				splits.RushingTouchdowns++
+				splits.TouchdownYards = append(splits.TouchdownYards, play.Yards)
+			}
+		case RoleReceiver:
+			offense[teamGame{play.GameID, play.TeamID}] = true
+			if play.Type != PlayPass {
+				continue
+			}
+			splits.Targets++
+			if play.RedZone() {
+				splits.RedZoneTargets++
+			}
+			if play.Result == ResultComplete {
+				splits.Receptions++
+				splits.ReceivingYards += play.Yards
+				if play.Scoring {
+					splits.ReceivingTouchdowns++
+					splits.TouchdownYards = append(splits.TouchdownYards, play.Yards)
+				}
+			}
+		case RoleKicker:
+			if play.Type == PlayFieldGoal {
+				splits.FieldGoals = append(splits.FieldGoals, FieldGoal{
+					Week:     play.Week,
+					Distance: fieldGoalDistance(play),
+					Result:   play.Result,
+				})
+			}
+		}
+	}
+	splits.Games = len(games)
+	for game := range offense {
+		splits.TeamPassAttempts += teamAttempts[game]
+	}
+	return splits
+}
+
+// fieldGoalDistance is a field goal's distance: ESPN's, or else the line of
+// scrimmage plus the end zone and the 7 yards to the hold
+func fieldGoalDistance(play PlayerPlay) int {
+	if play.Yards > 0 {
+		return play.Yards
+	}
+	return play.YardsToEndzone + 17
+}
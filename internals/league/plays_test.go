@@ -0,0 +1,78 @@
+package league
+
+import "testing"
+
+func TestSplitPlays(t *testing.T) {
+	plays := []PlayerPlay{
+		{PlayID: "1", GameID: 10, Week: 1, TeamID: "1", Role: RoleRusher, Type: PlayRush, YardsToEndzone: 75, Yards: 75, Scoring: true},
+		{PlayID: "2", GameID: 10, Week: 1, TeamID: "1", Role: RoleRusher, Type: PlayRush, YardsToEndzone: 5, Yards: 5, Scoring: true},
+		{PlayID: "3", GameID: 10, Week: 1, TeamID: "1", Role: RoleReceiver, Type: PlayPass, Result: ResultIncomplete, YardsToEndzone: 12},
+		{PlayID: "4", GameID: 11, Week: 2, TeamID: "1", Role: RoleReceiver, Type: PlayPass, Result: ResultComplete, YardsToEndzone: 45, Yards: 45, Scoring: true},
+		{PlayID: "4", GameID: 11, Week: 2, TeamID: "1", Role: "scorer", Type: PlayPass, Result: ResultComplete, YardsToEndzone: 45, Yards: 45, Scoring: true},
+		{PlayID: "5", GameID: 11, Week: 2, TeamID: "1", Role: RoleRusher, Type: PlayPenalty, YardsToEndzone: 30, Yards: -10},
+	}
+	attempts := map[teamGame]int{{10, "1"}: 30, {11, "1"}: 20, {11, "2"}: 40}
+
+	splits := splitPlays("1", 2024, plays, attempts)
+	if splits.Games != 2 || splits.Plays != 5 {
+		t.Errorf("Expected 5 plays in 2 games, got %d in %d", splits.Plays, splits.Games)
+	}
+	if splits.Rushes != 2 || splits.RushingYards != 80 || splits.RushingTouchdowns != 2 || splits.RedZoneRushes != 1 {
+		t.Errorf("Unexpected rushing splits: %+v", splits)
+	}
+	if splits.Targets != 2 || splits.Receptions != 1 || splits.ReceivingYards != 45 || splits.RedZoneTargets != 1 {
+		t.Errorf("Unexpected receiving splits: %+v", splits)
+	}
+
+	// The other team's passes don't count toward the player's target share
+	if splits.TeamPassAttempts != 50 || splits.TargetShare() != 0.04 {
+		t.Errorf("Expected 2 targets of 50 team passes, got %d of %d", splits.Targets, splits.TeamPassAttempts)
+	}
+	if long := splits.LongTouchdowns(40); long != 2 {
+		t.Errorf("Expected 2 touchdowns of 40 yards or more, got %d from %v", long, splits.TouchdownYards)
+	}
+}
+
+func TestSplitFieldGoals(t *testing.T) {
+	plays := []PlayerPlay{
+		{PlayID: "1", GameID: 10, Week: 1, TeamID: "1", Role: RoleKicker, Type: PlayFieldGoal, Result: ResultGood, YardsToEndzone: 35, Yards: 52},
+		{PlayID: "2", GameID: 10, Week: 1, TeamID: "1", Role: RoleKicker, Type: PlayFieldGoal, Result: ResultMissed, YardsToEndzone: 38},
+		{PlayID: "3", GameID: 10, Week: 1, TeamID: "1", Role: RoleKicker, Type: PlayFieldGoal, Result: ResultGood, YardsToEndzone: 10},
+		{PlayID: "4", GameID: 10, Week: 1, TeamID: "2", Role: RoleKicker, Type: PlayKickoff, YardsToEndzone: 65},
+	}
+
+	// Without ESPN's distance, it's worked out from the line of scrimmage
+	splits := splitPlays("1", 2024, plays, nil)
+	if len(splits.FieldGoals) != 3 || splits.FieldGoals[1].Distance != 55 || splits.FieldGoals[2].Distance != 27 {
+		t.Fatalf("Unexpected field goals: %+v", splits.FieldGoals)
+	}
+	if made := splits.FieldGoalsMade(50); made != 1 {
+		t.Errorf("Expected 1 field goal made from 50 or more, got %d", made)
+	}
+	if splits.TeamPassAttempts != 0 || splits.TargetShare() != 0 {
+		t.Errorf("Expected a kicker to have no target share, got %+v", splits)
+	}
+}
+
+func TestScoringPlays(t *testing.T) {
+	plays := []PlayerPlay{
+		{PlayID: "1", Role: RoleRusher, Type: PlayRush, Yards: 45, Scoring: true},
+		{PlayID: "2", Role: RoleRusher, Type: PlayRush, Yards: 60},
+		{PlayID: "3", Role: RoleReceiver, Type: PlayPass, Result: ResultComplete, Yards: 52, Scoring: true},
+		{PlayID: "4", Role: RoleReceiver, Type: PlayPass, Result: ResultIntercepted, Yards: 50, Scoring: true},
+		{PlayID: "5", Role: RoleRusher, Type: PlayPenalty, Yards: 15, Scoring: true},
+		{PlayID: "6", Role: RolePasser, Type: PlayTwoPoint, Result: ResultGood},
+		{PlayID: "7", Role: RoleRusher, Type: PlayTwoPoint, Result: ResultMissed},
+	}
+
+	// Only the player's own touchdowns and conversions count: not long gains,
+	// pick sixes, penalties or failed tries
+	scoring := ScoringPlays(plays)
+	if len(scoring) != 3 || scoring[0].StatType != "rushingTouchdowns" || scoring[1].Category != "receiving" || scoring[2].StatType != "twoPointConversions" {
+		t.Fatalf("Unexpected scoring plays: %+v", scoring)
+	}
+	rules, _ := PresetRules("bonus")
+	if points := rules.ScorePlays(scoring, "RB"); points != 6 {
+		t.Errorf("Expected 6 points for two 40+ yard touchdowns and a conversion, got %.2f", points)
+	}
+}
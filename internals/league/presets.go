@@ -0,0 +1,403 @@
+package league
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Preset is a named league configuration built from DefaultRules plus overrides
+type Preset struct {
+	Name        string
+	Description string
+	build       func(rules *LeagueRules) error
+}
+
+// Rules builds a fresh copy of the preset's league rules
+func (p Preset) Rules() (*LeagueRules, error) {
+	rules := DefaultRules()
+	rules.Description = p.Description
+	if p.build != nil {
+		if err := p.build(rules); err != nil {
+			return nil, fmt.Errorf("error building preset %s: %w", p.Name, err)
+		}
+	}
+	return rules, nil
+}
+
+// presets holds every registered preset keyed by lower-case name
+var presets = map[string]Preset{}
+
+// RegisterPreset adds a preset to the registry, replacing any preset with the same name
+func RegisterPreset(name, description string, build func(rules *LeagueRules) error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	presets[name] = Preset{
+		Name:        name,
+		Description: description,
+		build:       build,
+	}
+}
+
+func init() {
+	RegisterPreset("standard", "Standard scoring with no points per reception", func(rules *LeagueRules) error {
+		rules.Name = "Standard League"
+		return nil
+	})
+
+	RegisterPreset("half-ppr", "Standard scoring with 0.5 points per reception", func(rules *LeagueRules) error {
+		rules.Name = "Half PPR League"
+		rules.HalfPPR()
+		return nil
+	})
+
+	RegisterPreset("ppr", "Standard scoring with 1 point per reception", func(rules *LeagueRules) error {
+		rules.Name = "PPR League"
+		rules.EnablePPR()
+		return nil
+	})
+
+	RegisterPreset("6pt-passing-td", "Full PPR with 6 points per passing touchdown", func(rules *LeagueRules) error {
+		rules.Name = "6pt Passing TD League"
+		rules.EnablePPR()
+		return rules.UpdatePointValue("passing", "passingTouchdowns", 6)
+	})
+
+	RegisterPreset("superflex", "Full PPR with a SUPERFLEX (QB/RB/WR/TE) slot", func(rules *LeagueRules) error {
+		rules.Name = "Superflex League"
+		rules.EnablePPR()
+		return rules.SetPositionCount("SUPERFLEX", 1)
+	})
+
+	RegisterPreset("te-premium", "Full PPR with 1.5 points per tight end reception", func(rules *LeagueRules) error {
+		rules.Name = "TE Premium League"
+		rules.EnablePPR()
+		return rules.SetPositionOverride("receiving", "receptions", "TE", 1.5)
+	})
+
+	RegisterPreset("bonus", "Full PPR with yardage milestone, long touchdown and 2-point conversion bonuses", func(rules *LeagueRules) error {
+		rules.Name = "Bonus League"
+		rules.EnablePPR()
+		return setScoringRules(rules, bonusScoring)
+	})
+
+	RegisterPreset("idp", "Full PPR with individual defensive players (DL/LB/DB)", func(rules *LeagueRules) error {
+		rules.Name = "IDP League"
+		rules.EnablePPR()
+		if err := setPositionCounts(rules, idpRoster); err != nil {
+			return err
+		}
+		return setScoringRules(rules, idpScoring)
+	})
+
+	// Platform defaults
+	RegisterPreset("espn", "ESPN default scoring (full PPR)", func(rules *LeagueRules) error {
+		rules.Name = "ESPN League"
+		rules.EnablePPR()
+		return nil
+	})
+
+	RegisterPreset("yahoo", "Yahoo default scoring (half PPR, -1 per interception)", func(rules *LeagueRules) error {
+		rules.Name = "Yahoo League"
+		rules.HalfPPR()
+		return rules.UpdatePointValue("passing", "interceptions", -1)
+	})
+
+	RegisterPreset("sleeper", "Sleeper default scoring (full PPR, -1 per interception, 2 FLEX)", func(rules *LeagueRules) error {
+		rules.Name = "Sleeper League"
+		rules.EnablePPR()
+		if err := rules.UpdatePointValue("passing", "interceptions", -1); err != nil {
+			return err
+		}
+		return setPositionCounts(rules, []presetSlot{{"FLEX", 2}, {"BN", 5}})
+	})
+}
+
+// presetSlot is a roster slot count a preset sets
+type presetSlot struct {
+	position string
+	count    int
+}
+
+// presetRule is a scoring rule a preset sets
+type presetRule struct {
+	category string
+	statType string
+	value    any // Points, or a ScoringRule
+}
+
+// idpRoster adds individual defensive player slots, with a deeper bench
+var idpRoster = []presetSlot{{"DL", 2}, {"LB", 2}, {"DB", 2}, {"BN", 8}}
+
+// idpScoring is tackle-based scoring for individual defensive players
+var idpScoring = []presetRule{
+	{"defensive", "totalTackles", 1.0},
+	{"defensive", "soloTackles", 0.5},
+	{"defensive", "tacklesForLoss", 1.0},
+	{"defensive", "QBHits", 0.5},
+	{"defensive", "sacks", 3.0},
+	{"defensive", "passesDefended", 1.5},
+	{"fumbles", "fumblesRecovered", 2.0},
+	{"interceptions", "interceptions", 3.0},
+}
+
+// bonusScoring is common milestone and play bonuses
+var bonusScoring = []presetRule{
+	{"passing", "passingYardsBonus", NewBonusRule("passingYards", 300, 3)},
+	{"rushing", "rushingYardsBonus", NewBonusRule("rushingYards", 100, 3)},
+	{"receiving", "receivingYardsBonus", NewBonusRule("receivingYards", 100, 3)},
+
+	// Play bonuses are scored from each game's play-by-play
+	{"passing", "longPassingTouchdownBonus", NewPlayBonusRule("passingTouchdowns", 40, 2)},
+	{"rushing", "longRushingTouchdownBonus", NewPlayBonusRule("rushingTouchdowns", 40, 2)},
+	{"receiving", "longReceivingTouchdownBonus", NewPlayBonusRule("receivingTouchdowns", 40, 2)},
+	{"passing", "twoPointConversions", NewPlayBonusRule("twoPointConversions", 0, 2)},
+	{"rushing", "twoPointConversions", NewPlayBonusRule("twoPointConversions", 0, 2)},
+	{"receiving", "twoPointConversions", NewPlayBonusRule("twoPointConversions", 0, 2)},
+}
+
+// setPositionCounts sets a preset's roster slot counts
+func setPositionCounts(rules *LeagueRules, slots []presetSlot) error {
+	for _, slot := range slots {
+		if err := rules.SetPositionCount(slot.position, slot.count); err != nil {
+			return fmt.Errorf("error setting %s slots: %w", slot.position, err)
+		}
+	}
+	return nil
+}
+
+// setScoringRules sets a preset's scoring rules
+func setScoringRules(rules *LeagueRules, scoring []presetRule) error {
+	for _, rule := range scoring {
+		if err := rules.SetScoringRule(rule.category, rule.statType, rule.value); err != nil {
+			return fmt.Errorf("error setting %s %s scoring: %w", rule.category, rule.statType, err)
+		}
+	}
+	return nil
+}
+
+// Presets returns all registered presets sorted by name
+func Presets() []Preset {
+	list := make([]Preset, 0, len(presets))
+	for _, preset := range presets {
+		list = append(list, preset)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// PresetNames returns the names of all registered presets in sorted order
+func PresetNames() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PresetRules returns a fresh copy of the rules for a named preset
+func PresetRules(name string) (*LeagueRules, error) {
+	preset, ok := presets[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return nil, fmt.Errorf("unknown preset: %s (available: %s)", name, strings.Join(PresetNames(), ", "))
+	}
+	return preset.Rules()
+}
+
+// RuleDifference describes a single setting that differs between two rule sets
+type RuleDifference struct {
+	Setting  string `json:"setting"`  // e.g. "ppr", "roster.QB" or "scoring.passing.passingTouchdowns"
+	Expected string `json:"expected"` // Value in the reference rules ("" if missing)
+	Actual   string `json:"actual"`   // Value in the compared rules ("" if missing)
+}
+
+// String returns a readable description of the difference
+func (d RuleDifference) String() string {
+	switch {
+	case d.Expected == "":
+		return fmt.Sprintf("%s: added (%s)", d.Setting, d.Actual)
+	case d.Actual == "":
+		return fmt.Sprintf("%s: removed (was %s)", d.Setting, d.Expected)
+	default:
+		return fmt.Sprintf("%s: %s -> %s", d.Setting, d.Expected, d.Actual)
+	}
+}
+
+// DiffFromPreset reports how the rules differ from a named preset
+func (l *LeagueRules) DiffFromPreset(name string) ([]RuleDifference, error) {
+	preset, err := PresetRules(name)
+	if err != nil {
+		return nil, err
+	}
+	return preset.Diff(l), nil
+}
+
+// Diff reports every setting in other that differs from l. League name and
+// description are not considered rule settings.
+func (l *LeagueRules) Diff(other *LeagueRules) []RuleDifference {
+	var diffs []RuleDifference
+
+	addInt := func(setting string, expected, actual int) {
+		if expected != actual {
+			diffs = append(diffs, RuleDifference{Setting: setting, Expected: strconv.Itoa(expected), Actual: strconv.Itoa(actual)})
+		}
+	}
+
+	addInt("team_count", l.TeamCount, other.TeamCount)
+	if l.PPR != other.PPR {
+		diffs = append(diffs, RuleDifference{Setting: "ppr", Expected: strconv.FormatBool(l.PPR), Actual: strconv.FormatBool(other.PPR)})
+	}
+	if l.PPRValue != other.PPRValue {
+		diffs = append(diffs, RuleDifference{Setting: "ppr_value", Expected: formatPoints(l.PPRValue), Actual: formatPoints(other.PPRValue)})
+	}
+	addInt("playoff_week_start", l.PlayoffWeekStart, other.PlayoffWeekStart)
+	addInt("playoff_teams", l.PlayoffTeams, other.PlayoffTeams)
+	addInt("divisions", l.Divisions, other.Divisions)
+
+	// Compare roster slots across the union of slot names
+	var slotNames []string
+	seenSlots := make(map[string]bool)
+	for _, roster := range []PositionRoster{l.RosterPositions, other.RosterPositions} {
+		for _, slot := range roster {
+			if !seenSlots[slot.Slot] {
+				seenSlots[slot.Slot] = true
+				slotNames = append(slotNames, slot.Slot)
+			}
+		}
+	}
+	sort.SliceStable(slotNames, func(i, j int) bool {
+		return slotOrder(slotNames[i]) < slotOrder(slotNames[j])
+	})
+
+	for _, name := range slotNames {
+		expected, _ := l.RosterPositions.Slot(name)
+		actual, _ := other.RosterPositions.Slot(name)
+		addInt("roster."+name, expected.Count, actual.Count)
+
+		if expected.Count > 0 && actual.Count > 0 && describeSlot(expected) != describeSlot(actual) {
+			diffs = append(diffs, RuleDifference{
+				Setting:  "roster." + name + ".eligibility",
+				Expected: describeSlot(expected),
+				Actual:   describeSlot(actual),
+			})
+		}
+	}
+
+	// Compare scoring rules across the union of categories and stat types
+	categories := make(map[string]bool)
+	for category := range l.ScoringRules {
+		categories[category] = true
+	}
+	for category := range other.ScoringRules {
+		categories[category] = true
+	}
+
+	sortedCategories := make([]string, 0, len(categories))
+	for category := range categories {
+		sortedCategories = append(sortedCategories, category)
+	}
+	sort.Strings(sortedCategories)
+
+	for _, category := range sortedCategories {
+		statTypes := make(map[string]bool)
+		for statType := range l.ScoringRules[category] {
+			statTypes[statType] = true
+		}
+		for statType := range other.ScoringRules[category] {
+			statTypes[statType] = true
+		}
+
+		sortedStatTypes := make([]string, 0, len(statTypes))
+		for statType := range statTypes {
+			sortedStatTypes = append(sortedStatTypes, statType)
+		}
+		sort.Strings(sortedStatTypes)
+
+		for _, statType := range sortedStatTypes {
+			expected, inExpected := l.ScoringRules[category][statType]
+			actual, inActual := other.ScoringRules[category][statType]
+
+			diff := RuleDifference{Setting: fmt.Sprintf("scoring.%s.%s", category, statType)}
+			if inExpected {
+				diff.Expected = describeRule(expected)
+			}
+			if inActual {
+				diff.Actual = describeRule(actual)
+			}
+
+			if diff.Expected != diff.Actual {
+				diffs = append(diffs, diff)
+			}
+		}
+	}
+
+	return diffs
+}
+
+// describeSlot renders a roster slot's eligibility compactly for comparisons
+func describeSlot(slot RosterSlot) string {
+	description := "any"
+	if len(slot.Positions) > 0 {
+		description = strings.Join(slot.Positions, "/")
+	}
+	if slot.Bench {
+		description += " (bench)"
+	}
+	if slot.Reserve {
+		description += " (reserve)"
+	}
+	return description
+}
+
+// describeRule renders a scoring rule compactly for comparisons, including
+// any position overrides
+func describeRule(rule ScoringRule) string {
+	description := describeRuleValue(rule)
+	if len(rule.Positions) == 0 {
+		return description
+	}
+
+	positions := make([]string, 0, len(rule.Positions))
+	for position := range rule.Positions {
+		positions = append(positions, position)
+	}
+	sort.Strings(positions)
+
+	overrides := make([]string, 0, len(positions))
+	for _, position := range positions {
+		overrides = append(overrides, fmt.Sprintf("%s: %s", position, describeRuleValue(rule.Positions[position])))
+	}
+	return fmt.Sprintf("%s [%s]", description, strings.Join(overrides, ", "))
+}
+
+// describeRuleValue renders a scoring rule's points without its overrides
+func describeRuleValue(rule ScoringRule) string {
+	switch rule.Type {
+	case PerUnit:
+		return fmt.Sprintf("%s per unit", formatPoints(rule.Value))
+	case RangeBased:
+		rangeKeys := make([]string, 0, len(rule.Ranges))
+		for rangeKey := range rule.Ranges {
+			rangeKeys = append(rangeKeys, rangeKey)
+		}
+		sort.Slice(rangeKeys, func(i, j int) bool {
+			return rangeLowerBound(rangeKeys[i]) < rangeLowerBound(rangeKeys[j])
+		})
+
+		terms := make([]string, 0, len(rangeKeys))
+		for _, rangeKey := range rangeKeys {
+			terms = append(terms, fmt.Sprintf("%s: %s", rangeKey, formatPoints(rule.Ranges[rangeKey])))
+		}
+		return "{" + strings.Join(terms, ", ") + "}"
+	case Bonus:
+		if rule.PerPlay {
+			return fmt.Sprintf("%s per %s+ yd %s play", formatPoints(rule.Value), formatPoints(rule.Threshold), rule.Stat)
+		}
+		return fmt.Sprintf("%s at %s+ %s", formatPoints(rule.Value), formatPoints(rule.Threshold), rule.Stat)
+	default:
+		return formatPoints(rule.Value)
+	}
+}
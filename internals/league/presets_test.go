@@ -0,0 +1,144 @@
+package league
+
+import (
+	"testing"
+)
+
+func TestPresetNames(t *testing.T) {
+	expected := []string{"standard", "half-ppr", "ppr", "6pt-passing-td", "superflex", "te-premium", "idp"}
+
+	names := make(map[string]bool)
+	for _, name := range PresetNames() {
+		names[name] = true
+	}
+
+	for _, name := range expected {
+		if !names[name] {
+			t.Errorf("Expected preset %s to be registered", name)
+		}
+	}
+}
+
+func TestPresetRulesAreValid(t *testing.T) {
+	for _, preset := range Presets() {
+		rules, err := preset.Rules()
+		if err != nil {
+			t.Errorf("Error building preset %s: %v", preset.Name, err)
+			continue
+		}
+		if err := rules.ValidateRules(); err != nil {
+			t.Errorf("Expected preset %s to be valid, got error: %v", preset.Name, err)
+		}
+		if rules.Description != preset.Description {
+			t.Errorf("Expected preset %s description to be %q, got %q", preset.Name, preset.Description, rules.Description)
+		}
+	}
+}
+
+func TestPresetTables(t *testing.T) {
+	// Every rule and slot in the preset tables makes it into the rules
+	rules, err := PresetRules("idp")
+	if err != nil {
+		t.Fatalf("Error building the IDP preset: %v", err)
+	}
+	for _, slot := range idpRoster {
+		if got := rules.RosterPositions.Count(slot.position); got != slot.count {
+			t.Errorf("Expected %d %s slots, got %d", slot.count, slot.position, got)
+		}
+	}
+	for _, rule := range idpScoring {
+		if _, ok := rules.ScoringRules[rule.category][rule.statType]; !ok {
+			t.Errorf("Expected IDP scoring for %s %s", rule.category, rule.statType)
+		}
+	}
+
+	rules, err = PresetRules("bonus")
+	if err != nil {
+		t.Fatalf("Error building the bonus preset: %v", err)
+	}
+	for _, rule := range bonusScoring {
+		if got := rules.ScoringRules[rule.category][rule.statType]; got.Type != Bonus {
+			t.Errorf("Expected a bonus rule for %s %s, got %+v", rule.category, rule.statType, got)
+		}
+	}
+}
+
+func TestPresetRules(t *testing.T) {
+	// Lookup is case-insensitive
+	rules, err := PresetRules("Half-PPR")
+	if err != nil {
+		t.Fatalf("Error getting half-ppr preset: %v", err)
+	}
+	if !rules.PPR || rules.ScoringRules["receiving"]["receptions"].Value != 0.5 {
+		t.Errorf("Expected half-ppr preset to award 0.5 points per reception")
+	}
+
+	rules, err = PresetRules("6pt-passing-td")
+	if err != nil {
+		t.Fatalf("Error getting 6pt-passing-td preset: %v", err)
+	}
+	if rules.ScoringRules["passing"]["passingTouchdowns"].Value != 6 {
+		t.Errorf("Expected 6pt-passing-td preset to award 6 points per passing TD")
+	}
+
+	// Each call returns an independent copy
+	rules.UpdatePointValue("passing", "passingTouchdowns", 10)
+	fresh, _ := PresetRules("6pt-passing-td")
+	if fresh.ScoringRules["passing"]["passingTouchdowns"].Value != 6 {
+		t.Errorf("Expected preset rules to be rebuilt on every call")
+	}
+
+	if _, err := PresetRules("nonexistent"); err == nil {
+		t.Errorf("Expected error for unknown preset")
+	}
+}
+
+func TestDiffFromPreset(t *testing.T) {
+	rules, _ := PresetRules("ppr")
+
+	// Identical rules have no differences
+	diffs, err := rules.DiffFromPreset("ppr")
+	if err != nil {
+		t.Fatalf("Error diffing against preset: %v", err)
+	}
+	if len(diffs) != 0 {
+		t.Errorf("Expected no differences from own preset, got %v", diffs)
+	}
+
+	// Changes, additions and removals are all reported
+	rules.Name = "Renamed League"
+	rules.UpdatePointValue("passing", "passingTouchdowns", 6)
+	rules.SetPositionCount("WR", 3)
+	rules.SetScoringRule("passing", "passing2PtConversions", 2.0)
+	delete(rules.ScoringRules["kicking"], "extraPointsMade")
+
+	diffs, err = rules.DiffFromPreset("ppr")
+	if err != nil {
+		t.Fatalf("Error diffing against preset: %v", err)
+	}
+
+	found := make(map[string]RuleDifference)
+	for _, diff := range diffs {
+		found[diff.Setting] = diff
+	}
+
+	if len(found) != 4 {
+		t.Errorf("Expected 4 differences, got %d: %v", len(found), diffs)
+	}
+	if diff := found["scoring.passing.passingTouchdowns"]; diff.Expected != "4" || diff.Actual != "6" {
+		t.Errorf("Expected passing TD change 4 -> 6, got %+v", diff)
+	}
+	if diff := found["roster.WR"]; diff.Expected != "2" || diff.Actual != "3" {
+		t.Errorf("Expected WR change 2 -> 3, got %+v", diff)
+	}
+	if diff := found["scoring.passing.passing2PtConversions"]; diff.Expected != "" || diff.Actual != "2" {
+		t.Errorf("Expected added 2pt conversion rule, got %+v", diff)
+	}
+	if diff := found["scoring.kicking.extraPointsMade"]; diff.Expected != "1" || diff.Actual != "" {
+		t.Errorf("Expected removed extra point rule, got %+v", diff)
+	}
+
+	if _, err := rules.DiffFromPreset("nonexistent"); err == nil {
+		t.Errorf("Expected error for unknown preset")
+	}
+}
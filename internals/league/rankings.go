@@ -0,0 +1,104 @@
+package league
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RankedPlayer is a player ranked by fantasy points over a season
+type RankedPlayer struct {
+	Player
+	Rank          int // Overall rank, starting at 1
+	PositionRank  int // Rank among players at the same position, starting at 1
+	Games         int
+	Points        float64
+	PointsPerGame float64
+	Depth         int     // Place on their team's depth chart at their position, 0 if they aren't on one
+	Projected     float64 // Points projected from their depth, as returned by ProjectPoints
+}
+
+// RankPlayers ranks players by their projected fantasy points: their season
+// points, adjusted for their place on the depth chart when there is one.
+// Players without stats or who can't fill a starting slot in the roster are
+// left out.
+func RankPlayers(players []Player, summaries map[string]*PlayerSeasonSummary, roster PositionRoster, depths DepthChart) []*RankedPlayer {
+	ranked := make([]*RankedPlayer, 0, len(summaries))
+	for _, player := range players {
+		summary, ok := summaries[player.ID]
+		if !ok || !roster.CanStart(player.Position) {
+			continue
+		}
+		depth := depths.Depth(player.ID, player.Position)
+		ranked = append(ranked, &RankedPlayer{
+			Player:        player,
+			Games:         summary.Games,
+			Points:        summary.Points,
+			PointsPerGame: summary.PointsPerGame(),
+			Depth:         depth,
+			Projected:     ProjectPoints(summary, depth),
+		})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Projected != ranked[j].Projected {
+			return ranked[i].Projected > ranked[j].Projected
+		}
+		if ranked[i].Name != ranked[j].Name {
+			return ranked[i].Name < ranked[j].Name
+		}
+		return ranked[i].ID < ranked[j].ID
+	})
+
+	positionCounts := make(map[string]int)
+	for i, player := range ranked {
+		player.Rank = i + 1
+		positionCounts[player.Position]++
+		player.PositionRank = positionCounts[player.Position]
+	}
+	return ranked
+}
+
+// Rankings ranks every player who recorded stats in a season under the
+// scorer's rules, using each player's current NFL team and the season's
+// latest depth charts
+func (s *Scorer) Rankings(ctx context.Context, season int64) ([]*RankedPlayer, error) {
+	summaries, err := s.SeasonSummaries(ctx, season)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.queries.GetAllNFLTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting teams: %w", err)
+	}
+	abbreviations := make(map[string]string, len(teams))
+	for _, team := range teams {
+		abbreviations[team.TeamID] = team.Abbreviation
+	}
+
+	nflPlayers, err := s.queries.GetAllNFLPlayers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting players: %w", err)
+	}
+	players := make([]Player, 0, len(nflPlayers))
+	for _, p := range nflPlayers {
+		team, ok := abbreviations[p.TeamID.String]
+		if !ok {
+			team = "FA"
+		}
+		players = append(players, Player{
+			ID:       p.PlayerID,
+			Name:     p.FullName,
+			Position: strings.ToUpper(p.Position),
+			NFLTeam:  team,
+		})
+	}
+
+	depths, err := LoadDepthChart(ctx, s.queries, season, LastNFLWeek)
+	if err != nil {
+		return nil, err
+	}
+	return RankPlayers(players, summaries, s.rules.RosterPositions, depths), nil
+}
@@ -0,0 +1,76 @@
+package league
+
+import "testing"
+
+func TestRankPlayers(t *testing.T) {
+	players := []Player{
+		{ID: "1", Name: "Quarterback", Position: "QB"},
+		{ID: "2", Name: "Running Back", Position: "RB"},
+		{ID: "3", Name: "Backup Back", Position: "RB"},
+		{ID: "4", Name: "Linebacker", Position: "LB"},
+		{ID: "5", Name: "No Stats", Position: "WR"},
+	}
+	summaries := map[string]*PlayerSeasonSummary{
+		"1": {PlayerID: "1", Games: 2, Points: 40},
+		"2": {PlayerID: "2", Games: 2, Points: 50},
+		"3": {PlayerID: "3", Games: 1, Points: 10},
+		"4": {PlayerID: "4", Games: 2, Points: 30},
+	}
+
+	ranked := RankPlayers(players, summaries, DefaultRules().RosterPositions, nil)
+
+	// The linebacker has no starting slot and the receiver has no stats
+	if len(ranked) != 3 {
+		t.Fatalf("Expected 3 ranked players, got %d", len(ranked))
+	}
+
+	expected := []struct {
+		id           string
+		rank         int
+		positionRank int
+	}{{"2", 1, 1}, {"1", 2, 1}, {"3", 3, 2}}
+	for i, want := range expected {
+		if ranked[i].ID != want.id || ranked[i].Rank != want.rank || ranked[i].PositionRank != want.positionRank {
+			t.Errorf("Rank %d: expected %+v, got %s rank %d position rank %d",
+				i+1, want, ranked[i].ID, ranked[i].Rank, ranked[i].PositionRank)
+		}
+	}
+	if ranked[0].PointsPerGame != 25 {
+		t.Errorf("Expected 25 points per game, got %.2f", ranked[0].PointsPerGame)
+	}
+}
+
+func TestRankPlayersDepth(t *testing.T) {
+	players := []Player{
+		{ID: "1", Name: "Former Starter", Position: "RB"},
+		{ID: "2", Name: "New Starter", Position: "RB"},
+		{ID: "3", Name: "Free Agent", Position: "RB"},
+	}
+	summaries := map[string]*PlayerSeasonSummary{
+		"1": {PlayerID: "1", Games: 10, Points: 150},
+		"2": {PlayerID: "2", Games: 2, Points: 40},
+		"3": {PlayerID: "3", Games: 4, Points: 60},
+	}
+	depths := DepthChart{
+		"1": {{TeamID: "1", Slot: "RB", Position: "RB", Depth: 2, PlayerID: "1"}},
+		"2": {
+			{TeamID: "1", Slot: "KR", Position: "KR", Depth: 1, PlayerID: "2"},
+			{TeamID: "1", Slot: "RB", Position: "RB", Depth: 1, PlayerID: "2"},
+		},
+	}
+
+	// The starter is projected over a full season and the backup over the
+	// games they'd start, while the player off the chart keeps their points
+	ranked := RankPlayers(players, summaries, DefaultRules().RosterPositions, depths)
+	expected := []struct {
+		id        string
+		depth     int
+		projected float64
+	}{{"2", 1, 340}, {"1", 2, 90}, {"3", 0, 60}}
+	for i, want := range expected {
+		if ranked[i].ID != want.id || ranked[i].Depth != want.depth || ranked[i].Projected != want.projected {
+			t.Errorf("Rank %d: expected %+v, got %s depth %d projected %.1f",
+				i+1, want, ranked[i].ID, ranked[i].Depth, ranked[i].Projected)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package league
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// RosterSlot defines a roster slot, how many of it a team has and which NFL
+// positions may fill it
+type RosterSlot struct {
+	Slot      string   `json:"slot"`                // Slot name (e.g. "QB", "FLEX", "IR")
+	Count     int      `json:"count"`               // Number of slots of this kind
+	Positions []string `json:"positions,omitempty"` // Eligible NFL positions (empty means any position)
+	Bench     bool     `json:"bench,omitempty"`     // Bench slots are rostered but don't start
+	Reserve   bool     `json:"reserve,omitempty"`   // Reserve slots (IR) don't count toward roster size
+}
+
+// Accepts reports whether a player at the given NFL position can fill the slot
+func (s RosterSlot) Accepts(position string) bool {
+	if len(s.Positions) == 0 {
+		return true
+	}
+	position = strings.ToUpper(position)
+	for _, eligible := range s.Positions {
+		if eligible == position {
+			return true
+		}
+	}
+	return false
+}
+
+// IsStarter reports whether players in the slot are in the starting lineup
+func (s RosterSlot) IsStarter() bool {
+	return !s.Bench && !s.Reserve
+}
+
+// Position groups shared by several slot definitions
+var (
+	defensiveLinePositions = []string{"DL", "DE", "DT", "NT"}
+	linebackerPositions    = []string{"LB", "ILB", "OLB", "MLB"}
+	defensiveBackPositions = []string{"DB", "CB", "S", "FS", "SS"}
+)
+
+// slotDefinitions lists the built-in roster slots in display order
+var slotDefinitions = []RosterSlot{
+	{Slot: "QB", Positions: []string{"QB"}},
+	{Slot: "RB", Positions: []string{"RB", "FB"}},
+	{Slot: "WR", Positions: []string{"WR"}},
+	{Slot: "TE", Positions: []string{"TE"}},
+	{Slot: "FLEX", Positions: []string{"RB", "FB", "WR", "TE"}},
+	{Slot: "SUPERFLEX", Positions: []string{"QB", "RB", "FB", "WR", "TE"}},
+	{Slot: "K", Positions: []string{"K", "PK"}},
+	{Slot: "DST", Positions: []string{"DST"}},
+	{Slot: "DL", Positions: defensiveLinePositions},
+	{Slot: "LB", Positions: linebackerPositions},
+	{Slot: "DB", Positions: defensiveBackPositions},
+	{Slot: "IDP", Positions: concatPositions(defensiveLinePositions, linebackerPositions, defensiveBackPositions)},
+	{Slot: "BN", Bench: true},
+	{Slot: "IR", Reserve: true},
+}
+
+// concatPositions joins position groups into a new slice
+func concatPositions(groups ...[]string) []string {
+	var positions []string
+	for _, group := range groups {
+		positions = append(positions, group...)
+	}
+	return positions
+}
+
+// SlotDefinition returns the built-in definition for a slot name with a zero count
+func SlotDefinition(name string) (RosterSlot, bool) {
+	name = strings.ToUpper(name)
+	for _, def := range slotDefinitions {
+		if def.Slot == name {
+			def.Positions = append([]string(nil), def.Positions...)
+			return def, true
+		}
+	}
+	return RosterSlot{}, false
+}
+
+// SlotNames returns the names of all built-in roster slots in display order
+func SlotNames() []string {
+	names := make([]string, len(slotDefinitions))
+	for i, def := range slotDefinitions {
+		names[i] = def.Slot
+	}
+	return names
+}
+
+// slotOrder returns the display order of a slot; custom slots sort after built-in ones
+func slotOrder(name string) int {
+	for i, def := range slotDefinitions {
+		if def.Slot == name {
+			return i
+		}
+	}
+	return len(slotDefinitions)
+}
+
+// newSlot returns a built-in slot with the given count
+func newSlot(name string, count int) RosterSlot {
+	slot, _ := SlotDefinition(name)
+	slot.Count = count
+	return slot
+}
+
+// PositionRoster defines the roster slots of every team in a league
+type PositionRoster []RosterSlot
+
+// Slot returns the slot with the given name
+func (r PositionRoster) Slot(name string) (RosterSlot, bool) {
+	name = strings.ToUpper(name)
+	for _, slot := range r {
+		if slot.Slot == name {
+			return slot, true
+		}
+	}
+	return RosterSlot{}, false
+}
+
+// Count returns the number of slots with the given name (0 if the slot isn't used)
+func (r PositionRoster) Count(name string) int {
+	slot, _ := r.Slot(name)
+	return slot.Count
+}
+
+// EligibleSlots returns the slots a player at the given NFL position can fill
+func (r PositionRoster) EligibleSlots(position string) []RosterSlot {
+	var slots []RosterSlot
+	for _, slot := range r {
+		if slot.Count > 0 && slot.Accepts(position) {
+			slots = append(slots, slot)
+		}
+	}
+	return slots
+}
+
+// LineupSlot is a single roster spot and the player filling it
+type LineupSlot struct {
+	Slot   RosterSlot
+	Player *Player // nil when the spot is open
+}
+
+// Assign places players into roster spots in order, putting each player in
+// the most specific open starting spot they can fill and then on the bench.
+// Players on injured reserve go to open reserve (IR) slots first, so they
+// don't take up a spot that counts toward roster size. Players that don't
+// fit are returned separately.
+func (r PositionRoster) Assign(players []Player, injuries Injuries) ([]LineupSlot, []Player) {
+	var spots []LineupSlot
+	for _, slot := range r {
+		for i := 0; i < slot.Count; i++ {
+			spots = append(spots, LineupSlot{Slot: slot})
+		}
+	}
+
+	var overflow []Player
+	for i := range players {
+		best := -1
+		reserve := injuries.OnReserve(players[i].ID)
+		for j, spot := range spots {
+			if spot.Player != nil || !spot.Slot.Accepts(players[i].Position) || (spot.Slot.Reserve && !reserve) {
+				continue
+			}
+			if best < 0 || spotPreferred(spot.Slot, spots[best].Slot) {
+				best = j
+			}
+		}
+		if best < 0 {
+			overflow = append(overflow, players[i])
+			continue
+		}
+		spots[best].Player = &players[i]
+	}
+	return spots, overflow
+}
+
+// spotPreferred reports whether a player should fill slot a before slot b:
+// reserve slots for the players allowed in them, then starters before the
+// bench, then slots accepting fewer positions first
+func spotPreferred(a, b RosterSlot) bool {
+	if a.Reserve != b.Reserve {
+		return a.Reserve
+	}
+	if a.IsStarter() != b.IsStarter() {
+		return a.IsStarter()
+	}
+	return slotBreadth(a) < slotBreadth(b)
+}
+
+// slotBreadth returns how many positions a slot accepts, treating slots that
+// accept any position as the broadest
+func slotBreadth(slot RosterSlot) int {
+	if len(slot.Positions) == 0 {
+		return int(^uint(0) >> 1)
+	}
+	return len(slot.Positions)
+}
+
+// CanStart reports whether a player at the given NFL position can fill any starting slot
+func (r PositionRoster) CanStart(position string) bool {
+	for _, slot := range r {
+		if slot.Count > 0 && slot.IsStarter() && slot.Accepts(position) {
+			return true
+		}
+	}
+	return false
+}
+
+// set updates a slot's count, adding the slot in display order if it isn't present
+func (r *PositionRoster) set(slot RosterSlot) {
+	for i := range *r {
+		if (*r)[i].Slot == slot.Slot {
+			(*r)[i] = slot
+			return
+		}
+	}
+
+	// Insert before the first slot that sorts after the new one
+	order := slotOrder(slot.Slot)
+	for i := range *r {
+		if slotOrder((*r)[i].Slot) > order {
+			*r = append((*r)[:i], append(PositionRoster{slot}, (*r)[i:]...)...)
+			return
+		}
+	}
+	*r = append(*r, slot)
+}
+
+// UnmarshalJSON accepts both the slot list format and the legacy
+// {"qb": 1, "rb": 2, ...} object format used by older rules files
+func (r *PositionRoster) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if len(data) > 0 && data[0] == '{' {
+		var legacy map[string]int
+		if err := json.Unmarshal(data, &legacy); err != nil {
+			return fmt.Errorf("error unmarshaling roster positions: %w", err)
+		}
+
+		roster := PositionRoster{}
+		for name, count := range legacy {
+			slot, ok := SlotDefinition(name)
+			if !ok {
+				return fmt.Errorf("invalid roster position: %s", name)
+			}
+			slot.Count = count
+			roster.set(slot)
+		}
+		*r = roster
+		return nil
+	}
+
+	var slots []RosterSlot
+	if err := json.Unmarshal(data, &slots); err != nil {
+		return fmt.Errorf("error unmarshaling roster positions: %w", err)
+	}
+
+	roster := PositionRoster{}
+	for _, slot := range slots {
+		slot.Slot = strings.ToUpper(slot.Slot)
+		for i := range slot.Positions {
+			slot.Positions[i] = strings.ToUpper(slot.Positions[i])
+		}
+
+		// Built-in slots may omit their eligibility and flags
+		if def, ok := SlotDefinition(slot.Slot); ok && len(slot.Positions) == 0 && !slot.Bench && !slot.Reserve {
+			def.Count = slot.Count
+			slot = def
+		}
+		roster = append(roster, slot)
+	}
+	*r = roster
+	return nil
+}
@@ -0,0 +1,195 @@
+package league
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLegacyRosterJSON(t *testing.T) {
+	// Rules files written before roster slots were data-driven
+	legacyJSON := `{
+		"name": "Legacy League",
+		"team_count": 12,
+		"roster_positions": {"qb": 1, "rb": 2, "wr": 3, "te": 1, "flex": 1, "k": 1, "dst": 1, "bn": 7},
+		"scoring_rules": {},
+		"playoff_week_start": 15,
+		"playoff_teams": 4
+	}`
+
+	rules, err := FromJSON(legacyJSON)
+	if err != nil {
+		t.Fatalf("Error loading legacy rules: %v", err)
+	}
+
+	if rules.RosterPositions.Count("WR") != 3 || rules.RosterPositions.Count("BN") != 7 {
+		t.Errorf("Legacy roster counts not preserved: %+v", rules.RosterPositions)
+	}
+	if rules.TotalRosterSize() != 17 {
+		t.Errorf("Expected legacy roster size of 17, got %d", rules.TotalRosterSize())
+	}
+
+	// Slots are restored in display order with their built-in eligibility
+	names := make([]string, len(rules.RosterPositions))
+	for i, slot := range rules.RosterPositions {
+		names[i] = slot.Slot
+	}
+	if strings.Join(names, ",") != "QB,RB,WR,TE,FLEX,K,DST,BN" {
+		t.Errorf("Unexpected slot order: %v", names)
+	}
+	flex, _ := rules.RosterPositions.Slot("FLEX")
+	if !flex.Accepts("TE") || flex.Accepts("QB") {
+		t.Errorf("Expected FLEX to accept TE but not QB")
+	}
+
+	// Unknown legacy positions are rejected
+	if _, err := FromJSON(`{"roster_positions": {"xx": 1}}`); err == nil {
+		t.Errorf("Expected error for unknown legacy roster position")
+	}
+}
+
+func TestRosterJSONRoundTrip(t *testing.T) {
+	original := DefaultRules()
+	original.SetPositionCount("SUPERFLEX", 1)
+	original.SetPositionCount("IR", 2)
+	original.SetRosterSlot(RosterSlot{Slot: "WRTE", Count: 1, Positions: []string{"wr", "te"}})
+
+	jsonStr, err := original.ToJSON()
+	if err != nil {
+		t.Fatalf("Error converting to JSON: %v", err)
+	}
+
+	restored, err := FromJSON(jsonStr)
+	if err != nil {
+		t.Fatalf("Error converting from JSON: %v", err)
+	}
+
+	if len(restored.RosterPositions) != len(original.RosterPositions) {
+		t.Fatalf("Expected %d slots after round-trip, got %d", len(original.RosterPositions), len(restored.RosterPositions))
+	}
+	for i, slot := range original.RosterPositions {
+		restoredSlot := restored.RosterPositions[i]
+		if restoredSlot.Slot != slot.Slot || restoredSlot.Count != slot.Count || describeSlot(restoredSlot) != describeSlot(slot) {
+			t.Errorf("Slot %s changed in round-trip: %+v -> %+v", slot.Slot, slot, restoredSlot)
+		}
+	}
+
+	custom, ok := restored.RosterPositions.Slot("WRTE")
+	if !ok || !custom.Accepts("TE") || custom.Accepts("RB") {
+		t.Errorf("Custom slot eligibility not preserved: %+v", custom)
+	}
+}
+
+func TestExpandedRosterSlots(t *testing.T) {
+	rules := DefaultRules()
+	baseSize := rules.TotalRosterSize()
+	baseStarters := rules.TotalStartingPlayers()
+
+	// SUPERFLEX and IDP slots are starters
+	for _, slot := range []string{"SUPERFLEX", "DL", "LB", "DB"} {
+		if err := rules.SetPositionCount(slot, 1); err != nil {
+			t.Fatalf("Error adding %s slot: %v", slot, err)
+		}
+	}
+	if rules.TotalStartingPlayers() != baseStarters+4 {
+		t.Errorf("Expected %d starters, got %d", baseStarters+4, rules.TotalStartingPlayers())
+	}
+
+	// IR slots don't count toward roster size
+	rules.SetPositionCount("IR", 3)
+	if rules.TotalRosterSize() != baseSize+4 {
+		t.Errorf("Expected roster size of %d, got %d", baseSize+4, rules.TotalRosterSize())
+	}
+	if rules.TotalStartingPlayers() != baseStarters+4 {
+		t.Errorf("Expected IR slots not to count as starters")
+	}
+
+	// A SUPERFLEX slot satisfies the quarterback requirement
+	rules.SetPositionCount("QB", 0)
+	if err := rules.ValidateRules(); err != nil {
+		t.Errorf("Expected SUPERFLEX to satisfy the QB requirement, got error: %v", err)
+	}
+	rules.SetPositionCount("SUPERFLEX", 0)
+	if err := rules.ValidateRules(); err == nil {
+		t.Errorf("Expected error with no QB-eligible starting slot")
+	}
+
+	// Eligibility lookups
+	slots := rules.RosterPositions.EligibleSlots("OLB")
+	if len(slots) != 3 || slots[0].Slot != "LB" || slots[1].Slot != "BN" || slots[2].Slot != "IR" {
+		t.Errorf("Expected OLB to be eligible for LB, BN and IR, got %+v", slots)
+	}
+
+	if err := rules.SetPositionCount("IR", -1); err == nil {
+		t.Errorf("Expected error for negative slot count")
+	}
+	if err := rules.SetRosterSlot(RosterSlot{Slot: "TAXI", Count: 1, Bench: true, Reserve: true}); err == nil {
+		t.Errorf("Expected error for slot that is both bench and reserve")
+	}
+}
+
+func TestRosterAssign(t *testing.T) {
+	rules := DefaultRules()
+	rules.SetPositionCount("IR", 1)
+
+	players := []Player{
+		{ID: "rb1", Position: "RB"},
+		{ID: "rb2", Position: "RB"},
+		{ID: "rb3", Position: "RB"},
+		{ID: "rb4", Position: "RB"},
+		{ID: "wr1", Position: "WR"},
+		{ID: "qb1", Position: "QB"},
+		{ID: "qb2", Position: "QB"},
+	}
+	spots, overflow := rules.RosterPositions.Assign(players, nil)
+
+	if len(spots) != rules.TotalRosterSize()+1 {
+		t.Fatalf("Expected %d spots with IR, got %d", rules.TotalRosterSize()+1, len(spots))
+	}
+	if len(overflow) != 0 {
+		t.Errorf("Expected every player to fit, got overflow %+v", overflow)
+	}
+
+	// RBs fill their own slots, then FLEX, then the bench
+	filled := make(map[string][]string)
+	for _, spot := range spots {
+		if spot.Player != nil {
+			filled[spot.Slot.Slot] = append(filled[spot.Slot.Slot], spot.Player.ID)
+		}
+	}
+	if strings.Join(filled["RB"], ",") != "rb1,rb2" || strings.Join(filled["FLEX"], ",") != "rb3" {
+		t.Errorf("Unexpected RB and FLEX assignment: %v", filled)
+	}
+	if strings.Join(filled["BN"], ",") != "rb4,qb2" || strings.Join(filled["QB"], ",") != "qb1" {
+		t.Errorf("Unexpected bench assignment: %v", filled)
+	}
+	if len(filled["IR"]) != 0 {
+		t.Errorf("Expected IR to be left for injured players, got %v", filled["IR"])
+	}
+
+	// Players with no open spot overflow
+	rules.SetPositionCount("BN", 0)
+	_, overflow = rules.RosterPositions.Assign(players, nil)
+	if len(overflow) != 2 || overflow[0].ID != "rb4" || overflow[1].ID != "qb2" {
+		t.Errorf("Expected rb4 and qb2 to overflow, got %+v", overflow)
+	}
+
+	// A player on injured reserve goes to IR, which doesn't count toward
+	// roster size, so only qb2 is left without a spot
+	size := rules.TotalRosterSize()
+	spots, overflow = rules.RosterPositions.Assign(players, Injuries{"rb4": {Status: InjuryIR}})
+	if len(overflow) != 1 || overflow[0].ID != "qb2" {
+		t.Errorf("Expected only qb2 to overflow, got %+v", overflow)
+	}
+	for _, spot := range spots {
+		if spot.Slot.Reserve && (spot.Player == nil || spot.Player.ID != "rb4") {
+			t.Errorf("Expected rb4 on IR, got %+v", spot.Player)
+		}
+	}
+	if rules.TotalRosterSize() != size {
+		t.Errorf("Expected roster size %d with a player on IR, got %d", size, rules.TotalRosterSize())
+	}
+
+	if !rules.RosterPositions.CanStart("TE") || rules.RosterPositions.CanStart("LB") {
+		t.Errorf("Expected TE to be startable and LB not")
+	}
+}
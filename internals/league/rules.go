@@ -16,25 +16,40 @@ const (
 	PerUnit    RuleType = "per_unit"    // Points per unit (e.g., yards)
 	FixedUnit  RuleType = "fixed_unit"  // Fixed points per occurrence
 	RangeBased RuleType = "range_based" // Different points based on ranges
+	Bonus      RuleType = "bonus"       // Bonus points once a stat reaches a threshold
 )
 
 // ScoringRule represents a single scoring rule with its value
 type ScoringRule struct {
-	Type   RuleType           `json:"type"`             // The type of rule
-	Value  float64            `json:"value"`            // Point value (used for PerUnit and FixedUnit)
-	Ranges map[string]float64 `json:"ranges,omitempty"` // Range-based values (only for RangeBased)
+	Type      RuleType               `json:"type"`                // The type of rule
+	Value     float64                `json:"value"`               // Point value (used for PerUnit, FixedUnit and Bonus)
+	Ranges    map[string]float64     `json:"ranges,omitempty"`    // Range-based values (only for RangeBased)
+	Stat      string                 `json:"stat,omitempty"`      // Stat type a Bonus rule measures (e.g. "passingYards")
+	Threshold float64                `json:"threshold,omitempty"` // Minimum stat value (or play yards) that earns a Bonus
+	PerPlay   bool                   `json:"per_play,omitempty"`  // Bonus is awarded per qualifying play instead of per game
+	Positions map[string]ScoringRule `json:"positions,omitempty"` // Overrides keyed by NFL position (e.g. "TE")
 }
 
-// PositionRoster defines how many of each position can be on a roster
-type PositionRoster struct {
-	QB   int `json:"qb"`   // Quarterbacks
-	RB   int `json:"rb"`   // Running Backs
-	WR   int `json:"wr"`   // Wide Receivers
-	TE   int `json:"te"`   // Tight Ends
-	FLEX int `json:"flex"` // FLEX (RB/WR/TE)
-	K    int `json:"k"`    // Kickers
-	DST  int `json:"dst"`  // Defense/Special Teams
-	BN   int `json:"bn"`   // Bench spots
+// NewBonusRule returns a rule awarding points once per game when a player's
+// total for a stat reaches the threshold (e.g. 3 points for 300+ passing yards)
+func NewBonusRule(stat string, threshold, points float64) ScoringRule {
+	return ScoringRule{Type: Bonus, Value: points, Stat: stat, Threshold: threshold}
+}
+
+// NewPlayBonusRule returns a rule awarding points for every play of a stat
+// that gains at least minYards (e.g. 2 points per 40+ yard rushing TD). A
+// minYards of 0 awards every play, as for 2-point conversions.
+func NewPlayBonusRule(stat string, minYards, points float64) ScoringRule {
+	return ScoringRule{Type: Bonus, Value: points, Stat: stat, Threshold: minYards, PerPlay: true}
+}
+
+// ForPosition returns the rule that applies to a player at the given NFL
+// position, falling back to the base rule when there is no override
+func (r ScoringRule) ForPosition(position string) ScoringRule {
+	if override, ok := r.Positions[strings.ToUpper(position)]; ok {
+		return override
+	}
+	return r
 }
 
 // LeagueRules contains all the configuration options for a fantasy league
@@ -42,11 +57,13 @@ type LeagueRules struct {
 	Name             string                            `json:"name"`
 	Description      string                            `json:"description"`
 	TeamCount        int                               `json:"team_count"`
-	PPR              bool                              `json:"ppr"` // Points Per Reception
+	PPR              bool                              `json:"ppr"`                 // Points Per Reception
+	PPRValue         float64                           `json:"ppr_value,omitempty"` // Points per reception PPR would award while it's off, if not 1
 	RosterPositions  PositionRoster                    `json:"roster_positions"`
 	ScoringRules     map[string]map[string]ScoringRule `json:"scoring_rules"` // Category -> StatType -> ScoringRule
 	PlayoffWeekStart int                               `json:"playoff_week_start"`
 	PlayoffTeams     int                               `json:"playoff_teams"`
+	Divisions        int                               `json:"divisions,omitempty"` // Number of divisions teams are split into (0 for none)
 }
 
 // DefaultRules returns the standard fantasy football scoring rules
@@ -59,14 +76,14 @@ func DefaultRules() *LeagueRules {
 		PlayoffWeekStart: 15,
 		PlayoffTeams:     4,
 		RosterPositions: PositionRoster{
-			QB:   1,
-			RB:   2,
-			WR:   2,
-			TE:   1,
-			FLEX: 1,
-			K:    1,
-			DST:  1,
-			BN:   6,
+			newSlot("QB", 1),
+			newSlot("RB", 2),
+			newSlot("WR", 2),
+			newSlot("TE", 1),
+			newSlot("FLEX", 1),
+			newSlot("K", 1),
+			newSlot("DST", 1),
+			newSlot("BN", 6),
 		},
 		ScoringRules: make(map[string]map[string]ScoringRule),
 	}
@@ -132,6 +149,7 @@ func DefaultRules() *LeagueRules {
 // EnablePPR turns on PPR (Points Per Reception) scoring
 func (l *LeagueRules) EnablePPR() {
 	l.PPR = true
+	l.PPRValue = 0
 
 	// Update the reception points
 	if recRules, ok := l.ScoringRules["receiving"]; ok {
@@ -142,13 +160,17 @@ func (l *LeagueRules) EnablePPR() {
 	}
 }
 
-// DisablePPR turns off PPR (Points Per Reception) scoring
+// DisablePPR turns off PPR (Points Per Reception) scoring, remembering a
+// reception value other than 1 in PPRValue
 func (l *LeagueRules) DisablePPR() {
 	l.PPR = false
 
 	// Update the reception points
 	if recRules, ok := l.ScoringRules["receiving"]; ok {
 		if rule, ok := recRules["receptions"]; ok {
+			if rule.Value != 0 && rule.Value != 1 {
+				l.PPRValue = rule.Value
+			}
 			rule.Value = 0.0
 			recRules["receptions"] = rule
 		}
@@ -158,6 +180,7 @@ func (l *LeagueRules) DisablePPR() {
 // HalfPPR sets up Half PPR (0.5 points per reception) scoring
 func (l *LeagueRules) HalfPPR() {
 	l.PPR = true
+	l.PPRValue = 0
 
 	// Update the reception points
 	if recRules, ok := l.ScoringRules["receiving"]; ok {
@@ -168,55 +191,71 @@ func (l *LeagueRules) HalfPPR() {
 	}
 }
 
-// SetPositionCount updates the roster configuration for a specific position
+// SetPositionCount updates the roster configuration for a specific slot,
+// adding a built-in slot (e.g. SUPERFLEX, DL, IR) if the league doesn't use it yet
 func (l *LeagueRules) SetPositionCount(position string, count int) error {
 	position = strings.ToUpper(position)
 
-	switch position {
-	case "QB":
-		l.RosterPositions.QB = count
-	case "RB":
-		l.RosterPositions.RB = count
-	case "WR":
-		l.RosterPositions.WR = count
-	case "TE":
-		l.RosterPositions.TE = count
-	case "FLEX":
-		l.RosterPositions.FLEX = count
-	case "K":
-		l.RosterPositions.K = count
-	case "DST":
-		l.RosterPositions.DST = count
-	case "BN":
-		l.RosterPositions.BN = count
-	default:
-		return fmt.Errorf("invalid position: %s", position)
+	if count < 0 {
+		return fmt.Errorf("invalid count for %s: %d", position, count)
+	}
+
+	slot, ok := l.RosterPositions.Slot(position)
+	if !ok {
+		slot, ok = SlotDefinition(position)
+		if !ok {
+			return fmt.Errorf("invalid position: %s", position)
+		}
+	}
+
+	slot.Count = count
+	l.RosterPositions.set(slot)
+	return nil
+}
+
+// SetRosterSlot adds or replaces a roster slot, allowing custom slots with
+// their own eligible positions
+func (l *LeagueRules) SetRosterSlot(slot RosterSlot) error {
+	slot.Slot = strings.ToUpper(strings.TrimSpace(slot.Slot))
+	if slot.Slot == "" {
+		return fmt.Errorf("roster slot must have a name")
+	}
+	if slot.Count < 0 {
+		return fmt.Errorf("invalid count for %s: %d", slot.Slot, slot.Count)
+	}
+	if slot.Bench && slot.Reserve {
+		return fmt.Errorf("roster slot %s cannot be both bench and reserve", slot.Slot)
+	}
+
+	for i := range slot.Positions {
+		slot.Positions[i] = strings.ToUpper(slot.Positions[i])
 	}
 
+	l.RosterPositions.set(slot)
 	return nil
 }
 
-// TotalRosterSize returns the total number of players on a roster
+// TotalRosterSize returns the total number of players on a roster.
+// Reserve (IR) slots don't count toward roster size.
 func (l *LeagueRules) TotalRosterSize() int {
-	return l.RosterPositions.QB +
-		l.RosterPositions.RB +
-		l.RosterPositions.WR +
-		l.RosterPositions.TE +
-		l.RosterPositions.FLEX +
-		l.RosterPositions.K +
-		l.RosterPositions.DST +
-		l.RosterPositions.BN
+	total := 0
+	for _, slot := range l.RosterPositions {
+		if !slot.Reserve {
+			total += slot.Count
+		}
+	}
+	return total
 }
 
 // TotalStartingPlayers returns the number of starting players
 func (l *LeagueRules) TotalStartingPlayers() int {
-	return l.RosterPositions.QB +
-		l.RosterPositions.RB +
-		l.RosterPositions.WR +
-		l.RosterPositions.TE +
-		l.RosterPositions.FLEX +
-		l.RosterPositions.K +
-		l.RosterPositions.DST
+	total := 0
+	for _, slot := range l.RosterPositions {
+		if slot.IsStarter() {
+			total += slot.Count
+		}
+	}
+	return total
 }
 
 // ValidateRules checks if the rules configuration is valid
@@ -231,13 +270,43 @@ func (l *LeagueRules) ValidateRules() error {
 		return fmt.Errorf("invalid playoff teams: %d (must be between 2-%d)", l.PlayoffTeams, l.TeamCount)
 	}
 
+	// Check divisions, which need at least two teams each
+	if l.Divisions < 0 || l.Divisions > l.TeamCount/2 {
+		return fmt.Errorf("invalid divisions: %d (must be between 0-%d)", l.Divisions, l.TeamCount/2)
+	}
+
 	// Check playoff week
 	if l.PlayoffWeekStart < 10 || l.PlayoffWeekStart > 17 {
 		return fmt.Errorf("invalid playoff start week: %d (must be between 10-17)", l.PlayoffWeekStart)
 	}
+	if last := l.LastWeek(l.TeamCount); last > nflWeeks {
+		return fmt.Errorf("invalid playoff start week: %d (a %d-team bracket would end in week %d, after the NFL season's week %d)", l.PlayoffWeekStart, l.PlayoffTeams, last, nflWeeks)
+	}
+
+	// Check roster slots
+	seenSlots := make(map[string]bool)
+	qbSlots := 0
+	for _, slot := range l.RosterPositions {
+		if slot.Slot == "" {
+			return fmt.Errorf("roster slot must have a name")
+		}
+		if seenSlots[slot.Slot] {
+			return fmt.Errorf("duplicate roster slot: %s", slot.Slot)
+		}
+		seenSlots[slot.Slot] = true
 
-	// Check roster positions
-	if l.RosterPositions.QB < 1 {
+		if slot.Count < 0 {
+			return fmt.Errorf("invalid count for %s: %d", slot.Slot, slot.Count)
+		}
+		if slot.Bench && slot.Reserve {
+			return fmt.Errorf("roster slot %s cannot be both bench and reserve", slot.Slot)
+		}
+		if slot.IsStarter() && slot.Accepts("QB") {
+			qbSlots += slot.Count
+		}
+	}
+
+	if qbSlots < 1 {
 		return fmt.Errorf("must have at least 1 QB roster spot")
 	}
 
@@ -250,35 +319,165 @@ func (l *LeagueRules) ValidateRules() error {
 		return fmt.Errorf("must have at least one bench spot")
 	}
 
+	// Check bonus rules, including position overrides
+	for category, categoryRules := range l.ScoringRules {
+		for statType, rule := range categoryRules {
+			if err := validateBonusRule(rule); err != nil {
+				return fmt.Errorf("invalid bonus rule %s/%s: %w", category, statType, err)
+			}
+			for position, override := range rule.Positions {
+				if err := validateBonusRule(override); err != nil {
+					return fmt.Errorf("invalid bonus rule %s/%s (%s): %w", category, statType, position, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateBonusRule checks that a Bonus rule names its stat and has a usable threshold
+func validateBonusRule(rule ScoringRule) error {
+	if rule.Type != Bonus {
+		return nil
+	}
+	if rule.Stat == "" {
+		return fmt.Errorf("missing stat")
+	}
+	if rule.Threshold < 0 {
+		return fmt.Errorf("negative threshold: %v", rule.Threshold)
+	}
+	// Every game would earn a per-game bonus with no threshold
+	if !rule.PerPlay && rule.Threshold == 0 {
+		return fmt.Errorf("per-game bonus needs a threshold")
+	}
 	return nil
 }
 
 // SetScoringRule sets or updates a specific scoring rule
 func (l *LeagueRules) SetScoringRule(category, statType string, value any) error {
+	rule, err := ruleFromValue(value)
+	if err != nil {
+		return err
+	}
+
+	// Bonus rules measure their own stat type unless told otherwise
+	if rule.Type == Bonus && rule.Stat == "" {
+		rule.Stat = statType
+	}
+
 	// Make sure the category exists
 	if _, ok := l.ScoringRules[category]; !ok {
 		l.ScoringRules[category] = make(map[string]ScoringRule)
 	}
 
-	// Handle different value types
+	l.ScoringRules[category][statType] = rule
+	return nil
+}
+
+// ruleFromValue converts the value types accepted by SetScoringRule into a rule
+func ruleFromValue(value any) (ScoringRule, error) {
 	switch v := value.(type) {
 	case ScoringRule:
-		l.ScoringRules[category][statType] = v
+		return v, nil
 	case float64:
 		// If just a float is provided, treat it as a fixed-unit point value
-		l.ScoringRules[category][statType] = ScoringRule{Type: FixedUnit, Value: v}
+		return ScoringRule{Type: FixedUnit, Value: v}, nil
 	case map[string]float64:
 		// If a map is provided, treat it as a range-based rule
-		l.ScoringRules[category][statType] = ScoringRule{Type: RangeBased, Ranges: v}
+		return ScoringRule{Type: RangeBased, Ranges: v}, nil
 	default:
-		return fmt.Errorf("invalid value type for scoring rule: %T", value)
+		return ScoringRule{}, fmt.Errorf("invalid value type for scoring rule: %T", value)
+	}
+}
+
+// SetPositionOverride sets the scoring rule used for players at one NFL
+// position instead of the base rule (e.g. 1.5 points per TE reception).
+// The value accepts the same types as SetScoringRule.
+func (l *LeagueRules) SetPositionOverride(category, statType, position string, value any) error {
+	categoryRules, ok := l.ScoringRules[category]
+	if !ok {
+		return fmt.Errorf("category not found: %s", category)
+	}
+
+	rule, ok := categoryRules[statType]
+	if !ok {
+		return fmt.Errorf("stat type not found: %s", statType)
+	}
+
+	position = strings.ToUpper(strings.TrimSpace(position))
+	if position == "" {
+		return fmt.Errorf("position override must have a position")
+	}
+
+	override, err := ruleFromValue(value)
+	if err != nil {
+		return err
+	}
+	override.Positions = nil
+
+	// Copy the overrides so cloned rules don't share the map
+	positions := make(map[string]ScoringRule, len(rule.Positions)+1)
+	for pos, existing := range rule.Positions {
+		positions[pos] = existing
 	}
+	positions[position] = override
 
+	rule.Positions = positions
+	categoryRules[statType] = rule
 	return nil
 }
 
+// RemovePositionOverride removes a position override so the base rule applies again
+func (l *LeagueRules) RemovePositionOverride(category, statType, position string) error {
+	categoryRules, ok := l.ScoringRules[category]
+	if !ok {
+		return fmt.Errorf("category not found: %s", category)
+	}
+
+	rule, ok := categoryRules[statType]
+	if !ok {
+		return fmt.Errorf("stat type not found: %s", statType)
+	}
+
+	position = strings.ToUpper(strings.TrimSpace(position))
+	if _, ok := rule.Positions[position]; !ok {
+		return fmt.Errorf("no %s override for %s/%s", position, category, statType)
+	}
+
+	positions := make(map[string]ScoringRule, len(rule.Positions))
+	for pos, existing := range rule.Positions {
+		if pos != position {
+			positions[pos] = existing
+		}
+	}
+	if len(positions) == 0 {
+		positions = nil
+	}
+
+	rule.Positions = positions
+	categoryRules[statType] = rule
+	return nil
+}
+
+// RuleFor returns the scoring rule for a stat as it applies to a player at
+// the given NFL position. An empty position returns the base rule.
+func (l *LeagueRules) RuleFor(category, statType, position string) (ScoringRule, bool) {
+	rule, ok := l.ScoringRules[category][statType]
+	if !ok {
+		return ScoringRule{}, false
+	}
+	return rule.ForPosition(position), true
+}
+
 // GetScoringValue calculates the points for a specific stat
 func (l *LeagueRules) GetScoringValue(category, statType string, statValue float64) (float64, error) {
+	return l.GetScoringValueForPosition(category, statType, "", statValue)
+}
+
+// GetScoringValueForPosition calculates the points for a specific stat,
+// applying any override for the player's NFL position
+func (l *LeagueRules) GetScoringValueForPosition(category, statType, position string, statValue float64) (float64, error) {
 	categoryRules, ok := l.ScoringRules[category]
 	if !ok {
 		return 0, fmt.Errorf("category not found: %s", category)
@@ -288,6 +487,7 @@ func (l *LeagueRules) GetScoringValue(category, statType string, statValue float
 	if !ok {
 		return 0, fmt.Errorf("stat type not found: %s", statType)
 	}
+	rule = rule.ForPosition(position)
 
 	// Calculate points based on rule type
 	switch rule.Type {
@@ -311,6 +511,13 @@ func (l *LeagueRules) GetScoringValue(category, statType string, statValue float
 		}
 		return 0, fmt.Errorf("range-based scoring not implemented for: %s", statType)
 
+	case Bonus:
+		// statValue is the measured stat total, or the yards gained on a play
+		if statValue >= rule.Threshold {
+			return rule.Value, nil
+		}
+		return 0, nil
+
 	default:
 		return 0, fmt.Errorf("unsupported rule type: %s", rule.Type)
 	}
@@ -346,25 +553,17 @@ func (l *LeagueRules) PrintScoringRules() string {
 
 		for _, statType := range statTypes {
 			rule := rules[statType]
+			writeRule(&output, "  ", caser.String(statType), rule)
 
-			switch rule.Type {
-			case PerUnit:
-				output.WriteString(fmt.Sprintf("  %s: %.2f points per unit\n", caser.String(statType), rule.Value))
-			case FixedUnit:
-				output.WriteString(fmt.Sprintf("  %s: %.2f points\n", caser.String(statType), rule.Value))
-			case RangeBased:
-				output.WriteString(fmt.Sprintf("  %s:\n", caser.String(statType)))
-
-				// Sort ranges for consistent display
-				ranges := make([]string, 0, len(rule.Ranges))
-				for rng := range rule.Ranges {
-					ranges = append(ranges, rng)
-				}
-				sort.Strings(ranges)
+			// Position overrides are listed beneath the base rule
+			positions := make([]string, 0, len(rule.Positions))
+			for position := range rule.Positions {
+				positions = append(positions, position)
+			}
+			sort.Strings(positions)
 
-				for _, rng := range ranges {
-					output.WriteString(fmt.Sprintf("    %s yards: %.2f points\n", rng, rule.Ranges[rng]))
-				}
+			for _, position := range positions {
+				writeRule(&output, "    ", position, rule.Positions[position])
 			}
 		}
 		output.WriteString("\n")
@@ -373,6 +572,35 @@ func (l *LeagueRules) PrintScoringRules() string {
 	return output.String()
 }
 
+// writeRule writes a single scoring rule line (and its ranges) at the given indent
+func writeRule(output *strings.Builder, indent, label string, rule ScoringRule) {
+	switch rule.Type {
+	case PerUnit:
+		output.WriteString(fmt.Sprintf("%s%s: %.2f points per unit\n", indent, label, rule.Value))
+	case FixedUnit:
+		output.WriteString(fmt.Sprintf("%s%s: %.2f points\n", indent, label, rule.Value))
+	case RangeBased:
+		output.WriteString(fmt.Sprintf("%s%s:\n", indent, label))
+
+		// Sort ranges for consistent display
+		ranges := make([]string, 0, len(rule.Ranges))
+		for rng := range rule.Ranges {
+			ranges = append(ranges, rng)
+		}
+		sort.Strings(ranges)
+
+		for _, rng := range ranges {
+			output.WriteString(fmt.Sprintf("%s  %s yards: %.2f points\n", indent, rng, rule.Ranges[rng]))
+		}
+	case Bonus:
+		if rule.PerPlay {
+			output.WriteString(fmt.Sprintf("%s%s: %.2f points per %s play of %v+ yards\n", indent, label, rule.Value, rule.Stat, rule.Threshold))
+		} else {
+			output.WriteString(fmt.Sprintf("%s%s: %.2f points for %v+ %s in a game\n", indent, label, rule.Value, rule.Threshold, rule.Stat))
+		}
+	}
+}
+
 // GetScoringCategories returns all available scoring categories
 func (l *LeagueRules) GetScoringCategories() []string {
 	categories := make([]string, 0, len(l.ScoringRules))
@@ -0,0 +1,514 @@
+package league
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// csvHeader is the header row used by the human-readable scoring rules CSV
+var csvHeader = []string{"Category", "Stat Type", "Points"}
+
+// pprQualifier marks a reception rule that only applies when PPR is enabled
+const pprQualifier = "(if PPR enabled)"
+
+// csvCategoryOrder lists categories in the order they are written to CSV.
+// Categories not listed here are written afterwards in alphabetical order.
+var csvCategoryOrder = []string{
+	"passing",
+	"rushing",
+	"receiving",
+	"fumbles",
+	"defensive",
+	"interceptions",
+	"kickReturns",
+	"puntReturns",
+	"kicking",
+}
+
+var (
+	// perUnitPattern matches expressions like "0.04 per yard", "1 per 25 yards" or "-2 per INT"
+	perUnitPattern = regexp.MustCompile(`^(-?\d+(?:\.\d+)?)\s+per\s+(.+)$`)
+
+	// positionQualifierPattern matches a trailing position override qualifier like "(TE only)"
+	positionQualifierPattern = regexp.MustCompile(`\s*\(\s*([A-Za-z]+)\s+only\s*\)$`)
+
+	// bonusPattern matches a per-game bonus like "3 bonus (300+ passingYards)"
+	bonusPattern = regexp.MustCompile(`^(-?\d+(?:\.\d+)?)\s+bonus\s*\(\s*(\d+(?:\.\d+)?)\s*\+\s*([A-Za-z/-]+)\s*\)$`)
+
+	// playBonusPattern matches a play bonus like "2 bonus per play (40+ yd rushingTouchdowns)"
+	playBonusPattern = regexp.MustCompile(`^(-?\d+(?:\.\d+)?)\s+bonus\s+per\s+play\s*\(\s*(\d+(?:\.\d+)?)\s*\+\s*(?:yds?|yards?)\s+([A-Za-z/-]+)\s*\)$`)
+
+	// rangePattern matches a single range term like "3 (0-39 yd)" or "5 (50+ yd)"
+	rangePattern = regexp.MustCompile(`^(-?\d+(?:\.\d+)?)\s*\(\s*(\d+\s*-\s*\d+|\d+\s*\+)\s*(?:yds?|yards?)?\s*\)$`)
+)
+
+// csvOverride is a position override row waiting for its base rule
+type csvOverride struct {
+	line     int
+	category string
+	statType string
+	position string
+	rule     ScoringRule
+}
+
+// FromCSV builds league rules from the human-readable scoring CSV format
+// (see examples/Core_Fantasy_Scoring_Rules.csv). Non-scoring settings are
+// taken from DefaultRules. Rows whose points end in "(TE only)" and the like
+// are position overrides of the row with the same category and stat type.
+func FromCSV(csvStr string) (*LeagueRules, error) {
+	reader := csv.NewReader(strings.NewReader(csvStr))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rules := DefaultRules()
+	rules.ScoringRules = make(map[string]map[string]ScoringRule)
+	rules.PPR = false
+
+	var overrides []csvOverride
+	seenOverrides := make(map[string]bool)
+
+	headerSeen := false
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading rules CSV: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if !headerSeen {
+			headerSeen = true
+			if isCSVHeader(record) {
+				continue
+			}
+		}
+
+		if len(record) != len(csvHeader) {
+			return nil, fmt.Errorf("line %d: expected %d columns, got %d", line, len(csvHeader), len(record))
+		}
+
+		category := strings.TrimSpace(record[0])
+		statType := strings.TrimSpace(record[1])
+		points := strings.TrimSpace(record[2])
+
+		if category == "" {
+			return nil, fmt.Errorf("line %d: missing category", line)
+		}
+		if statType == "" {
+			return nil, fmt.Errorf("line %d: missing stat type", line)
+		}
+
+		// Position overrides are applied once every base rule has been read
+		if match := positionQualifierPattern.FindStringSubmatch(points); match != nil {
+			position := strings.ToUpper(match[1])
+			key := category + "/" + statType + "/" + position
+			if seenOverrides[key] {
+				return nil, fmt.Errorf("line %d: duplicate %s override for %s/%s", line, position, category, statType)
+			}
+			seenOverrides[key] = true
+
+			rule, pprOnly, err := parsePointsExpression(strings.TrimSpace(points[:len(points)-len(match[0])]))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s/%s (%s): %w", line, category, statType, position, err)
+			}
+			if pprOnly {
+				return nil, fmt.Errorf("line %d: %s/%s: %q cannot be used with a position override", line, category, statType, pprQualifier)
+			}
+
+			overrides = append(overrides, csvOverride{line: line, category: category, statType: statType, position: position, rule: rule})
+			continue
+		}
+
+		if _, ok := rules.ScoringRules[category][statType]; ok {
+			return nil, fmt.Errorf("line %d: duplicate rule for %s/%s", line, category, statType)
+		}
+
+		rule, pprOnly, err := parsePointsExpression(points)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s/%s: %w", line, category, statType, err)
+		}
+
+		// Reception rules decide whether the league uses PPR. A rule marked
+		// "(if PPR enabled)" describes the PPR value of a non-PPR league.
+		if statType == "receptions" {
+			if pprOnly {
+				if rule.Value != 1 {
+					rules.PPRValue = rule.Value
+				}
+				rule.Value = 0
+			} else {
+				rules.PPR = rule.Value != 0
+			}
+		} else if pprOnly {
+			return nil, fmt.Errorf("line %d: %s/%s: %q is only valid for receptions", line, category, statType, pprQualifier)
+		}
+
+		if err := rules.SetScoringRule(category, statType, rule); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+
+	if len(rules.ScoringRules) == 0 {
+		return nil, fmt.Errorf("rules CSV contains no scoring rules")
+	}
+
+	for _, override := range overrides {
+		if err := rules.SetPositionOverride(override.category, override.statType, override.position, override.rule); err != nil {
+			return nil, fmt.Errorf("line %d: %s override: %w", override.line, override.position, err)
+		}
+	}
+
+	return rules, nil
+}
+
+// ToCSV returns the scoring rules in the human-readable CSV format accepted by FromCSV
+func (l *LeagueRules) ToCSV() (string, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return "", fmt.Errorf("error writing rules CSV header: %w", err)
+	}
+
+	for _, category := range csvCategories(l.ScoringRules) {
+		categoryRules := l.ScoringRules[category]
+
+		statTypes := make([]string, 0, len(categoryRules))
+		for statType := range categoryRules {
+			statTypes = append(statTypes, statType)
+		}
+		sort.Strings(statTypes)
+
+		for _, statType := range statTypes {
+			rule := categoryRules[statType]
+			points, err := l.formatPointsExpression(statType, rule)
+			if err != nil {
+				return "", fmt.Errorf("error formatting %s/%s: %w", category, statType, err)
+			}
+
+			if err := writer.Write([]string{category, statType, points}); err != nil {
+				return "", fmt.Errorf("error writing rules CSV row: %w", err)
+			}
+
+			// Position overrides follow their base rule
+			positions := make([]string, 0, len(rule.Positions))
+			for position := range rule.Positions {
+				positions = append(positions, position)
+			}
+			sort.Strings(positions)
+
+			for _, position := range positions {
+				override := rule.Positions[position]
+				points, err := formatRuleExpression(statType, override)
+				if err != nil {
+					return "", fmt.Errorf("error formatting %s/%s (%s): %w", category, statType, position, err)
+				}
+
+				row := []string{category, statType, fmt.Sprintf("%s (%s only)", points, position)}
+				if err := writer.Write(row); err != nil {
+					return "", fmt.Errorf("error writing rules CSV row: %w", err)
+				}
+			}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", fmt.Errorf("error writing rules CSV: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// isCSVHeader reports whether a record is the rules CSV header row
+func isCSVHeader(record []string) bool {
+	if len(record) != len(csvHeader) {
+		return false
+	}
+	for i, field := range record {
+		if !strings.EqualFold(strings.TrimSpace(field), csvHeader[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// parsePointsExpression parses the Points column into a scoring rule. The
+// returned flag reports whether the expression carried the PPR qualifier.
+func parsePointsExpression(expr string) (ScoringRule, bool, error) {
+	if expr == "" {
+		return ScoringRule{}, false, fmt.Errorf("missing points value")
+	}
+
+	pprOnly := false
+	if strings.HasSuffix(expr, pprQualifier) {
+		pprOnly = true
+		expr = strings.TrimSpace(strings.TrimSuffix(expr, pprQualifier))
+	}
+
+	// A plain number is a fixed value per occurrence
+	if value, err := strconv.ParseFloat(expr, 64); err == nil {
+		return ScoringRule{Type: FixedUnit, Value: value}, pprOnly, nil
+	}
+
+	// "<value> per <unit>": yards score per yard, everything else per occurrence
+	if match := perUnitPattern.FindStringSubmatch(expr); match != nil {
+		value, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return ScoringRule{}, false, fmt.Errorf("invalid point value %q", match[1])
+		}
+
+		count, yardage, err := parseUnit(match[2])
+		if err != nil {
+			return ScoringRule{}, false, err
+		}
+		if yardage {
+			return ScoringRule{Type: PerUnit, Value: value / count}, pprOnly, nil
+		}
+		return ScoringRule{Type: FixedUnit, Value: value}, pprOnly, nil
+	}
+
+	// "<value> bonus (<threshold>+ <stat>)" and "<value> bonus per play (<yards>+ yd <stat>)"
+	if match := bonusPattern.FindStringSubmatch(expr); match != nil {
+		return parseBonusExpression(match, false, pprOnly)
+	}
+	if match := playBonusPattern.FindStringSubmatch(expr); match != nil {
+		return parseBonusExpression(match, true, pprOnly)
+	}
+
+	// "<value> (<range> yd), ..." for range-based rules
+	if strings.Contains(expr, "(") {
+		if pprOnly {
+			return ScoringRule{}, false, fmt.Errorf("%q cannot be used with range-based points", pprQualifier)
+		}
+
+		ranges := make(map[string]float64)
+		for _, term := range splitRangeTerms(expr) {
+			match := rangePattern.FindStringSubmatch(term)
+			if match == nil {
+				return ScoringRule{}, false, fmt.Errorf("invalid range expression %q", term)
+			}
+
+			value, err := strconv.ParseFloat(match[1], 64)
+			if err != nil {
+				return ScoringRule{}, false, fmt.Errorf("invalid point value %q", match[1])
+			}
+
+			rangeKey := strings.Join(strings.Fields(match[2]), "")
+			if _, ok := ranges[rangeKey]; ok {
+				return ScoringRule{}, false, fmt.Errorf("duplicate range %q", rangeKey)
+			}
+			ranges[rangeKey] = value
+		}
+		return ScoringRule{Type: RangeBased, Ranges: ranges}, false, nil
+	}
+
+	return ScoringRule{}, false, fmt.Errorf("unrecognized points expression %q", expr)
+}
+
+// parseBonusExpression builds a Bonus rule from a bonusPattern or playBonusPattern match
+func parseBonusExpression(match []string, perPlay, pprOnly bool) (ScoringRule, bool, error) {
+	if pprOnly {
+		return ScoringRule{}, false, fmt.Errorf("%q cannot be used with bonus points", pprQualifier)
+	}
+
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return ScoringRule{}, false, fmt.Errorf("invalid point value %q", match[1])
+	}
+	threshold, err := strconv.ParseFloat(match[2], 64)
+	if err != nil {
+		return ScoringRule{}, false, fmt.Errorf("invalid bonus threshold %q", match[2])
+	}
+
+	if perPlay {
+		return NewPlayBonusRule(match[3], threshold, value), false, nil
+	}
+	return NewBonusRule(match[3], threshold, value), false, nil
+}
+
+// splitRangeTerms splits "3 (0-39 yd), 4 (40-49 yd)" into its individual terms
+func splitRangeTerms(expr string) []string {
+	var terms []string
+	depth := 0
+	start := 0
+	for i, c := range expr {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, strings.TrimSpace(expr[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(terms, strings.TrimSpace(expr[start:]))
+}
+
+// parseUnit parses a "per <unit>" unit like "yard", "25 yards" or "passing
+// yard" into how many units the points are for and whether they're yards.
+// Only yards can be counted in more than ones.
+func parseUnit(unit string) (float64, bool, error) {
+	words := strings.Fields(strings.ToLower(unit))
+	count := 1.0
+	if n, err := strconv.ParseFloat(words[0], 64); err == nil {
+		if n <= 0 {
+			return 0, false, fmt.Errorf("invalid unit count %q", words[0])
+		}
+		count, words = n, words[1:]
+	}
+	if len(words) == 0 {
+		return 0, false, fmt.Errorf("missing unit in %q", unit)
+	}
+
+	yardage := false
+	for _, word := range words {
+		if isYardageUnit(word) {
+			yardage = true
+		}
+	}
+	if count != 1 && !yardage {
+		return 0, false, fmt.Errorf("unit counts are only supported for yards, got %q", unit)
+	}
+	return count, yardage, nil
+}
+
+// isYardageUnit reports whether a unit word is a yardage unit
+func isYardageUnit(unit string) bool {
+	switch unit {
+	case "yard", "yards", "yd", "yds":
+		return true
+	}
+	return false
+}
+
+// formatPointsExpression renders a scoring rule as a Points column value
+func (l *LeagueRules) formatPointsExpression(statType string, rule ScoringRule) (string, error) {
+	// Non-PPR leagues describe the value PPR would award
+	if rule.Type == FixedUnit && statType == "receptions" && !l.PPR && rule.Value == 0 {
+		value := 1.0
+		if l.PPRValue != 0 {
+			value = l.PPRValue
+		}
+		return fmt.Sprintf("%s per %s %s", formatPoints(value), unitLabel(statType), pprQualifier), nil
+	}
+	return formatRuleExpression(statType, rule)
+}
+
+// formatRuleExpression renders a scoring rule's points without league-level qualifiers
+func formatRuleExpression(statType string, rule ScoringRule) (string, error) {
+	switch rule.Type {
+	case PerUnit:
+		return fmt.Sprintf("%s per yard", formatPoints(rule.Value)), nil
+
+	case FixedUnit:
+		return fmt.Sprintf("%s per %s", formatPoints(rule.Value), unitLabel(statType)), nil
+
+	case RangeBased:
+		if len(rule.Ranges) == 0 {
+			return "", fmt.Errorf("range-based rule has no ranges")
+		}
+
+		rangeKeys := make([]string, 0, len(rule.Ranges))
+		for rangeKey := range rule.Ranges {
+			rangeKeys = append(rangeKeys, rangeKey)
+		}
+		sort.Slice(rangeKeys, func(i, j int) bool {
+			return rangeLowerBound(rangeKeys[i]) < rangeLowerBound(rangeKeys[j])
+		})
+
+		terms := make([]string, 0, len(rangeKeys))
+		for _, rangeKey := range rangeKeys {
+			terms = append(terms, fmt.Sprintf("%s (%s yd)", formatPoints(rule.Ranges[rangeKey]), rangeKey))
+		}
+		return strings.Join(terms, ", "), nil
+
+	case Bonus:
+		if rule.Stat == "" {
+			return "", fmt.Errorf("bonus rule has no stat")
+		}
+		if rule.PerPlay {
+			return fmt.Sprintf("%s bonus per play (%s+ yd %s)", formatPoints(rule.Value), formatPoints(rule.Threshold), rule.Stat), nil
+		}
+		return fmt.Sprintf("%s bonus (%s+ %s)", formatPoints(rule.Value), formatPoints(rule.Threshold), rule.Stat), nil
+
+	default:
+		return "", fmt.Errorf("unsupported rule type: %s", rule.Type)
+	}
+}
+
+// formatPoints formats a point value without trailing zeros
+func formatPoints(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// rangeLowerBound returns the lower bound of a range key like "40-49" or "50+"
+func rangeLowerBound(rangeKey string) int {
+	end := strings.IndexAny(rangeKey, "-+")
+	if end < 0 {
+		end = len(rangeKey)
+	}
+	bound, err := strconv.Atoi(rangeKey[:end])
+	if err != nil {
+		return 0
+	}
+	return bound
+}
+
+// unitLabel returns the human-readable unit used for a fixed-value stat type
+func unitLabel(statType string) string {
+	switch statType {
+	case "interceptions":
+		return "INT"
+	case "receptions":
+		return "reception"
+	case "fumblesLost":
+		return "fumble lost"
+	case "sacks":
+		return "sack"
+	case "passesDefended":
+		return "pass defended"
+	case "extraPointsMade", "extraPointsMade/extraPointAttempts":
+		return "XP made"
+	}
+
+	if strings.HasSuffix(statType, "Touchdowns") {
+		return "TD"
+	}
+	return "occurrence"
+}
+
+// csvCategories returns the categories of a rule set in CSV output order
+func csvCategories(scoringRules map[string]map[string]ScoringRule) []string {
+	categories := make([]string, 0, len(scoringRules))
+	known := make(map[string]bool, len(csvCategoryOrder))
+	for _, category := range csvCategoryOrder {
+		known[category] = true
+		if _, ok := scoringRules[category]; ok {
+			categories = append(categories, category)
+		}
+	}
+
+	var others []string
+	for category := range scoringRules {
+		if !known[category] {
+			others = append(others, category)
+		}
+	}
+	sort.Strings(others)
+
+	return append(categories, others...)
+}
@@ -0,0 +1,294 @@
+package league
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestFromCSVExampleFile(t *testing.T) {
+	content, err := os.ReadFile("../../examples/Core_Fantasy_Scoring_Rules.csv")
+	if err != nil {
+		t.Fatalf("Error reading example CSV: %v", err)
+	}
+
+	rules, err := FromCSV(string(content))
+	if err != nil {
+		t.Fatalf("Error parsing example CSV: %v", err)
+	}
+
+	// Per-unit yardage rule
+	rule := rules.ScoringRules["passing"]["passingYards"]
+	if rule.Type != PerUnit || rule.Value != 0.04 {
+		t.Errorf("Expected passing yards to be 0.04 per unit, got %v %.2f", rule.Type, rule.Value)
+	}
+
+	// Fixed rules
+	rule = rules.ScoringRules["passing"]["interceptions"]
+	if rule.Type != FixedUnit || rule.Value != -2 {
+		t.Errorf("Expected interceptions thrown to be -2 fixed, got %v %.2f", rule.Type, rule.Value)
+	}
+
+	rule = rules.ScoringRules["interceptions"]["interceptions"]
+	if rule.Type != FixedUnit || rule.Value != 2 {
+		t.Errorf("Expected defensive interceptions to be 2 fixed, got %v %.2f", rule.Type, rule.Value)
+	}
+
+	// The reception rule is PPR-only, so the league is not PPR
+	if rules.PPR {
+		t.Errorf("Expected PPR to be false for a PPR-conditional reception rule")
+	}
+	if rules.ScoringRules["receiving"]["receptions"].Value != 0 {
+		t.Errorf("Expected receptions to be worth 0 points when PPR is disabled")
+	}
+
+	// Range rule
+	rule = rules.ScoringRules["kicking"]["fieldGoalsMade/fieldGoalAttempts"]
+	if rule.Type != RangeBased {
+		t.Fatalf("Expected field goals to be range-based, got %v", rule.Type)
+	}
+	expectedRanges := map[string]float64{"0-39": 3, "40-49": 4, "50+": 5}
+	for rangeKey, points := range expectedRanges {
+		if rule.Ranges[rangeKey] != points {
+			t.Errorf("Expected range %s to be worth %.0f points, got %.2f", rangeKey, points, rule.Ranges[rangeKey])
+		}
+	}
+
+	// Non-scoring settings come from the defaults
+	if err := rules.ValidateRules(); err != nil {
+		t.Errorf("Expected rules imported from CSV to be valid, got error: %v", err)
+	}
+}
+
+func TestFromCSVEnablesPPR(t *testing.T) {
+	csvStr := "Category,Stat Type,Points\nreceiving,receptions,0.5 per reception\n"
+
+	rules, err := FromCSV(csvStr)
+	if err != nil {
+		t.Fatalf("Error parsing CSV: %v", err)
+	}
+
+	if !rules.PPR {
+		t.Errorf("Expected PPR to be enabled by a reception rule")
+	}
+	if rules.ScoringRules["receiving"]["receptions"].Value != 0.5 {
+		t.Errorf("Expected receptions to be worth 0.5 points")
+	}
+}
+
+func TestFromCSVErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		errLine string
+	}{
+		{
+			name:    "unrecognized expression",
+			csv:     "Category,Stat Type,Points\npassing,passingYards,0.04 per yard\nrushing,rushingYards,lots\n",
+			errLine: "line 3:",
+		},
+		{
+			name:    "bad range",
+			csv:     "Category,Stat Type,Points\nkicking,fieldGoalsMade,\"3 (0-39 yd), four (40+ yd)\"\n",
+			errLine: "line 2:",
+		},
+		{
+			name:    "duplicate rule",
+			csv:     "Category,Stat Type,Points\npassing,passingYards,0.04 per yard\npassing,passingYards,0.05 per yard\n",
+			errLine: "line 3:",
+		},
+		{
+			name:    "wrong column count",
+			csv:     "Category,Stat Type,Points\npassing,passingYards\n",
+			errLine: "line 2:",
+		},
+		{
+			name:    "unit count on a non-yardage unit",
+			csv:     "Category,Stat Type,Points\nreceiving,receptions,1 per 2 receptions\n",
+			errLine: "line 2:",
+		},
+		{
+			name:    "PPR qualifier on non-reception rule",
+			csv:     "Category,Stat Type,Points\nrushing,rushingTouchdowns,6 per TD (if PPR enabled)\n",
+			errLine: "line 2:",
+		},
+	}
+
+	for _, test := range tests {
+		_, err := FromCSV(test.csv)
+		if err == nil {
+			t.Errorf("%s: expected error, got nil", test.name)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.errLine) {
+			t.Errorf("%s: expected error to mention %q, got %v", test.name, test.errLine, err)
+		}
+	}
+
+	// Empty input has no rules
+	if _, err := FromCSV("Category,Stat Type,Points\n"); err == nil {
+		t.Errorf("Expected error for CSV without rules")
+	}
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	original := DefaultRules()
+	original.HalfPPR()
+	original.UpdatePointValue("passing", "passingTouchdowns", 6.0)
+	original.UpdateRangePointValue("kicking", "fieldGoalsMade", "50+", 6.0)
+
+	csvStr, err := original.ToCSV()
+	if err != nil {
+		t.Fatalf("Error converting to CSV: %v", err)
+	}
+
+	restored, err := FromCSV(csvStr)
+	if err != nil {
+		t.Fatalf("Error converting from CSV: %v\n%s", err, csvStr)
+	}
+
+	if restored.PPR != original.PPR {
+		t.Errorf("PPR setting not preserved in CSV round-trip")
+	}
+
+	for category, categoryRules := range original.ScoringRules {
+		for statType, rule := range categoryRules {
+			restoredRule, ok := restored.ScoringRules[category][statType]
+			if !ok {
+				t.Errorf("Rule %s/%s missing after CSV round-trip", category, statType)
+				continue
+			}
+			if restoredRule.Type != rule.Type || restoredRule.Value != rule.Value {
+				t.Errorf("Rule %s/%s changed in CSV round-trip: %+v -> %+v", category, statType, rule, restoredRule)
+			}
+			for rangeKey, points := range rule.Ranges {
+				if restoredRule.Ranges[rangeKey] != points {
+					t.Errorf("Range %s of %s/%s changed in CSV round-trip", rangeKey, category, statType)
+				}
+			}
+		}
+	}
+}
+
+func TestToCSVNonPPR(t *testing.T) {
+	csvStr, err := DefaultRules().ToCSV()
+	if err != nil {
+		t.Fatalf("Error converting to CSV: %v", err)
+	}
+
+	if !strings.Contains(csvStr, "receiving,receptions,1 per reception (if PPR enabled)") {
+		t.Errorf("Expected non-PPR reception rule to be written as PPR-conditional, got:\n%s", csvStr)
+	}
+	if !strings.Contains(csvStr, `kicking,fieldGoalsMade,"3 (0-39 yd), 4 (40-49 yd), 5 (50+ yd)"`) {
+		t.Errorf("Expected field goal ranges in ascending order, got:\n%s", csvStr)
+	}
+	if !strings.HasPrefix(csvStr, "Category,Stat Type,Points\npassing,") {
+		t.Errorf("Expected header followed by passing rules, got:\n%s", csvStr)
+	}
+}
+
+func TestCSVBonusRules(t *testing.T) {
+	original, _ := PresetRules("bonus")
+
+	csvStr, err := original.ToCSV()
+	if err != nil {
+		t.Fatalf("Error converting to CSV: %v", err)
+	}
+	if !strings.Contains(csvStr, "passing,passingYardsBonus,3 bonus (300+ passingYards)") {
+		t.Errorf("Expected passing yards bonus row, got:\n%s", csvStr)
+	}
+	if !strings.Contains(csvStr, "rushing,longRushingTouchdownBonus,2 bonus per play (40+ yd rushingTouchdowns)") {
+		t.Errorf("Expected long TD bonus row, got:\n%s", csvStr)
+	}
+
+	restored, err := FromCSV(csvStr)
+	if err != nil {
+		t.Fatalf("Error converting from CSV: %v\n%s", err, csvStr)
+	}
+	if diffs := original.Diff(restored); len(diffs) != 0 {
+		t.Errorf("Expected bonus rules to survive CSV round-trip, got %v", diffs)
+	}
+}
+
+func TestCSVPositionOverrides(t *testing.T) {
+	original, _ := PresetRules("te-premium")
+	original.SetPositionOverride("passing", "passingTouchdowns", "QB", 4.0)
+	original.UpdatePointValue("passing", "passingTouchdowns", 6.0)
+
+	csvStr, err := original.ToCSV()
+	if err != nil {
+		t.Fatalf("Error converting to CSV: %v", err)
+	}
+	if !strings.Contains(csvStr, "receiving,receptions,1.5 per reception (TE only)") {
+		t.Errorf("Expected TE override row, got:\n%s", csvStr)
+	}
+
+	restored, err := FromCSV(csvStr)
+	if err != nil {
+		t.Fatalf("Error converting from CSV: %v\n%s", err, csvStr)
+	}
+	if diffs := original.Diff(restored); len(diffs) != 0 {
+		t.Errorf("Expected overrides to survive CSV round-trip, got %v", diffs)
+	}
+
+	// Overrides may appear before their base rule but need one
+	if _, err := FromCSV("receiving,receptions,1.5 (TE only)\nreceiving,receptions,1\n"); err != nil {
+		t.Errorf("Unexpected error for override before base rule: %v", err)
+	}
+	if _, err := FromCSV("passing,passingYards,0.04 per yard\nreceiving,receptions,1.5 (TE only)\n"); err == nil {
+		t.Errorf("Expected error for override without a base rule")
+	}
+}
+
+func TestFromCSVYardageUnits(t *testing.T) {
+	csvStr := "passing,passingYards,1 per 25 yards\nrushing,rushingYards,0.1 per rushing yard\nreceiving,receivingYards,1 per 10 yd\n"
+
+	rules, err := FromCSV(csvStr)
+	if err != nil {
+		t.Fatalf("Error parsing CSV: %v", err)
+	}
+	expected := map[string]float64{"passing": 0.04, "rushing": 0.1, "receiving": 0.1}
+	for category, value := range expected {
+		statType := category + "Yards"
+		if rule := rules.ScoringRules[category][statType]; rule.Type != PerUnit || rule.Value != value {
+			t.Errorf("Expected %s to be %.2f per yard, got %v %.4f", statType, value, rule.Type, rule.Value)
+		}
+	}
+}
+
+func TestCSVHalfPPRRoundTrip(t *testing.T) {
+	half := DefaultRules()
+	half.HalfPPR()
+
+	// A half PPR league, and one whose half point per reception is turned off
+	off := DefaultRules()
+	off.HalfPPR()
+	off.DisablePPR()
+
+	for _, test := range []struct {
+		rules *LeagueRules
+		row   string
+	}{
+		{half, "receiving,receptions,0.5 per reception\n"},
+		{off, "receiving,receptions,0.5 per reception (if PPR enabled)\n"},
+	} {
+		csvStr, err := test.rules.ToCSV()
+		if err != nil {
+			t.Fatalf("Error converting to CSV: %v", err)
+		}
+		if !strings.Contains(csvStr, test.row) {
+			t.Errorf("Expected %q, got:\n%s", test.row, csvStr)
+		}
+
+		restored, err := FromCSV(csvStr)
+		if err != nil {
+			t.Fatalf("Error converting from CSV: %v\n%s", err, csvStr)
+		}
+		if diffs := test.rules.Diff(restored); len(diffs) != 0 {
+			t.Errorf("Expected %q to survive CSV round-trip, got %v", test.row, diffs)
+		}
+	}
+	if off.PPR || off.PPRValue != 0.5 || off.ScoringRules["receiving"]["receptions"].Value != 0 {
+		t.Errorf("Expected receptions off with a half point remembered, got PPR %v value %.2f", off.PPR, off.PPRValue)
+	}
+}
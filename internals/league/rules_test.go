@@ -1,6 +1,7 @@
 package league
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -21,12 +22,12 @@ func TestDefaultRules(t *testing.T) {
 	}
 
 	// Test roster positions
-	if rules.RosterPositions.QB != 1 {
-		t.Errorf("Expected default QB count to be 1, got %d", rules.RosterPositions.QB)
+	if rules.RosterPositions.Count("QB") != 1 {
+		t.Errorf("Expected default QB count to be 1, got %d", rules.RosterPositions.Count("QB"))
 	}
 
-	if rules.RosterPositions.RB != 2 {
-		t.Errorf("Expected default RB count to be 2, got %d", rules.RosterPositions.RB)
+	if rules.RosterPositions.Count("RB") != 2 {
+		t.Errorf("Expected default RB count to be 2, got %d", rules.RosterPositions.Count("RB"))
 	}
 
 	// Test scoring rules exist
@@ -110,14 +111,14 @@ func TestSetPositionCount(t *testing.T) {
 		count    int
 		getter   func() int
 	}{
-		{"QB", 2, func() int { return rules.RosterPositions.QB }},
-		{"RB", 3, func() int { return rules.RosterPositions.RB }},
-		{"WR", 4, func() int { return rules.RosterPositions.WR }},
-		{"TE", 2, func() int { return rules.RosterPositions.TE }},
-		{"FLEX", 2, func() int { return rules.RosterPositions.FLEX }},
-		{"K", 0, func() int { return rules.RosterPositions.K }},
-		{"DST", 2, func() int { return rules.RosterPositions.DST }},
-		{"BN", 8, func() int { return rules.RosterPositions.BN }},
+		{"QB", 2, func() int { return rules.RosterPositions.Count("QB") }},
+		{"RB", 3, func() int { return rules.RosterPositions.Count("RB") }},
+		{"WR", 4, func() int { return rules.RosterPositions.Count("WR") }},
+		{"TE", 2, func() int { return rules.RosterPositions.Count("TE") }},
+		{"FLEX", 2, func() int { return rules.RosterPositions.Count("FLEX") }},
+		{"K", 0, func() int { return rules.RosterPositions.Count("K") }},
+		{"DST", 2, func() int { return rules.RosterPositions.Count("DST") }},
+		{"BN", 8, func() int { return rules.RosterPositions.Count("BN") }},
 	}
 
 	for _, test := range tests {
@@ -142,14 +143,14 @@ func TestTotalRosterSize(t *testing.T) {
 	rules := DefaultRules()
 
 	// Calculate expected total manually
-	expected := rules.RosterPositions.QB +
-		rules.RosterPositions.RB +
-		rules.RosterPositions.WR +
-		rules.RosterPositions.TE +
-		rules.RosterPositions.FLEX +
-		rules.RosterPositions.K +
-		rules.RosterPositions.DST +
-		rules.RosterPositions.BN
+	expected := rules.RosterPositions.Count("QB") +
+		rules.RosterPositions.Count("RB") +
+		rules.RosterPositions.Count("WR") +
+		rules.RosterPositions.Count("TE") +
+		rules.RosterPositions.Count("FLEX") +
+		rules.RosterPositions.Count("K") +
+		rules.RosterPositions.Count("DST") +
+		rules.RosterPositions.Count("BN")
 
 	if rules.TotalRosterSize() != expected {
 		t.Errorf("Expected total roster size to be %d, got %d", expected, rules.TotalRosterSize())
@@ -186,20 +187,32 @@ func TestValidateRules(t *testing.T) {
 	}
 	rules.PlayoffTeams = 4 // reset
 
+	// Test a bracket that runs past the NFL season: 8 teams play 3 rounds
+	rules.PlayoffTeams = 8
+	rules.PlayoffWeekStart = 17
+	if err := rules.ValidateRules(); err == nil {
+		t.Errorf("Expected error for playoffs ending after week 18")
+	}
+	rules.PlayoffWeekStart = 16
+	if err := rules.ValidateRules(); err != nil {
+		t.Errorf("Expected an 8-team bracket ending in week 18 to be valid, got error: %v", err)
+	}
+	rules.PlayoffTeams, rules.PlayoffWeekStart = 4, 15 // reset
+
 	// Test invalid roster (no QB)
-	rules.RosterPositions.QB = 0
+	rules.SetPositionCount("QB", 0)
 	if err := rules.ValidateRules(); err == nil {
 		t.Errorf("Expected error for no QB")
 	}
-	rules.RosterPositions.QB = 1 // reset
+	rules.SetPositionCount("QB", 1) // reset
 
 	// Test invalid roster (no bench)
-	originalBN := rules.RosterPositions.BN
-	rules.RosterPositions.BN = 0
+	originalBN := rules.RosterPositions.Count("BN")
+	rules.SetPositionCount("BN", 0)
 	if err := rules.ValidateRules(); err == nil {
 		t.Errorf("Expected error for no bench spots")
 	}
-	rules.RosterPositions.BN = originalBN // reset
+	rules.SetPositionCount("BN", originalBN) // reset
 }
 
 func TestSetScoringRule(t *testing.T) {
@@ -467,7 +480,7 @@ func TestJSONSerialization(t *testing.T) {
 		t.Errorf("PPR setting not preserved in JSON round-trip")
 	}
 
-	if restored.RosterPositions.WR != original.RosterPositions.WR {
+	if restored.RosterPositions.Count("WR") != original.RosterPositions.Count("WR") {
 		t.Errorf("WR count not preserved in JSON round-trip")
 	}
 
@@ -496,7 +509,7 @@ func TestCloneRules(t *testing.T) {
 		t.Errorf("PPR setting not preserved in clone")
 	}
 
-	if clone.RosterPositions.WR != original.RosterPositions.WR {
+	if clone.RosterPositions.Count("WR") != original.RosterPositions.Count("WR") {
 		t.Errorf("WR count not preserved in clone")
 	}
 
@@ -524,3 +537,128 @@ func TestCloneRules(t *testing.T) {
 		t.Errorf("Clone passing TD value not updated: expected 7.0, got %.1f", clonePassingTD)
 	}
 }
+
+func TestPositionOverrides(t *testing.T) {
+	rules := DefaultRules()
+	rules.EnablePPR()
+
+	if err := rules.SetPositionOverride("receiving", "receptions", "te", 1.5); err != nil {
+		t.Fatalf("Error setting TE override: %v", err)
+	}
+
+	// Overrides only apply to their position
+	points, _ := rules.GetScoringValueForPosition("receiving", "receptions", "TE", 4)
+	if points != 6 {
+		t.Errorf("Expected 6 points for 4 TE receptions, got %.2f", points)
+	}
+	points, _ = rules.GetScoringValueForPosition("receiving", "receptions", "WR", 4)
+	if points != 4 {
+		t.Errorf("Expected 4 points for 4 WR receptions, got %.2f", points)
+	}
+	points, _ = rules.GetScoringValue("receiving", "receptions", 4)
+	if points != 4 {
+		t.Errorf("Expected base rule without a position, got %.2f", points)
+	}
+
+	// Base rule changes leave overrides in place
+	rules.DisablePPR()
+	if rule, _ := rules.RuleFor("receiving", "receptions", "TE"); rule.Value != 1.5 {
+		t.Errorf("Expected TE override to survive DisablePPR, got %.2f", rule.Value)
+	}
+
+	if err := rules.SetPositionOverride("receiving", "missing", "TE", 1.0); err == nil {
+		t.Errorf("Expected error overriding a missing rule")
+	}
+	if err := rules.SetPositionOverride("receiving", "receptions", "TE", "bad"); err == nil {
+		t.Errorf("Expected error for invalid override value")
+	}
+
+	// Overrides are serialized and shown
+	clone, err := rules.CloneRules()
+	if err != nil {
+		t.Fatalf("Error cloning rules: %v", err)
+	}
+	if rule, _ := clone.RuleFor("receiving", "receptions", "TE"); rule.Value != 1.5 {
+		t.Errorf("Expected TE override to survive JSON round-trip")
+	}
+	if !strings.Contains(rules.PrintScoringRules(), "    TE: 1.50 points\n") {
+		t.Errorf("Expected TE override in printed rules:\n%s", rules.PrintScoringRules())
+	}
+
+	if err := rules.RemovePositionOverride("receiving", "receptions", "TE"); err != nil {
+		t.Fatalf("Error removing override: %v", err)
+	}
+	if rule := rules.ScoringRules["receiving"]["receptions"]; rule.Positions != nil {
+		t.Errorf("Expected no overrides after removal, got %v", rule.Positions)
+	}
+	if err := rules.RemovePositionOverride("receiving", "receptions", "TE"); err == nil {
+		t.Errorf("Expected error removing a missing override")
+	}
+	if rule, _ := clone.RuleFor("receiving", "receptions", "TE"); rule.Value != 1.5 {
+		t.Errorf("Expected clone to keep its own overrides")
+	}
+}
+
+func TestBonusRules(t *testing.T) {
+	rules := DefaultRules()
+
+	// The measured stat defaults to the rule's stat type
+	if err := rules.SetScoringRule("passing", "passingYards300", ScoringRule{Type: Bonus, Value: 3, Stat: "passingYards", Threshold: 300}); err != nil {
+		t.Fatalf("Error setting bonus rule: %v", err)
+	}
+	if err := rules.SetScoringRule("rushing", "rushingYards100", NewBonusRule("rushingYards", 100, 2)); err != nil {
+		t.Fatalf("Error setting bonus rule: %v", err)
+	}
+	if err := rules.SetScoringRule("passing", "twoPointConversions", ScoringRule{Type: Bonus, Value: 2, PerPlay: true}); err != nil {
+		t.Fatalf("Error setting play bonus rule: %v", err)
+	}
+	if rule := rules.ScoringRules["passing"]["twoPointConversions"]; rule.Stat != "twoPointConversions" {
+		t.Errorf("Expected bonus stat to default to the stat type, got %q", rule.Stat)
+	}
+
+	// Bonuses are all or nothing at the threshold
+	tests := []struct {
+		value    float64
+		expected float64
+	}{
+		{299, 0},
+		{300, 3},
+		{412, 3},
+	}
+	for _, test := range tests {
+		points, err := rules.GetScoringValue("passing", "passingYards300", test.value)
+		if err != nil {
+			t.Errorf("Error getting bonus value: %v", err)
+		}
+		if points != test.expected {
+			t.Errorf("Expected %.0f bonus points for %.0f yards, got %.2f", test.expected, test.value, points)
+		}
+	}
+
+	if err := rules.ValidateRules(); err != nil {
+		t.Errorf("Expected bonus rules to be valid, got error: %v", err)
+	}
+
+	// Bonus rules are serialized with the rules
+	clone, err := rules.CloneRules()
+	if err != nil {
+		t.Fatalf("Error cloning rules: %v", err)
+	}
+	restored := clone.ScoringRules["rushing"]["rushingYards100"]
+	if restored.Type != Bonus || restored.Stat != "rushingYards" || restored.Threshold != 100 || restored.Value != 2 {
+		t.Errorf("Bonus rule not preserved in JSON round-trip: %+v", restored)
+	}
+	if !clone.ScoringRules["passing"]["twoPointConversions"].PerPlay {
+		t.Errorf("Expected play bonus flag to survive JSON round-trip")
+	}
+
+	if !strings.Contains(rules.PrintScoringRules(), "2.00 points for 100+ rushingYards in a game") {
+		t.Errorf("Expected bonus rule in printed rules:\n%s", rules.PrintScoringRules())
+	}
+
+	// Per-game bonuses need a threshold
+	rules.SetScoringRule("receiving", "receivingYardsBonus", NewBonusRule("receivingYards", 0, 3))
+	if err := rules.ValidateRules(); err == nil {
+		t.Errorf("Expected error for per-game bonus without a threshold")
+	}
+}
@@ -1 +1,120 @@
 package league
+
+// Matchup is a fantasy game between two teams in a week of the regular season
+type Matchup struct {
+	Week int
+	Home *Team
+	Away *Team
+}
+
+// nflWeeks is the number of weeks in the NFL regular season, which the
+// fantasy playoffs have to finish within
+const nflWeeks = 18
+
+// RegularSeasonWeeks returns the number of weeks played before the playoffs
+func (l *LeagueRules) RegularSeasonWeeks() int {
+	return max(l.PlayoffWeekStart-1, 0)
+}
+
+// LastWeek returns the week of the championship game for a league of teams
+func (l *LeagueRules) LastWeek(teams int) int {
+	rounds := 0
+	for size := 1; size < min(l.PlayoffTeams, teams); size *= 2 {
+		rounds++
+	}
+	return l.RegularSeasonWeeks() + rounds
+}
+
+// GenerateSchedule creates a round-robin regular season schedule for the
+// given number of weeks. Every team plays every other team once before any
+// rematches, and home games alternate from one cycle to the next. With an
+// odd number of teams one team has a bye each week.
+func GenerateSchedule(teams []*Team, weeks int) []Matchup {
+	if len(teams) < 2 {
+		return nil
+	}
+
+	// The circle method: the first team stays put while the rest rotate,
+	// with a nil team standing in for the bye
+	rotation := append([]*Team{}, teams...)
+	if len(rotation)%2 == 1 {
+		rotation = append(rotation, nil)
+	}
+	n := len(rotation)
+	rounds := n - 1
+
+	var schedule []Matchup
+	for week := 1; week <= weeks; week++ {
+		round := (week - 1) % rounds
+		cycle := (week - 1) / rounds
+
+		for i := 0; i < n/2; i++ {
+			home, away := rotation[i], rotation[n-1-i]
+			if home == nil || away == nil {
+				continue
+			}
+			// Alternate which side hosts so the fixed team isn't always home
+			if (i == 0 && round%2 == 1) != (cycle%2 == 1) {
+				home, away = away, home
+			}
+			schedule = append(schedule, Matchup{Week: week, Home: home, Away: away})
+		}
+
+		// Rotate every team but the first one spot clockwise
+		last := rotation[n-1]
+		copy(rotation[2:], rotation[1:n-1])
+		rotation[1] = last
+	}
+	return schedule
+}
+
+// WeekMatchups returns the matchups scheduled for a week
+func WeekMatchups(schedule []Matchup, week int) []Matchup {
+	var matchups []Matchup
+	for _, matchup := range schedule {
+		if matchup.Week == week {
+			matchups = append(matchups, matchup)
+		}
+	}
+	return matchups
+}
+
+// ScoredSlot is a lineup spot and the fantasy points its player scored
+type ScoredSlot struct {
+	LineupSlot
+	Points float64
+	Played bool // Whether the player recorded a stat that week
+}
+
+// TeamScore is a fantasy team's lineup scored for one week
+type TeamScore struct {
+	Team     *Team
+	Starters []ScoredSlot
+	Bench    []ScoredSlot
+	Total    float64 // Points scored by starters
+}
+
+// ScoreTeam scores a team's lineup for a week using each player's weekly
+// scores, as returned by Scorer.WeekScores. Only starters count toward the
+// total. Pass Team.ForWeek to score the lineup locked in for a played week.
+func ScoreTeam(team *Team, roster PositionRoster, scores map[string]*PlayerWeek) *TeamScore {
+	spots, _ := roster.Assign(team.lineup(nil), nil)
+
+	result := &TeamScore{Team: team}
+	for _, spot := range spots {
+		scored := ScoredSlot{LineupSlot: spot}
+		if spot.Player != nil {
+			if week, ok := scores[spot.Player.ID]; ok {
+				scored.Points, scored.Played = week.Points, true
+			}
+		}
+
+		if spot.Slot.IsStarter() {
+			result.Starters = append(result.Starters, scored)
+			result.Total += scored.Points
+		} else if spot.Player != nil {
+			result.Bench = append(result.Bench, scored)
+		}
+	}
+	return result
+}
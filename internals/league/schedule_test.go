@@ -0,0 +1,122 @@
+package league
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGenerateSchedule(t *testing.T) {
+	teams := testTeams(6)
+	schedule := GenerateSchedule(teams, 14)
+
+	if len(schedule) != 14*3 {
+		t.Fatalf("Expected %d matchups, got %d", 14*3, len(schedule))
+	}
+
+	// Every team plays exactly once a week
+	for week := 1; week <= 14; week++ {
+		seen := make(map[int]bool)
+		for _, matchup := range WeekMatchups(schedule, week) {
+			for _, team := range []*Team{matchup.Home, matchup.Away} {
+				if seen[team.ID] {
+					t.Errorf("Week %d: %s plays twice", week, team.Name)
+				}
+				seen[team.ID] = true
+			}
+		}
+		if len(seen) != len(teams) {
+			t.Errorf("Week %d: expected %d teams to play, got %d", week, len(teams), len(seen))
+		}
+	}
+
+	// The first five weeks are a full round robin
+	opponents := make(map[string]bool)
+	for _, matchup := range schedule {
+		if matchup.Week > 5 {
+			break
+		}
+		pair := fmt.Sprintf("%d-%d", min(matchup.Home.ID, matchup.Away.ID), max(matchup.Home.ID, matchup.Away.ID))
+		if opponents[pair] {
+			t.Errorf("Week %d: rematch %s before every team has met", matchup.Week, pair)
+		}
+		opponents[pair] = true
+	}
+	if len(opponents) != 15 {
+		t.Errorf("Expected 15 different pairings in the first 5 weeks, got %d", len(opponents))
+	}
+
+	// Home games are shared evenly over two full cycles
+	home := make(map[int]int)
+	for _, matchup := range schedule {
+		if matchup.Week <= 10 {
+			home[matchup.Home.ID]++
+		}
+	}
+	for _, team := range teams {
+		if home[team.ID] != 5 {
+			t.Errorf("Expected %s to host 5 games in 10 weeks, got %d", team.Name, home[team.ID])
+		}
+	}
+}
+
+func TestGenerateScheduleOddTeams(t *testing.T) {
+	teams := testTeams(5)
+	schedule := GenerateSchedule(teams, 5)
+
+	// Two games a week with one team on bye, and every team gets one bye
+	byes := make(map[int]int)
+	for week := 1; week <= 5; week++ {
+		matchups := WeekMatchups(schedule, week)
+		if len(matchups) != 2 {
+			t.Fatalf("Week %d: expected 2 matchups, got %d", week, len(matchups))
+		}
+		playing := make(map[int]bool)
+		for _, matchup := range matchups {
+			playing[matchup.Home.ID] = true
+			playing[matchup.Away.ID] = true
+		}
+		for _, team := range teams {
+			if !playing[team.ID] {
+				byes[team.ID]++
+			}
+		}
+	}
+	for _, team := range teams {
+		if byes[team.ID] != 1 {
+			t.Errorf("Expected %s to have 1 bye, got %d", team.Name, byes[team.ID])
+		}
+	}
+
+	if GenerateSchedule(testTeams(1), 14) != nil {
+		t.Error("Expected no schedule for a single team")
+	}
+}
+
+func TestScoreTeam(t *testing.T) {
+	roster := PositionRoster{newSlot("QB", 1), newSlot("RB", 1), newSlot("BN", 2)}
+	team := &Team{Name: "Scorers", Roster: []Player{
+		{ID: "qb", Position: "QB"},
+		{ID: "rb1", Position: "RB"},
+		{ID: "rb2", Position: "RB"},
+		{ID: "wr", Position: "WR"},
+	}}
+	scores := map[string]*PlayerWeek{
+		"qb":  {Week: 1, Points: 20},
+		"rb1": {Week: 1, Points: 8.5},
+		"rb2": {Week: 1, Points: 30},
+	}
+
+	score := ScoreTeam(team, roster, scores)
+	if score.Total != 28.5 {
+		t.Errorf("Expected starters to score 28.5, got %.2f", score.Total)
+	}
+	if len(score.Starters) != 2 || len(score.Bench) != 2 {
+		t.Fatalf("Expected 2 starters and 2 bench players, got %d and %d", len(score.Starters), len(score.Bench))
+	}
+	if score.Bench[0].Points != 30 || !score.Bench[0].Played {
+		t.Errorf("Expected bench player to score 30, got %+v", score.Bench[0])
+	}
+	if score.Bench[1].Played {
+		t.Errorf("Expected receiver without stats not to have played")
+	}
+}
@@ -1 +1,561 @@
 package league
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+)
+
+// StatLine holds a player's raw stat values keyed by nfl_stats category and stat type
+type StatLine map[string]map[string]float64
+
+// Add adds a value to a stat, creating the category if needed
+func (s StatLine) Add(category, statType string, value float64) {
+	if _, ok := s[category]; !ok {
+		s[category] = make(map[string]float64)
+	}
+	s[category][statType] += value
+}
+
+// Get returns the value of a stat (0 if the player didn't record it)
+func (s StatLine) Get(category, statType string) float64 {
+	return s[category][statType]
+}
+
+// statKey identifies a stat by category and stat type
+type statKey struct {
+	category string
+	statType string
+}
+
+// statAliases maps nfl_stats keys that have no scoring rule of their own onto
+// the rule they count toward. The boxscore records defensive interceptions
+// under their own category and kicking stats as "made/attempts" pairs.
+var statAliases = map[statKey]statKey{
+	{"interceptions", "interceptions"}:                {"defensive", "interceptions"},
+	{"kicking", "fieldGoalsMade/fieldGoalAttempts"}:   {"kicking", "fieldGoalsMade"},
+	{"kicking", "extraPointsMade/extraPointAttempts"}: {"kicking", "extraPointsMade"},
+}
+
+// ruleForStat returns the rule that scores an nfl_stats value for a player at
+// the given position and the stat it's keyed by, following statAliases when
+// there is no exact rule
+func (l *LeagueRules) ruleForStat(category, statType, position string) (statKey, ScoringRule, bool) {
+	if rule, ok := l.RuleFor(category, statType, position); ok {
+		return statKey{category, statType}, rule, true
+	}
+	if alias, ok := statAliases[statKey{category, statType}]; ok {
+		rule, ok := l.RuleFor(alias.category, alias.statType, position)
+		return alias, rule, ok
+	}
+	return statKey{}, ScoringRule{}, false
+}
+
+// ScoreStats returns the fantasy points for a stat line recorded by a player
+// at the given NFL position. Stats without a scoring rule are ignored.
+func (l *LeagueRules) ScoreStats(stats StatLine, position string) float64 {
+	return l.scoreStats(stats, position, nil)
+}
+
+// ScoreGame returns the fantasy points for a player's stat line in a game and
+// the plays behind it. Range-based rules, like field goals by distance, are
+// scored a play at a time when the game's plays were stored, and from the
+// stat line's count when they weren't.
+func (l *LeagueRules) ScoreGame(stats StatLine, plays []Play, position string) float64 {
+	total := 0.0
+	ranged := make(map[statKey]bool)
+	for _, play := range plays {
+		if rule, ok := l.RuleFor(play.Category, play.StatType, position); ok && rule.Type == RangeBased {
+			ranged[statKey{play.Category, play.StatType}] = true
+			total += rangePoints(rule, play.Yards)
+		}
+	}
+	return total + l.scoreStats(stats, position, ranged) + l.ScorePlays(plays, position)
+}
+
+// scoreStats scores a stat line, leaving out the rules in skip
+func (l *LeagueRules) scoreStats(stats StatLine, position string, skip map[statKey]bool) float64 {
+	// Sum in a fixed order so totals are reproducible to the last bit
+	categories := make([]string, 0, len(stats))
+	for category := range stats {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+
+	total := 0.0
+	for _, category := range categories {
+		statTypes := make([]string, 0, len(stats[category]))
+		for statType := range stats[category] {
+			statTypes = append(statTypes, statType)
+		}
+		sort.Strings(statTypes)
+
+		for _, statType := range statTypes {
+			if key, rule, ok := l.ruleForStat(category, statType, position); ok && !skip[key] {
+				total += statLinePoints(rule, stats[category][statType])
+			}
+		}
+	}
+
+	// Per-game bonuses, checked against the stat they measure
+	l.eachBonusRule(position, func(category string, rule ScoringRule) {
+		if !rule.PerPlay && stats.Get(category, rule.Stat) >= rule.Threshold {
+			total += rule.Value
+		}
+	})
+
+	return total
+}
+
+// Play is a single play credited to a player, used for play-level bonuses
+// such as long touchdowns and 2-point conversions
+type Play struct {
+	Category string  // Scoring category the play counts toward (e.g. "rushing")
+	StatType string  // Stat the play recorded (e.g. "rushingTouchdowns", "twoPointConversions")
+	Yards    float64 // Yards gained on the play
+}
+
+// ScorePlays returns the play-level bonus points earned by a player at the
+// given NFL position. Stat totals from the same plays are scored by ScoreStats.
+func (l *LeagueRules) ScorePlays(plays []Play, position string) float64 {
+	total := 0.0
+	l.eachBonusRule(position, func(category string, rule ScoringRule) {
+		if !rule.PerPlay {
+			return
+		}
+		for _, play := range plays {
+			if play.Category == category && play.StatType == rule.Stat && play.Yards >= rule.Threshold {
+				total += rule.Value
+			}
+		}
+	})
+	return total
+}
+
+// eachBonusRule calls fn for every Bonus rule as it applies to the position,
+// in category and stat type order
+func (l *LeagueRules) eachBonusRule(position string, fn func(category string, rule ScoringRule)) {
+	for _, category := range l.GetScoringCategories() {
+		statTypes, _ := l.GetStatTypesForCategory(category)
+		for _, statType := range statTypes {
+			rule := l.ScoringRules[category][statType].ForPosition(position)
+			if rule.Type == Bonus {
+				fn(category, rule)
+			}
+		}
+	}
+}
+
+// statLinePoints scores an aggregated stat value with a rule. Bonus rules are
+// scored separately since they aren't tied to a single stat line value.
+func statLinePoints(rule ScoringRule, value float64) float64 {
+	switch rule.Type {
+	case PerUnit, FixedUnit:
+		return rule.Value * value
+	case RangeBased:
+		// Stat lines only carry made counts, not distances, so without the
+		// plays each one is scored at the value of the shortest range
+		return rangePoints(rule, 0) * value
+	default:
+		return 0
+	}
+}
+
+// rangePoints returns a RangeBased rule's value for a distance: the range
+// with the highest lower bound it reaches, or the shortest range
+func rangePoints(rule ScoringRule, distance float64) float64 {
+	lowest, reached := "", ""
+	for rangeKey := range rule.Ranges {
+		bound := rangeLowerBound(rangeKey)
+		if lowest == "" || bound < rangeLowerBound(lowest) {
+			lowest = rangeKey
+		}
+		if float64(bound) <= distance && (reached == "" || bound > rangeLowerBound(reached)) {
+			reached = rangeKey
+		}
+	}
+	if reached == "" {
+		return rule.Ranges[lowest]
+	}
+	return rule.Ranges[reached]
+}
+
+// Scorer calculates fantasy points from the nfl_stats table using a league's
+// rules, looking up each player's position in nfl_players for position overrides
+type Scorer struct {
+	queries sqlc.Querier
+	rules   *LeagueRules
+
+	mu        sync.Mutex
+	positions map[string]string // Player ID -> NFL position
+}
+
+// NewScorer creates a scorer for the given rules
+func NewScorer(queries sqlc.Querier, rules *LeagueRules) *Scorer {
+	return &Scorer{
+		queries:   queries,
+		rules:     rules,
+		positions: make(map[string]string),
+	}
+}
+
+// Rules returns the league rules used by the scorer
+func (s *Scorer) Rules() *LeagueRules {
+	return s.rules
+}
+
+// PlayerPosition returns a player's NFL position, caching lookups
+func (s *Scorer) PlayerPosition(ctx context.Context, playerID string) (string, error) {
+	s.mu.Lock()
+	position, ok := s.positions[playerID]
+	s.mu.Unlock()
+	if ok {
+		return position, nil
+	}
+
+	player, err := s.queries.GetNFLPlayer(ctx, playerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Unknown players are scored with the base rules
+		position = ""
+	} else if err != nil {
+		return "", fmt.Errorf("error getting player %s: %w", playerID, err)
+	} else {
+		position = strings.ToUpper(player.Position)
+	}
+
+	s.mu.Lock()
+	s.positions[playerID] = position
+	s.mu.Unlock()
+	return position, nil
+}
+
+// score scores a stat line and the plays behind it for a player using their
+// NFL position
+func (s *Scorer) score(ctx context.Context, playerID string, stats StatLine, plays []Play) (float64, error) {
+	position, err := s.PlayerPosition(ctx, playerID)
+	if err != nil {
+		return 0, err
+	}
+	return s.rules.ScoreGame(stats, plays, position), nil
+}
+
+// playerPlays returns a player's scoring plays in a season, by game
+func (s *Scorer) playerPlays(ctx context.Context, playerID string, season int64) (map[int64][]Play, error) {
+	plays, err := LoadPlayerPlays(ctx, s.queries, playerID, season)
+	if err != nil {
+		return nil, err
+	}
+	games := make(map[int64][]Play)
+	for _, play := range plays {
+		if scoring, ok := scoringPlay(play); ok {
+			games[play.GameID] = append(games[play.GameID], scoring)
+		}
+	}
+	return games, nil
+}
+
+// groupPlays groups scoring play rows by player and game
+func groupPlays(rows []*sqlc.GetSeasonScoringPlaysRow) map[string]map[int64][]Play {
+	plays := make(map[string]map[int64][]Play)
+	for _, row := range rows {
+		scoring, ok := scoringPlay(PlayerPlay{
+			GameID:         row.GameID,
+			Week:           int(row.Week),
+			Role:           row.Role,
+			Type:           row.PlayType,
+			Result:         row.Result,
+			YardsToEndzone: int(row.YardsToEndzone),
+			Yards:          int(row.Yards),
+			Scoring:        row.Scoring,
+		})
+		if !ok {
+			continue
+		}
+		if plays[row.PlayerID] == nil {
+			plays[row.PlayerID] = make(map[int64][]Play)
+		}
+		plays[row.PlayerID][row.GameID] = append(plays[row.PlayerID][row.GameID], scoring)
+	}
+	return plays
+}
+
+// weekPlays returns every player's scoring plays in a week, by player and game
+func (s *Scorer) weekPlays(ctx context.Context, season, week int64) (map[string]map[int64][]Play, error) {
+	rows, err := s.queries.GetWeekScoringPlays(ctx, sqlc.GetWeekScoringPlaysParams{Season: season, Week: week})
+	if err != nil {
+		return nil, fmt.Errorf("error getting %d week %d scoring plays: %w", season, week, err)
+	}
+	seasonRows := make([]*sqlc.GetSeasonScoringPlaysRow, len(rows))
+	for i, row := range rows {
+		seasonRow := sqlc.GetSeasonScoringPlaysRow(*row)
+		seasonRows[i] = &seasonRow
+	}
+	return groupPlays(seasonRows), nil
+}
+
+// GamePoints returns a player's fantasy points for a single game
+func (s *Scorer) GamePoints(ctx context.Context, playerID string, gameID int64) (float64, error) {
+	rows, err := s.queries.GetStatsByGameAndPlayer(ctx, sqlc.GetStatsByGameAndPlayerParams{
+		GameID:   gameID,
+		PlayerID: playerID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error getting stats for player %s in game %d: %w", playerID, gameID, err)
+	}
+
+	stats := StatLine{}
+	for _, row := range rows {
+		stats.Add(row.Category, row.StatType, row.StatValue)
+	}
+
+	playRows, err := s.queries.GetGameScoringPlays(ctx, gameID)
+	if err != nil {
+		return 0, fmt.Errorf("error getting scoring plays in game %d: %w", gameID, err)
+	}
+	seasonRows := make([]*sqlc.GetSeasonScoringPlaysRow, len(playRows))
+	for i, row := range playRows {
+		seasonRow := sqlc.GetSeasonScoringPlaysRow(*row)
+		seasonRows[i] = &seasonRow
+	}
+	plays := groupPlays(seasonRows)[playerID][gameID]
+	return s.score(ctx, playerID, stats, plays)
+}
+
+// WeekPoints returns a player's fantasy points for a week of a season
+func (s *Scorer) WeekPoints(ctx context.Context, playerID string, season, week int64) (float64, error) {
+	rows, err := s.queries.GetPlayerStatsByWeek(ctx, sqlc.GetPlayerStatsByWeekParams{
+		PlayerID: playerID,
+		Season:   season,
+		Week:     week,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error getting week %d stats for player %s: %w", week, playerID, err)
+	}
+
+	stats := StatLine{}
+	for _, row := range rows {
+		stats.Add(row.Category, row.StatType, row.StatValue)
+	}
+
+	weekPlays, err := s.weekPlays(ctx, season, week)
+	if err != nil {
+		return 0, err
+	}
+	var plays []Play
+	for _, gamePlays := range weekPlays[playerID] {
+		plays = append(plays, gamePlays...)
+	}
+	return s.score(ctx, playerID, stats, plays)
+}
+
+// SeasonPoints returns a player's total fantasy points for a season. Games
+// are scored one at a time so per-game bonuses are applied correctly.
+func (s *Scorer) SeasonPoints(ctx context.Context, playerID string, season int64) (float64, error) {
+	rows, err := s.queries.GetPlayerGameStatsBySeason(ctx, sqlc.GetPlayerGameStatsBySeasonParams{
+		PlayerID: playerID,
+		Season:   season,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error getting %d stats for player %s: %w", season, playerID, err)
+	}
+
+	games := make(map[int64]StatLine)
+	var gameIDs []int64
+	for _, row := range rows {
+		stats, ok := games[row.GameID]
+		if !ok {
+			stats = StatLine{}
+			games[row.GameID] = stats
+			gameIDs = append(gameIDs, row.GameID)
+		}
+		stats.Add(row.Category, row.StatType, row.StatValue)
+	}
+	plays, err := s.playerPlays(ctx, playerID, season)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0.0
+	for _, gameID := range gameIDs {
+		points, err := s.score(ctx, playerID, games[gameID], plays[gameID])
+		if err != nil {
+			return 0, err
+		}
+		total += points
+	}
+	return total, nil
+}
+
+// PlayerWeek is a player's fantasy production in one week of a season
+type PlayerWeek struct {
+	Week   int64
+	Points float64  // Fantasy points, scored game by game
+	Stats  StatLine // Stat totals for the week
+}
+
+// WeeklyPoints returns a player's fantasy points for each week of a season in
+// which they recorded a stat, ordered by week
+func (s *Scorer) WeeklyPoints(ctx context.Context, playerID string, season int64) ([]*PlayerWeek, error) {
+	rows, err := s.queries.GetPlayerGameStatsBySeason(ctx, sqlc.GetPlayerGameStatsBySeasonParams{
+		PlayerID: playerID,
+		Season:   season,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting %d stats for player %s: %w", season, playerID, err)
+	}
+
+	position, err := s.PlayerPosition(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	plays, err := s.playerPlays(ctx, playerID, season)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rows are ordered by week and game, so each game is scored once complete
+	var (
+		weeks  []*PlayerWeek
+		game   StatLine
+		gameID int64
+	)
+	finishGame := func() {
+		if game != nil {
+			weeks[len(weeks)-1].Points += s.rules.ScoreGame(game, plays[gameID], position)
+		}
+		game = nil
+	}
+
+	for _, row := range rows {
+		if len(weeks) == 0 || weeks[len(weeks)-1].Week != row.Week {
+			finishGame()
+			weeks = append(weeks, &PlayerWeek{Week: row.Week, Stats: StatLine{}})
+		} else if row.GameID != gameID {
+			finishGame()
+		}
+
+		if game == nil {
+			game = StatLine{}
+			gameID = row.GameID
+		}
+		game.Add(row.Category, row.StatType, row.StatValue)
+		weeks[len(weeks)-1].Stats.Add(row.Category, row.StatType, row.StatValue)
+	}
+	finishGame()
+
+	return weeks, nil
+}
+
+// PlayerSeasonSummary is a player's fantasy production over a season
+type PlayerSeasonSummary struct {
+	PlayerID string
+	Position string
+	Games    int      // Games with at least one recorded stat
+	Points   float64  // Fantasy points, scored game by game
+	Stats    StatLine // Season stat totals
+}
+
+// PointsPerGame returns the player's average fantasy points per game played
+func (p *PlayerSeasonSummary) PointsPerGame() float64 {
+	if p.Games == 0 {
+		return 0
+	}
+	return p.Points / float64(p.Games)
+}
+
+// SeasonSummaries scores every player's games in a season, keyed by player ID
+func (s *Scorer) SeasonSummaries(ctx context.Context, season int64) (map[string]*PlayerSeasonSummary, error) {
+	rows, err := s.queries.GetSeasonGameStats(ctx, season)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %d game stats: %w", season, err)
+	}
+	playRows, err := s.queries.GetSeasonScoringPlays(ctx, season)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %d scoring plays: %w", season, err)
+	}
+	return s.summarize(rows, groupPlays(playRows)), nil
+}
+
+// WeekScores scores every player's games in a week of a season, keyed by player ID
+func (s *Scorer) WeekScores(ctx context.Context, season, week int64) (map[string]*PlayerWeek, error) {
+	rows, err := s.queries.GetWeekGameStats(ctx, sqlc.GetWeekGameStatsParams{
+		Season: season,
+		Week:   week,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting %d week %d game stats: %w", season, week, err)
+	}
+
+	gameRows := make([]*sqlc.GetSeasonGameStatsRow, len(rows))
+	for i, row := range rows {
+		gameRow := sqlc.GetSeasonGameStatsRow(*row)
+		gameRows[i] = &gameRow
+	}
+	plays, err := s.weekPlays(ctx, season, week)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[string]*PlayerWeek)
+	for playerID, summary := range s.summarize(gameRows, plays) {
+		scores[playerID] = &PlayerWeek{Week: week, Points: summary.Points, Stats: summary.Stats}
+	}
+	return scores, nil
+}
+
+// summarize scores game stat rows ordered by player and game, along with
+// each player's scoring plays in those games
+func (s *Scorer) summarize(rows []*sqlc.GetSeasonGameStatsRow, plays map[string]map[int64][]Play) map[string]*PlayerSeasonSummary {
+	summaries := make(map[string]*PlayerSeasonSummary)
+	var (
+		summary *PlayerSeasonSummary
+		game    StatLine
+		gameID  int64
+	)
+
+	// Rows are ordered by player and game, so each game is scored once complete
+	finishGame := func() {
+		if summary != nil && game != nil {
+			summary.Points += s.rules.ScoreGame(game, plays[summary.PlayerID][gameID], summary.Position)
+			summary.Games++
+		}
+		game = nil
+	}
+
+	for _, row := range rows {
+		if summary == nil || row.PlayerID != summary.PlayerID {
+			finishGame()
+			summary = &PlayerSeasonSummary{
+				PlayerID: row.PlayerID,
+				Position: strings.ToUpper(row.Position),
+				Stats:    StatLine{},
+			}
+			summaries[row.PlayerID] = summary
+		} else if row.GameID != gameID {
+			finishGame()
+		}
+
+		if game == nil {
+			game = StatLine{}
+			gameID = row.GameID
+		}
+		game.Add(row.Category, row.StatType, row.StatValue)
+		summary.Stats.Add(row.Category, row.StatType, row.StatValue)
+	}
+	finishGame()
+
+	// Remember positions for later per-player lookups
+	s.mu.Lock()
+	for playerID, summary := range summaries {
+		s.positions[playerID] = summary.Position
+	}
+	s.mu.Unlock()
+
+	return summaries
+}
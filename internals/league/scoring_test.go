@@ -0,0 +1,122 @@
+package league
+
+import (
+	"math"
+	"testing"
+)
+
+func TestScoreStats(t *testing.T) {
+	rules := DefaultRules()
+	rules.EnablePPR()
+
+	stats := StatLine{}
+	stats.Add("passing", "passingYards", 250)
+	stats.Add("passing", "passingTouchdowns", 2)
+	stats.Add("passing", "interceptions", 1)
+	stats.Add("passing", "completions/passingAttempts", 20) // no rule, ignored
+	stats.Add("rushing", "rushingYards", 30)
+	stats.Add("rushing", "rushingTouchdowns", 1)
+
+	// 10 + 8 - 2 + 3 + 6
+	if points := rules.ScoreStats(stats, "QB"); math.Abs(points-25) > 1e-9 {
+		t.Errorf("Expected 25 points, got %.2f", points)
+	}
+
+	// Defensive interceptions and kicking attempts map onto existing rules
+	defense := StatLine{}
+	defense.Add("interceptions", "interceptions", 1)
+	if points := rules.ScoreStats(defense, "CB"); points != 2 {
+		t.Errorf("Expected 2 points for a defensive interception, got %.2f", points)
+	}
+
+	kicking := StatLine{}
+	kicking.Add("kicking", "fieldGoalsMade/fieldGoalAttempts", 2)
+	kicking.Add("kicking", "extraPointsMade/extraPointAttempts", 3)
+	if points := rules.ScoreStats(kicking, "K"); points != 9 {
+		t.Errorf("Expected 9 kicking points, got %.2f", points)
+	}
+}
+
+func TestScoreGameFieldGoals(t *testing.T) {
+	rules := DefaultRules()
+
+	kicking := StatLine{}
+	kicking.Add("kicking", "fieldGoalsMade/fieldGoalAttempts", 2)
+	kicking.Add("kicking", "extraPointsMade/extraPointAttempts", 1)
+
+	// Each kick is scored by its distance: 5 for 52 yards and 3 for 33
+	plays := []Play{
+		{Category: "kicking", StatType: "fieldGoalsMade", Yards: 52},
+		{Category: "kicking", StatType: "fieldGoalsMade", Yards: 33},
+	}
+	if points := rules.ScoreGame(kicking, plays, "K"); points != 9 {
+		t.Errorf("Expected 9 kicking points from the plays, got %.2f", points)
+	}
+
+	// Without the game's plays the made count is scored at the shortest range
+	if points := rules.ScoreGame(kicking, nil, "K"); points != 7 {
+		t.Errorf("Expected 7 kicking points from the stat line, got %.2f", points)
+	}
+}
+
+func TestScoreStatsPositionOverrides(t *testing.T) {
+	rules, _ := PresetRules("te-premium")
+
+	// QBs score 4 per passing TD, anyone else throwing one scores 6
+	rules.UpdatePointValue("passing", "passingTouchdowns", 6)
+	rules.SetPositionOverride("passing", "passingTouchdowns", "QB", 4.0)
+
+	receiving := StatLine{}
+	receiving.Add("receiving", "receptions", 6)
+	receiving.Add("receiving", "receivingYards", 50)
+
+	if points := rules.ScoreStats(receiving, "TE"); points != 14 {
+		t.Errorf("Expected 14 points for a TE, got %.2f", points)
+	}
+	if points := rules.ScoreStats(receiving, "WR"); points != 11 {
+		t.Errorf("Expected 11 points for a WR, got %.2f", points)
+	}
+
+	passing := StatLine{}
+	passing.Add("passing", "passingTouchdowns", 1)
+	if points := rules.ScoreStats(passing, "QB"); points != 4 {
+		t.Errorf("Expected 4 points for a QB passing TD, got %.2f", points)
+	}
+	if points := rules.ScoreStats(passing, "WR"); points != 6 {
+		t.Errorf("Expected 6 points for a WR passing TD, got %.2f", points)
+	}
+}
+
+func TestScoreStatsBonuses(t *testing.T) {
+	rules, _ := PresetRules("bonus")
+
+	stats := StatLine{}
+	stats.Add("passing", "passingYards", 300)
+	stats.Add("rushing", "rushingYards", 99)
+
+	// 12 + 9.9 + 3 bonus for 300 passing yards, none for 99 rushing yards
+	if points := rules.ScoreStats(stats, "QB"); math.Abs(points-24.9) > 1e-9 {
+		t.Errorf("Expected 24.9 points, got %.2f", points)
+	}
+
+	// Play bonuses only come from plays
+	plays := []Play{
+		{Category: "rushing", StatType: "rushingTouchdowns", Yards: 45},
+		{Category: "rushing", StatType: "rushingTouchdowns", Yards: 12},
+		{Category: "receiving", StatType: "twoPointConversions"},
+	}
+	if points := rules.ScorePlays(plays, "RB"); points != 4 {
+		t.Errorf("Expected 4 play bonus points, got %.2f", points)
+	}
+	if points := DefaultRules().ScorePlays(plays, "RB"); points != 0 {
+		t.Errorf("Expected no play bonuses under default rules, got %.2f", points)
+	}
+
+	// Bonuses can be overridden by position like any other rule
+	rules.SetPositionOverride("rushing", "rushingYardsBonus", "QB", NewBonusRule("rushingYards", 50, 5))
+	qbRushing := StatLine{}
+	qbRushing.Add("rushing", "rushingYards", 60)
+	if points := rules.ScoreStats(qbRushing, "QB"); math.Abs(points-11) > 1e-9 {
+		t.Errorf("Expected 11 points for a 60 yard QB rushing game, got %.2f", points)
+	}
+}
@@ -0,0 +1,173 @@
+package league
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+)
+
+// Season is a league's fantasy season: the drafted teams and the NFL season
+// whose games they score points in
+type Season struct {
+	LeagueID int64
+	Year     int64 // NFL season year
+	Week     int   // Last week played, 0 before the season starts
+	Teams    []*Team
+}
+
+// SaveSeason saves a season's teams and rosters, replacing any earlier
+// draft for the same league and year
+func SaveSeason(ctx context.Context, queries sqlc.Querier, season *Season) error {
+	if season.LeagueID == 0 {
+		return fmt.Errorf("league must be saved before its seasons")
+	}
+
+	teamsJSON, err := json.Marshal(season.Teams)
+	if err != nil {
+		return fmt.Errorf("error encoding %d teams: %w", season.Year, err)
+	}
+
+	err = queries.UpsertFantasySeason(ctx, sqlc.UpsertFantasySeasonParams{
+		LeagueID: season.LeagueID,
+		Season:   season.Year,
+		Teams:    string(teamsJSON),
+		Week:     int64(season.Week),
+	})
+	if err != nil {
+		return fmt.Errorf("error saving %d season: %w", season.Year, err)
+	}
+	return nil
+}
+
+// LoadSeasons returns every season a league has played, newest first
+func LoadSeasons(ctx context.Context, queries sqlc.Querier, leagueID int64) ([]*Season, error) {
+	rows, err := queries.GetFantasySeasons(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting seasons for league %d: %w", leagueID, err)
+	}
+
+	seasons := make([]*Season, 0, len(rows))
+	for _, row := range rows {
+		season := &Season{LeagueID: row.LeagueID, Year: row.Season, Week: int(row.Week)}
+		if err := json.Unmarshal([]byte(row.Teams), &season.Teams); err != nil {
+			return nil, fmt.Errorf("error decoding %d teams: %w", row.Season, err)
+		}
+		seasons = append(seasons, season)
+	}
+	return seasons, nil
+}
+
+// CompletedWeeks returns the weeks of an NFL season in which every game is
+// final, in order. Games scraped before scores were tracked have no score
+// and count as final once their date has passed.
+func CompletedWeeks(ctx context.Context, queries sqlc.Querier, season int64, now time.Time) ([]int, error) {
+	games, err := queries.GetGamesBySeason(ctx, season)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %d games: %w", season, err)
+	}
+
+	today := now.Format("2006-01-02")
+	complete := make(map[int]bool)
+	for _, game := range games {
+		week := int(game.Week)
+		done := game.Completed || (!game.AwayScore.Valid && !game.HomeScore.Valid && game.Date < today)
+		if _, seen := complete[week]; !seen {
+			complete[week] = done
+		} else {
+			complete[week] = complete[week] && done
+		}
+	}
+
+	var weeks []int
+	for week, done := range complete {
+		if done {
+			weeks = append(weeks, week)
+		}
+	}
+	sort.Ints(weeks)
+	return weeks, nil
+}
+
+// Advance plays a season's next week, locking in every team's current lineup
+// for it, and returns the week. completed lists the NFL season's weeks whose
+// games are all final, as returned by CompletedWeeks, and injuries is the
+// week's injury report, which automatic lineups start around.
+func (s *Season) Advance(rules *LeagueRules, completed []int, injuries Injuries) (int, error) {
+	next := s.Week + 1
+	if next > rules.LastWeek(len(s.Teams)) {
+		return 0, fmt.Errorf("the %d season is over", s.Year)
+	}
+	if !slices.Contains(completed, next) {
+		return 0, fmt.Errorf("week %d of the %d NFL season isn't final yet", next, s.Year)
+	}
+
+	for _, team := range s.Teams {
+		team.lockLineup(next, rules.RosterPositions, injuries)
+	}
+	s.Week = next
+	return next, nil
+}
+
+// SeasonReport is a fantasy season played through its completed weeks
+type SeasonReport struct {
+	Season            *Season
+	Schedule          []Matchup
+	Results           []GameResult // Regular season results in week order
+	Standings         []*Standing
+	Bracket           *Bracket // Seeded from the current standings until the regular season ends
+	RegularSeasonDone bool
+	WeekScores        map[int]map[string]*PlayerWeek // Player scores for each completed week
+}
+
+// PlaySeason scores a season's schedule and playoffs for every week it has
+// played, using the scorer's league rules
+func (s *Scorer) PlaySeason(ctx context.Context, season *Season) (*SeasonReport, error) {
+	weeks, err := CompletedWeeks(ctx, s.queries, season.Year, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	regularWeeks := s.rules.RegularSeasonWeeks()
+	lastWeek := min(s.rules.LastWeek(len(season.Teams)), season.Week)
+
+	report := &SeasonReport{
+		Season:     season,
+		Schedule:   GenerateSchedule(season.Teams, regularWeeks),
+		WeekScores: make(map[int]map[string]*PlayerWeek),
+	}
+	for _, week := range weeks {
+		if week > lastWeek {
+			break
+		}
+		scores, err := s.WeekScores(ctx, season.Year, int64(week))
+		if err != nil {
+			return nil, err
+		}
+		report.WeekScores[week] = scores
+	}
+
+	roster := s.rules.RosterPositions
+	report.Results = PlayRegularSeason(report.Schedule, roster, report.WeekScores)
+	report.Standings = Standings(season.Teams, report.Results)
+
+	report.RegularSeasonDone = true
+	for week := 1; week <= regularWeeks; week++ {
+		if _, ok := report.WeekScores[week]; !ok {
+			report.RegularSeasonDone = false
+		}
+	}
+
+	seeds := PlayoffSeeds(report.Standings, s.rules.PlayoffTeams)
+	playoffScores := report.WeekScores
+	if !report.RegularSeasonDone {
+		// Projected bracket: nobody plays until the seeds are final
+		playoffScores = nil
+	}
+	report.Bracket = PlayBracket(seeds, s.rules.PlayoffWeekStart, roster, playoffScores)
+	return report, nil
+}
@@ -0,0 +1,149 @@
+package league
+
+import (
+	"fmt"
+	"sort"
+)
+
+// GameResult is a scored fantasy matchup
+type GameResult struct {
+	Week      int
+	Home      *Team
+	Away      *Team
+	HomeScore float64
+	AwayScore float64
+}
+
+// Winner returns the team that scored more points, or nil for a tie
+func (r GameResult) Winner() *Team {
+	switch {
+	case r.HomeScore > r.AwayScore:
+		return r.Home
+	case r.AwayScore > r.HomeScore:
+		return r.Away
+	default:
+		return nil
+	}
+}
+
+// PlayRegularSeason scores every scheduled matchup in the weeks that have
+// scores, using the lineups teams locked in for each week. weekScores holds
+// each completed week's player scores, as returned by Scorer.WeekScores.
+func PlayRegularSeason(schedule []Matchup, roster PositionRoster, weekScores map[int]map[string]*PlayerWeek) []GameResult {
+	var results []GameResult
+	for _, matchup := range schedule {
+		scores, ok := weekScores[matchup.Week]
+		if !ok {
+			continue
+		}
+		results = append(results, GameResult{
+			Week:      matchup.Week,
+			Home:      matchup.Home,
+			Away:      matchup.Away,
+			HomeScore: ScoreTeam(matchup.Home.ForWeek(matchup.Week), roster, scores).Total,
+			AwayScore: ScoreTeam(matchup.Away.ForWeek(matchup.Week), roster, scores).Total,
+		})
+	}
+	return results
+}
+
+// Standing is a team's regular season record
+type Standing struct {
+	Team          *Team
+	Wins          int
+	Losses        int
+	Ties          int
+	PointsFor     float64
+	PointsAgainst float64
+	Streak        int // Current streak: positive for wins, negative for losses, 0 after a tie
+}
+
+// Record formats the team's record as W-L-T
+func (s *Standing) Record() string {
+	return fmt.Sprintf("%d-%d-%d", s.Wins, s.Losses, s.Ties)
+}
+
+// WinPercentage returns the share of games won, counting ties as half a win
+func (s *Standing) WinPercentage() float64 {
+	games := s.Wins + s.Losses + s.Ties
+	if games == 0 {
+		return 0
+	}
+	return (float64(s.Wins) + float64(s.Ties)/2) / float64(games)
+}
+
+// StreakText formats the current streak, e.g. "W3" or "L1"
+func (s *Standing) StreakText() string {
+	switch {
+	case s.Streak > 0:
+		return fmt.Sprintf("W%d", s.Streak)
+	case s.Streak < 0:
+		return fmt.Sprintf("L%d", -s.Streak)
+	default:
+		return "-"
+	}
+}
+
+// Standings ranks teams by win percentage, then points scored. Results must
+// be in week order so streaks are counted correctly.
+func Standings(teams []*Team, results []GameResult) []*Standing {
+	byTeam := make(map[*Team]*Standing, len(teams))
+	standings := make([]*Standing, len(teams))
+	for i, team := range teams {
+		standings[i] = &Standing{Team: team}
+		byTeam[team] = standings[i]
+	}
+
+	record := func(team *Team, scored, allowed float64, winner *Team) {
+		standing, ok := byTeam[team]
+		if !ok {
+			return
+		}
+		standing.PointsFor += scored
+		standing.PointsAgainst += allowed
+		switch winner {
+		case nil:
+			standing.Ties++
+			standing.Streak = 0
+		case team:
+			standing.Wins++
+			standing.Streak = max(standing.Streak, 0) + 1
+		default:
+			standing.Losses++
+			standing.Streak = min(standing.Streak, 0) - 1
+		}
+	}
+
+	for _, result := range results {
+		winner := result.Winner()
+		record(result.Home, result.HomeScore, result.AwayScore, winner)
+		record(result.Away, result.AwayScore, result.HomeScore, winner)
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.WinPercentage() != b.WinPercentage() {
+			return a.WinPercentage() > b.WinPercentage()
+		}
+		return a.PointsFor > b.PointsFor
+	})
+	return standings
+}
+
+// LongestWinStreak returns the longest run of consecutive wins by a team in
+// results, which must be in week order
+func LongestWinStreak(team *Team, results []GameResult) int {
+	longest, current := 0, 0
+	for _, result := range results {
+		if result.Home != team && result.Away != team {
+			continue
+		}
+		if result.Winner() == team {
+			current++
+			longest = max(longest, current)
+		} else {
+			current = 0
+		}
+	}
+	return longest
+}
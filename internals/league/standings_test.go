@@ -0,0 +1,62 @@
+package league
+
+import "testing"
+
+func TestStandings(t *testing.T) {
+	teams := testTeams(3)
+	a, b, c := teams[0], teams[1], teams[2]
+	results := []GameResult{
+		{Week: 1, Home: a, Away: b, HomeScore: 100, AwayScore: 90},
+		{Week: 2, Home: a, Away: c, HomeScore: 80, AwayScore: 80},
+		{Week: 3, Home: b, Away: c, HomeScore: 120, AwayScore: 70},
+		{Week: 4, Home: b, Away: a, HomeScore: 110, AwayScore: 95},
+		{Week: 5, Home: c, Away: b, HomeScore: 60, AwayScore: 130},
+	}
+
+	standings := Standings(teams, results)
+
+	// B is 3-1, A is 1-1-1, C is 0-2-1
+	expected := []struct {
+		team   *Team
+		record string
+		streak string
+	}{{b, "3-1-0", "W3"}, {a, "1-1-1", "L1"}, {c, "0-2-1", "L2"}}
+	for i, want := range expected {
+		got := standings[i]
+		if got.Team != want.team || got.Record() != want.record || got.StreakText() != want.streak {
+			t.Errorf("Rank %d: expected %s %s %s, got %s %s %s", i+1,
+				want.team.Name, want.record, want.streak, got.Team.Name, got.Record(), got.StreakText())
+		}
+	}
+	if standings[0].PointsFor != 450 || standings[0].PointsAgainst != 325 {
+		t.Errorf("Expected B to score 450 and allow 325, got %.0f and %.0f", standings[0].PointsFor, standings[0].PointsAgainst)
+	}
+
+	if streak := LongestWinStreak(b, results); streak != 3 {
+		t.Errorf("Expected B's longest win streak to be 3, got %d", streak)
+	}
+	if streak := LongestWinStreak(c, results); streak != 0 {
+		t.Errorf("Expected C to have no win streak, got %d", streak)
+	}
+}
+
+func TestPlayRegularSeason(t *testing.T) {
+	roster := PositionRoster{newSlot("QB", 1), newSlot("BN", 1)}
+	teams := testTeams(2)
+	teams[0].Roster = []Player{{ID: "qb1", Position: "QB"}}
+	teams[1].Roster = []Player{{ID: "qb2", Position: "QB"}}
+	schedule := GenerateSchedule(teams, 3)
+
+	// Only the first two weeks have been played
+	weekScores := map[int]map[string]*PlayerWeek{
+		1: {"qb1": {Points: 20}, "qb2": {Points: 15}},
+		2: {"qb1": {Points: 10}},
+	}
+	results := PlayRegularSeason(schedule, roster, weekScores)
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+	if winner := results[1].Winner(); winner != teams[0] {
+		t.Errorf("Expected %s to win week 2, got %v", teams[0].Name, winner)
+	}
+}
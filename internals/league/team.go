@@ -1 +1,52 @@
 package league
+
+import "fmt"
+
+// Player is an NFL player as rostered by fantasy teams
+type Player struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Position string `json:"position"`
+	NFLTeam  string `json:"nfl_team"` // NFL team abbreviation ("FA" for free agents)
+}
+
+// Team is a fantasy team in a league
+type Team struct {
+	ID       int      `json:"id"`
+	Name     string   `json:"name"`
+	Owner    string   `json:"owner,omitempty"`
+	Bot      bool     `json:"bot"`                // Bot teams draft and set lineups automatically
+	Division string   `json:"division,omitempty"` // Empty when the league has no divisions
+	Roster   []Player `json:"roster"`
+
+	Starters []string         `json:"starters,omitempty"` // Player IDs set to start from the next week on (empty for automatic)
+	Lineups  map[int][]string `json:"lineups,omitempty"`  // Week -> IDs of the players started, locked when the week was played
+}
+
+// HasPlayer reports whether the player is on the team's roster
+func (t *Team) HasPlayer(playerID string) bool {
+	for _, player := range t.Roster {
+		if player.ID == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// divisionNames names divisions when a league has up to four of them
+var divisionNames = []string{"East", "West", "North", "South"}
+
+// AssignDivisions splits teams evenly into the given number of divisions,
+// dealing them out in order. Teams have no division when divisions is 0 or 1.
+func AssignDivisions(teams []*Team, divisions int) {
+	for i, team := range teams {
+		switch {
+		case divisions <= 1:
+			team.Division = ""
+		case divisions <= len(divisionNames):
+			team.Division = divisionNames[i%divisions]
+		default:
+			team.Division = fmt.Sprintf("Division %d", i%divisions+1)
+		}
+	}
+}
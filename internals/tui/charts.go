@@ -0,0 +1,40 @@
+package tui
+
+import "strings"
+
+// sparkBlocks are the bar heights used by sparklines, lowest first
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders values as a single line of bars scaled to the largest
+// value. Values at or below zero are drawn as the lowest bar.
+func sparkline(values []float64) string {
+	highest := 0.0
+	for _, value := range values {
+		highest = max(highest, value)
+	}
+
+	var b strings.Builder
+	for _, value := range values {
+		level := 0
+		if highest > 0 && value > 0 {
+			level = int(value / highest * float64(len(sparkBlocks)-1))
+		}
+		b.WriteRune(sparkBlocks[level])
+	}
+	return b.String()
+}
+
+// bar renders a horizontal bar of value scaled so that highest fills width
+func bar(value, highest float64, width int) string {
+	if highest <= 0 || value <= 0 {
+		return ""
+	}
+	// Eighth blocks give the bar sub-character resolution
+	eighths := int(value / highest * float64(width*8))
+	full, partial := eighths/8, eighths%8
+	out := strings.Repeat("█", full)
+	if partial > 0 {
+		out += string([]rune("▏▎▍▌▋▊▉")[partial-1])
+	}
+	return out
+}
@@ -0,0 +1,548 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+const (
+	pickTimeLimit = 90 * time.Second       // Time the user has to make a pick before auto-pick
+	botPickDelay  = 700 * time.Millisecond // Pause before each bot pick so the ticker is readable
+	tickerLength  = 8                      // Number of recent picks shown in the ticker
+)
+
+// botTeamNames are given to bot teams in draft order
+var botTeamNames = []string{
+	"Gridiron Gurus", "Pigskin Prophets", "End Zone Elite", "Blitz Brigade",
+	"Hail Mary Heroes", "Red Zone Raiders", "Fourth & Long", "Sunday Funday",
+	"Touchdown Titans", "Audible Alchemists", "Pocket Passers", "Two-Minute Drill",
+	"Goal Line Stand", "Play Action Pack", "Statue of Liberty", "Nickel Package",
+}
+
+// Draft room keys
+var (
+	draftPickKey = key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "draft player"))
+	autoPickKey  = key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "auto-pick"))
+	boardKey     = key.NewBinding(key.WithKeys("b"), key.WithHelp("b", "board"))
+	newDraftKey  = key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "new draft"))
+)
+
+// Messages used by the draft room
+type (
+	draftReadyMsg struct {
+		draft  *league.Draft
+		myTeam int
+		season int64
+		err    error
+	}
+	draftPickMsg struct {
+		pick league.DraftPick
+		err  error
+	}
+	draftTickMsg struct {
+		number int
+	}
+	draftSavedMsg struct {
+		err error
+	}
+)
+
+// draftScreen runs a snake draft against bot teams using last season's rankings
+type draftScreen struct {
+	session *session
+
+	draft  *league.Draft
+	myTeam int   // Index of the user's team in draft order
+	season int64 // Season the rankings come from
+
+	positions      []string // Position filter options
+	positionFilter int      // Index into positions, -1 for all
+	available      []*league.RankedPlayer
+	cursor         int
+	showBoard      bool
+
+	deadline time.Time // When the user's pick is auto-made
+	picking  bool      // A pick is being made in the background
+
+	loading bool
+	err     error
+}
+
+// openDraftScreen resumes the session's draft room or opens a new one
+func openDraftScreen(s *session) Screen {
+	if s.draftRoom == nil {
+		s.draftRoom = newDraftScreen(s)
+	}
+	return s.draftRoom
+}
+
+func newDraftScreen(s *session) *draftScreen {
+	return &draftScreen{session: s, positionFilter: -1, loading: true}
+}
+
+func (m *draftScreen) Init() tea.Cmd {
+	if m.draft == nil {
+		return m.setup
+	}
+	// Resuming: anything in flight was dropped while the screen was closed
+	m.picking = false
+	return m.next()
+}
+
+func (m *draftScreen) Title() string {
+	return "Draft"
+}
+
+func (m *draftScreen) Keys() []key.Binding {
+	if m.draft != nil && m.draft.Done() {
+		return []key.Binding{boardKey, newDraftKey}
+	}
+	return []key.Binding{upKey, downKey, positionKey, draftPickKey, autoPickKey, boardKey}
+}
+
+// setup ranks last season's players and creates the draft
+func (m *draftScreen) setup() tea.Msg {
+	s := m.session
+	seasons, err := s.db.GetSeasons(s.ctx)
+	if err != nil {
+		return draftReadyMsg{err: fmt.Errorf("error loading seasons: %w", err)}
+	}
+	if len(seasons) == 0 {
+		return draftReadyMsg{err: fmt.Errorf("no games have been scraped yet, so players can't be ranked")}
+	}
+
+	pool, err := s.scorer.Rankings(s.ctx, seasons[0])
+	if err != nil {
+		return draftReadyMsg{err: err}
+	}
+
+	myTeam := rand.IntN(s.rules.TeamCount)
+	teams := make([]*league.Team, s.rules.TeamCount)
+	bots := 0
+	for i := range teams {
+		teams[i] = &league.Team{ID: i + 1}
+		if i == myTeam {
+			teams[i].Name = "My Team"
+			continue
+		}
+		teams[i].Bot = true
+		teams[i].Name = fmt.Sprintf("Team %d", i+1)
+		if bots < len(botTeamNames) {
+			teams[i].Name = botTeamNames[bots]
+		}
+		bots++
+	}
+	league.AssignDivisions(teams, s.rules.Divisions)
+
+	injuries, err := league.LoadInjuries(s.ctx, s.db, seasons[0], 1)
+	if err != nil {
+		return draftReadyMsg{err: fmt.Errorf("error loading injuries: %w", err)}
+	}
+	draft, err := league.NewDraft(s.rules, teams, pool, injuries)
+	if err != nil {
+		return draftReadyMsg{err: fmt.Errorf("error creating draft: %w", err)}
+	}
+	return draftReadyMsg{draft: draft, myTeam: myTeam, season: seasons[0]}
+}
+
+// next schedules whatever happens for the pick on the clock: a bot pick
+// after a short pause, or the clock for the user's pick
+func (m *draftScreen) next() tea.Cmd {
+	pick, ok := m.draft.OnTheClock()
+	if !ok || m.picking {
+		return nil
+	}
+
+	if pick.Team == m.myTeam {
+		if m.deadline.IsZero() {
+			m.deadline = time.Now().Add(pickTimeLimit)
+		}
+		return m.tick(pick.Number)
+	}
+
+	m.picking = true
+	draft := m.draft
+	return tea.Tick(botPickDelay, func(time.Time) tea.Msg {
+		pick, err := draft.AutoPickAt(pick.Number)
+		return draftPickMsg{pick: pick, err: err}
+	})
+}
+
+// finish makes the drafted teams the session's fantasy season, saving them
+// in the background when the league has been saved
+func (m *draftScreen) finish() tea.Cmd {
+	s := m.session
+	season := &league.Season{LeagueID: s.league.ID, Year: m.season, Teams: m.draft.Teams()}
+	s.season = season
+	if season.LeagueID == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		return draftSavedMsg{err: league.SaveSeason(s.ctx, s.db, season)}
+	}
+}
+
+// tick refreshes the pick timer every second
+func (m *draftScreen) tick(number int) tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return draftTickMsg{number: number}
+	})
+}
+
+// autoPick makes the user's pick in the background
+func (m *draftScreen) autoPick(number int) tea.Cmd {
+	m.picking = true
+	draft := m.draft
+	return func() tea.Msg {
+		pick, err := draft.AutoPickAt(number)
+		return draftPickMsg{pick: pick, err: err}
+	}
+}
+
+// userPick drafts the selected player for the user in the background
+func (m *draftScreen) userPick(playerID string) tea.Cmd {
+	m.picking = true
+	draft := m.draft
+	return func() tea.Msg {
+		pick, err := draft.Pick(playerID)
+		return draftPickMsg{pick: pick, err: err}
+	}
+}
+
+func (m *draftScreen) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case draftReadyMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.draft, m.myTeam, m.season = msg.draft, msg.myTeam, msg.season
+		m.setPositions()
+		m.refreshAvailable()
+		return m, m.next()
+
+	case draftPickMsg:
+		if m.draft == nil {
+			return m, nil
+		}
+		m.picking = false
+		if errors.Is(msg.err, league.ErrNotOnTheClock) || errors.Is(msg.err, league.ErrDraftComplete) {
+			// A stale request from before the screen was reopened
+			return m, m.next()
+		}
+		if msg.err != nil {
+			m.err = msg.err
+			// The user can choose another player, but a bot retrying wouldn't help
+			if next, ok := m.draft.OnTheClock(); ok && next.Team == m.myTeam {
+				return m, m.next()
+			}
+			return m, nil
+		}
+		m.err = nil
+		if msg.pick.Team == m.myTeam {
+			m.deadline = time.Time{}
+		}
+		m.refreshAvailable()
+		if m.draft.Done() {
+			return m, m.finish()
+		}
+		return m, m.next()
+
+	case draftSavedMsg:
+		m.err = msg.err
+		return m, nil
+
+	case draftTickMsg:
+		if m.draft == nil {
+			return m, nil
+		}
+		pick, ok := m.draft.OnTheClock()
+		if !ok || pick.Number != msg.number || m.picking {
+			return m, nil
+		}
+		if time.Now().After(m.deadline) {
+			return m, m.autoPick(pick.Number)
+		}
+		return m, m.tick(msg.number)
+
+	case tea.KeyMsg:
+		if m.draft == nil {
+			return m, nil
+		}
+		return m.updateKeys(msg)
+	}
+
+	return m, nil
+}
+
+// updateKeys handles keys once the draft is ready
+func (m *draftScreen) updateKeys(msg tea.KeyMsg) (Screen, tea.Cmd) {
+	pick, onClock := m.draft.OnTheClock()
+	myPick := onClock && pick.Team == m.myTeam && !m.picking
+
+	switch {
+	case key.Matches(msg, boardKey):
+		m.showBoard = !m.showBoard
+	case key.Matches(msg, newDraftKey):
+		if !onClock {
+			fresh := newDraftScreen(m.session)
+			m.session.draftRoom = fresh
+			return fresh, fresh.Init()
+		}
+	case key.Matches(msg, upKey):
+		m.cursor = max(m.cursor-1, 0)
+	case key.Matches(msg, downKey):
+		m.cursor = max(min(m.cursor+1, len(m.available)-1), 0)
+	case key.Matches(msg, pageUpKey):
+		m.cursor = max(m.cursor-m.pageSize(), 0)
+	case key.Matches(msg, pageDnKey):
+		m.cursor = max(min(m.cursor+m.pageSize(), len(m.available)-1), 0)
+	case key.Matches(msg, positionKey):
+		m.positionFilter = cycleFilter(m.positionFilter, len(m.positions))
+		m.cursor = 0
+		m.refreshAvailable()
+	case key.Matches(msg, draftPickKey):
+		if myPick && m.cursor < len(m.available) {
+			return m, m.userPick(m.available[m.cursor].ID)
+		}
+	case key.Matches(msg, autoPickKey):
+		if myPick {
+			return m, m.autoPick(pick.Number)
+		}
+	}
+	return m, nil
+}
+
+// setPositions builds the position filter from the positions in the pool
+func (m *draftScreen) setPositions() {
+	present := make(map[string]bool)
+	for _, player := range m.draft.Available() {
+		present[player.Position] = true
+	}
+	m.positions = m.positions[:0]
+	for _, position := range fantasyPositionOrder {
+		if present[position] {
+			m.positions = append(m.positions, position)
+			delete(present, position)
+		}
+	}
+	for _, slot := range m.draft.Roster() {
+		for _, position := range slot.Positions {
+			if present[position] {
+				m.positions = append(m.positions, position)
+				delete(present, position)
+			}
+		}
+	}
+}
+
+// refreshAvailable reloads the best available players for the position filter
+func (m *draftScreen) refreshAvailable() {
+	m.available = m.available[:0]
+	for _, player := range m.draft.Available() {
+		if m.positionFilter < 0 || player.Position == m.positions[m.positionFilter] {
+			m.available = append(m.available, player)
+		}
+	}
+	m.cursor = max(min(m.cursor, len(m.available)-1), 0)
+}
+
+// pageSize returns how many available players fit on a page
+func (m *draftScreen) pageSize() int {
+	// Status line, blank line, title and table header
+	return max(m.session.bodyHeight()-4, 1)
+}
+
+func (m *draftScreen) View() string {
+	switch {
+	case m.loading:
+		return "Ranking players..."
+	case m.draft == nil:
+		return errorStyle.Render(m.err.Error())
+	}
+
+	var b strings.Builder
+	b.WriteString(m.statusLine())
+	b.WriteString("\n\n")
+
+	if m.showBoard {
+		b.WriteString(m.boardView())
+		return b.String()
+	}
+
+	available := m.availableView()
+	side := lipgloss.JoinVertical(lipgloss.Left, m.rosterView(), "", m.tickerView())
+	if m.session.width >= lipgloss.Width(available)+lipgloss.Width(side)+2 {
+		b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, available, "  ", side))
+	} else {
+		b.WriteString(available)
+	}
+	return b.String()
+}
+
+// statusLine shows the pick on the clock and the user's timer
+func (m *draftScreen) statusLine() string {
+	pick, ok := m.draft.OnTheClock()
+	if !ok {
+		return goodStyle.Render(fmt.Sprintf("Draft complete · %d picks · rankings from %d", m.draft.TotalPicks(), m.season))
+	}
+
+	team := m.draft.Teams()[pick.Team]
+	status := fmt.Sprintf("Round %d/%d · Pick %s · On the clock: %s", pick.Round, m.draft.Rounds(), m.pickLabel(pick.Number), team.Name)
+	if pick.Team == m.myTeam {
+		remaining := max(time.Until(m.deadline).Round(time.Second), 0)
+		clock := fmt.Sprintf(" · %d:%02d", int(remaining.Minutes()), int(remaining.Seconds())%60)
+		if remaining <= 10*time.Second {
+			clock = errorStyle.Render(clock)
+		}
+		status = selectedStyle.Render(status) + clock
+	} else {
+		status = subtleStyle.Render(status)
+	}
+	if m.err != nil {
+		status += "  " + errorStyle.Render(m.err.Error())
+	}
+	return status
+}
+
+// pickLabel formats an overall pick number as round.pick (e.g. 3.05)
+func (m *draftScreen) pickLabel(number int) string {
+	round, _ := m.draft.PickSlot(number)
+	inRound := number - (round-1)*len(m.draft.Teams())
+	return fmt.Sprintf("%d.%02d", round, inRound)
+}
+
+// availableView renders the best available players
+func (m *draftScreen) availableView() string {
+	var b strings.Builder
+
+	position := "All"
+	if m.positionFilter >= 0 {
+		position = m.positions[m.positionFilter]
+	}
+	b.WriteString(titleStyle.Render("Best available"))
+	b.WriteString(subtleStyle.Render(fmt.Sprintf("  position: %s · %d fantasy points", position, m.season)))
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("%4s  %-24s %-6s %-4s %7s %6s\n", "Rk", "Name", "Pos", "Team", "FPts", "FP/G"))
+
+	pageSize := m.pageSize()
+	start := (m.cursor / pageSize) * pageSize
+	end := min(start+pageSize, len(m.available))
+	for i := start; i < end; i++ {
+		player := m.available[i]
+		// Team colors only go on plain rows so they don't break up a highlight
+		highlighted := i == m.cursor || !m.draft.CanDraft(m.myTeam, player)
+		team := fmt.Sprintf("%-4s", player.NFLTeam)
+		if !highlighted {
+			team = m.session.teamBadge(player.NFLTeam, 4)
+		}
+		line := fmt.Sprintf("%4d  %-24s %-6s %s %7.1f %6.1f", player.Rank, truncate(player.Name, 24),
+			fmt.Sprintf("%s%d", player.Position, player.PositionRank), team, player.Points, player.PointsPerGame)
+		switch {
+		case i == m.cursor:
+			line = selectedStyle.Render(line)
+		case !m.draft.CanDraft(m.myTeam, player):
+			line = subtleStyle.Render(line)
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// rosterView renders the user's roster with open slots
+func (m *draftScreen) rosterView() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(m.draft.Teams()[m.myTeam].Name))
+	b.WriteString("\n")
+	for _, spot := range m.draft.Lineup(m.myTeam) {
+		if spot.Player == nil {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf("%-5s —", spot.Slot.Slot)))
+		} else {
+			b.WriteString(fmt.Sprintf("%-5s %-22s %s", spot.Slot.Slot, truncate(spot.Player.Name, 22), spot.Player.Position))
+		}
+		b.WriteString("\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// tickerView renders the most recent picks, newest first
+func (m *draftScreen) tickerView() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Recent picks"))
+	b.WriteString("\n")
+
+	picks := m.draft.Picks()
+	if len(picks) == 0 {
+		b.WriteString(subtleStyle.Render("No picks yet"))
+	}
+	for i := len(picks) - 1; i >= max(len(picks)-tickerLength, 0); i-- {
+		pick := picks[i]
+		line := fmt.Sprintf("%s %-16s %s (%s, %s)", m.pickLabel(pick.Number), truncate(m.draft.Teams()[pick.Team].Name, 16),
+			truncate(pick.Player.Name, 20), pick.Player.Position, pick.Player.NFLTeam)
+		if pick.Team == m.myTeam {
+			line = selectedStyle.Render(line)
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// boardView renders every pick in a grid of rounds by teams
+func (m *draftScreen) boardView() string {
+	teams := m.draft.Teams()
+	cellWidth := max((m.session.width-4)/len(teams)-1, 4)
+
+	var b strings.Builder
+	b.WriteString("    ")
+	for i, team := range teams {
+		name := fmt.Sprintf("%-*s", cellWidth, truncate(team.Name, cellWidth))
+		if i == m.myTeam {
+			name = selectedStyle.Render(name)
+		} else {
+			name = titleStyle.Render(name)
+		}
+		b.WriteString(name + " ")
+	}
+	b.WriteString("\n")
+
+	picks := m.draft.Picks()
+	next, _ := m.draft.OnTheClock()
+	rows := min(m.draft.Rounds(), m.session.bodyHeight()-3)
+	for round := 1; round <= rows; round++ {
+		cells := make([]string, len(teams))
+		for i := range cells {
+			cells[i] = subtleStyle.Render(fmt.Sprintf("%-*s", cellWidth, "·"))
+		}
+		for i := range teams {
+			number := (round-1)*len(teams) + i + 1
+			_, team := m.draft.PickSlot(number)
+			switch {
+			case number <= len(picks):
+				player := picks[number-1].Player
+				cell := fmt.Sprintf("%-*s", cellWidth, truncate(player.Position+" "+player.Name, cellWidth))
+				if team == m.myTeam {
+					cell = selectedStyle.Render(cell)
+				}
+				cells[team] = cell
+			case number == next.Number:
+				cells[team] = goodStyle.Render(fmt.Sprintf("%-*s", cellWidth, "on the clock"))
+			}
+		}
+		b.WriteString(fmt.Sprintf("%3d ", round))
+		b.WriteString(strings.Join(cells, " "))
+		b.WriteString("\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -0,0 +1,30 @@
+package tui
+
+import "github.com/charmbracelet/bubbles/key"
+
+// globalKeyMap holds the keybindings available on every screen
+type globalKeyMap struct {
+	Help key.Binding
+	Back key.Binding
+	Quit key.Binding
+}
+
+var globalKeys = globalKeyMap{
+	Help: key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "help")),
+	Back: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Quit: key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q", "quit")),
+}
+
+// bindings returns the global keys in help display order
+func (k globalKeyMap) bindings() []key.Binding {
+	return []key.Binding{k.Help, k.Back, k.Quit}
+}
+
+// Keys shared by list-style screens
+var (
+	upKey     = key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up"))
+	downKey   = key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down"))
+	enterKey  = key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select"))
+	pageUpKey = key.NewBinding(key.WithKeys("pgup", "ctrl+u"), key.WithHelp("pgup", "page up"))
+	pageDnKey = key.NewBinding(key.WithKeys("pgdown", "ctrl+d"), key.WithHelp("pgdn", "page down"))
+)
@@ -0,0 +1,182 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// League screen keys
+var (
+	newLeagueKey    = key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "new league"))
+	editLeagueKey   = key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "edit league"))
+	leagueListKey   = key.NewBinding(key.WithKeys("l"), key.WithHelp("l", "switch league"))
+	selectLeagueKey = key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open league"))
+)
+
+// leagueScreen shows the current league's rules
+type leagueScreen struct {
+	session  *session
+	viewport viewport.Model
+}
+
+func newLeagueScreen(s *session) Screen {
+	m := &leagueScreen{session: s, viewport: viewport.New(s.width, s.bodyHeight()-3)}
+	m.refresh()
+	return m
+}
+
+func (m *leagueScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (m *leagueScreen) Title() string {
+	return "League"
+}
+
+func (m *leagueScreen) Keys() []key.Binding {
+	return []key.Binding{newLeagueKey, editLeagueKey, leagueListKey, upKey, downKey, pageUpKey, pageDnKey}
+}
+
+// refresh shows the session's league rules
+func (m *leagueScreen) refresh() {
+	m.viewport.SetContent(m.session.rules.PrintScoringRules())
+	m.viewport.GotoTop()
+}
+
+func (m *leagueScreen) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.viewport.Width = msg.Width
+		m.viewport.Height = m.session.bodyHeight() - 3
+		return m, nil
+
+	case leagueChangedMsg:
+		m.refresh()
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, newLeagueKey):
+			return m, navigate(newLeagueWizard(m.session, nil))
+		case key.Matches(msg, editLeagueKey):
+			return m, navigate(newLeagueWizard(m.session, m.session.league))
+		case key.Matches(msg, leagueListKey):
+			return m, navigate(newLeagueListScreen(m.session))
+		}
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+func (m *leagueScreen) View() string {
+	status := "unsaved, press e to edit and save it"
+	if m.session.league.ID != 0 {
+		status = "saved"
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(m.session.rules.Name))
+	b.WriteString(subtleStyle.Render(fmt.Sprintf("  (%s)", status)))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render(m.session.rules.Description))
+	b.WriteString("\n\n")
+	b.WriteString(m.viewport.View())
+	return b.String()
+}
+
+// Messages loaded by the league list
+type leagueListMsg struct {
+	leagues []*league.League
+	err     error
+}
+
+// leagueListScreen lists saved leagues so the user can switch between them
+type leagueListScreen struct {
+	session *session
+	leagues []*league.League
+	cursor  int
+	loading bool
+	err     error
+}
+
+func newLeagueListScreen(s *session) Screen {
+	return &leagueListScreen{session: s, loading: true}
+}
+
+func (m *leagueListScreen) Init() tea.Cmd {
+	s := m.session
+	return func() tea.Msg {
+		leagues, err := league.ListLeagues(s.ctx, s.db)
+		return leagueListMsg{leagues: leagues, err: err}
+	}
+}
+
+func (m *leagueListScreen) Title() string {
+	return "Leagues"
+}
+
+func (m *leagueListScreen) Keys() []key.Binding {
+	return []key.Binding{upKey, downKey, selectLeagueKey}
+}
+
+func (m *leagueListScreen) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case leagueListMsg:
+		m.loading = false
+		m.leagues, m.err = msg.leagues, msg.err
+		for i, l := range m.leagues {
+			if l.ID == m.session.league.ID {
+				m.cursor = i
+			}
+		}
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, upKey):
+			m.cursor = max(m.cursor-1, 0)
+		case key.Matches(msg, downKey):
+			m.cursor = max(min(m.cursor+1, len(m.leagues)-1), 0)
+		case key.Matches(msg, selectLeagueKey):
+			if m.cursor < len(m.leagues) {
+				m.session.setLeague(m.leagues[m.cursor])
+				return m, tea.Batch(goBack, leagueChanged)
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m *leagueListScreen) View() string {
+	switch {
+	case m.loading:
+		return "Loading leagues..."
+	case m.err != nil:
+		return errorStyle.Render(m.err.Error())
+	case len(m.leagues) == 0:
+		return subtleStyle.Render("No leagues have been saved yet. Press esc and then n to create one.")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Saved leagues"))
+	b.WriteString("\n\n")
+	for i, l := range m.leagues {
+		line := fmt.Sprintf("%-30s %2d teams  %s", truncate(l.Rules.Name, 30), l.Rules.TeamCount, truncate(l.Rules.Description, 50))
+		marker := "  "
+		if l.ID == m.session.league.ID {
+			marker = "* "
+		}
+		if i == m.cursor {
+			line = selectedStyle.Render(line)
+		}
+		b.WriteString(marker + line + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,600 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// Wizard steps in order
+const (
+	stepBasics = iota
+	stepRoster
+	stepScoring
+	stepPlayoffs
+	stepReview
+)
+
+var wizardStepNames = []string{"Basics", "Roster", "Scoring", "Playoffs", "Review"}
+
+// receptionFormats are the PPR options, in the order ←/→ cycles through them
+var receptionFormats = []string{"Standard", "Half PPR", "Full PPR"}
+
+// Wizard keys
+var (
+	nextStepKey = key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next step"))
+	prevStepKey = key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "previous step"))
+	adjustKey   = key.NewBinding(key.WithKeys("left", "right", "h", "l", "-", "+"), key.WithHelp("←/→", "change"))
+	decreaseKey = key.NewBinding(key.WithKeys("left", "h", "-"))
+	editKey     = key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "edit"))
+	saveKey     = key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "save league"))
+)
+
+// wizardField is a setting shown on a wizard step
+type wizardField struct {
+	label  string
+	value  string
+	adjust func(delta int) error   // Called for ←/→, nil if the field can't be stepped
+	edit   func(text string) error // Called with typed text, nil if the field can't be typed
+	text   string                  // Initial text when editing starts
+}
+
+// wizardSavedMsg reports the result of saving the league
+type wizardSavedMsg struct {
+	league *league.League
+	err    error
+}
+
+// leagueWizard edits a copy of a league's rules step by step and saves them
+type leagueWizard struct {
+	session  *session
+	leagueID int64 // 0 for a new league
+	rules    *league.LeagueRules
+	preset   int // Index into league.PresetNames for new leagues
+
+	step   int
+	cursor int
+	offset int // First visible field when a step doesn't fit
+
+	input   textinput.Model
+	editing bool
+	preview viewport.Model
+
+	saving bool
+	err    error
+}
+
+// newLeagueWizard opens the wizard for an existing league, or for a new
+// league starting from the standard preset when l is nil
+func newLeagueWizard(s *session, l *league.League) Screen {
+	m := &leagueWizard{
+		session: s,
+		input:   textinput.New(),
+		preview: viewport.New(s.width, s.bodyHeight()-6),
+	}
+	m.input.CharLimit = 60
+
+	if l != nil {
+		m.leagueID = l.ID
+		rules, err := l.Rules.CloneRules()
+		if err != nil {
+			m.err = err
+			rules = league.DefaultRules()
+		}
+		m.rules = rules
+	} else {
+		names := league.PresetNames()
+		for i, name := range names {
+			if name == "standard" {
+				m.preset = i
+			}
+		}
+		m.rules, _ = league.PresetRules(names[m.preset])
+	}
+	return m
+}
+
+func (m *leagueWizard) Init() tea.Cmd {
+	return nil
+}
+
+func (m *leagueWizard) Title() string {
+	if m.leagueID == 0 {
+		return "New League"
+	}
+	return "Edit League"
+}
+
+func (m *leagueWizard) Keys() []key.Binding {
+	if m.step == stepReview {
+		return []key.Binding{prevStepKey, upKey, downKey, saveKey}
+	}
+	return []key.Binding{nextStepKey, prevStepKey, upKey, downKey, adjustKey, editKey}
+}
+
+// CapturingInput reports whether a value is being typed
+func (m *leagueWizard) CapturingInput() bool {
+	return m.editing
+}
+
+// fields returns the settings on the current step
+func (m *leagueWizard) fields() []wizardField {
+	switch m.step {
+	case stepBasics:
+		return m.basicsFields()
+	case stepRoster:
+		return m.rosterFields()
+	case stepScoring:
+		return m.scoringFields()
+	case stepPlayoffs:
+		return m.playoffFields()
+	}
+	return nil
+}
+
+func (m *leagueWizard) basicsFields() []wizardField {
+	var fields []wizardField
+	r := m.rules
+
+	if m.leagueID == 0 {
+		names := league.PresetNames()
+		fields = append(fields, wizardField{
+			label: "Preset",
+			value: names[m.preset],
+			adjust: func(delta int) error {
+				m.preset = (m.preset + delta + len(names)) % len(names)
+				rules, err := league.PresetRules(names[m.preset])
+				if err != nil {
+					return err
+				}
+				m.rules = rules
+				return nil
+			},
+		})
+	}
+
+	fields = append(fields,
+		wizardField{
+			label: "Name",
+			value: r.Name,
+			text:  r.Name,
+			edit: func(text string) error {
+				if strings.TrimSpace(text) == "" {
+					return fmt.Errorf("league must have a name")
+				}
+				r.Name = strings.TrimSpace(text)
+				return nil
+			},
+		},
+		wizardField{
+			label: "Description",
+			value: r.Description,
+			text:  r.Description,
+			edit: func(text string) error {
+				r.Description = strings.TrimSpace(text)
+				return nil
+			},
+		},
+		wizardField{
+			label: "Teams",
+			value: strconv.Itoa(r.TeamCount),
+			adjust: func(delta int) error {
+				r.TeamCount = min(max(r.TeamCount+delta, 2), 32)
+				return nil
+			},
+			text: strconv.Itoa(r.TeamCount),
+			edit: func(text string) error {
+				count, err := strconv.Atoi(strings.TrimSpace(text))
+				if err != nil {
+					return fmt.Errorf("invalid team count: %s", text)
+				}
+				r.TeamCount = count
+				return nil
+			},
+		},
+	)
+	return fields
+}
+
+func (m *leagueWizard) rosterFields() []wizardField {
+	r := m.rules
+
+	// Every built-in slot, then any custom slots the league defines
+	names := league.SlotNames()
+	for _, slot := range r.RosterPositions {
+		if _, ok := league.SlotDefinition(slot.Slot); !ok {
+			names = append(names, slot.Slot)
+		}
+	}
+
+	fields := make([]wizardField, 0, len(names))
+	for _, name := range names {
+		slot, ok := r.RosterPositions.Slot(name)
+		if !ok {
+			slot, _ = league.SlotDefinition(name)
+		}
+		eligible := "any position"
+		if len(slot.Positions) > 0 {
+			eligible = strings.Join(slot.Positions, "/")
+		}
+
+		fields = append(fields, wizardField{
+			label: fmt.Sprintf("%-9s %s", slot.Slot, subtleStyle.Render(truncate(eligible, 30))),
+			value: strconv.Itoa(slot.Count),
+			adjust: func(delta int) error {
+				return m.setSlotCount(slot, max(slot.Count+delta, 0))
+			},
+			text: strconv.Itoa(slot.Count),
+			edit: func(text string) error {
+				count, err := strconv.Atoi(strings.TrimSpace(text))
+				if err != nil {
+					return fmt.Errorf("invalid count: %s", text)
+				}
+				return m.setSlotCount(slot, count)
+			},
+		})
+	}
+	return fields
+}
+
+// setSlotCount changes a roster slot's count, keeping custom slot definitions
+func (m *leagueWizard) setSlotCount(slot league.RosterSlot, count int) error {
+	if _, ok := league.SlotDefinition(slot.Slot); ok {
+		return m.rules.SetPositionCount(slot.Slot, count)
+	}
+	slot.Count = count
+	return m.rules.SetRosterSlot(slot)
+}
+
+func (m *leagueWizard) scoringFields() []wizardField {
+	r := m.rules
+	fields := []wizardField{{
+		label: "Receptions",
+		value: receptionFormats[receptionFormat(r)],
+		adjust: func(delta int) error {
+			switch (receptionFormat(r) + delta + len(receptionFormats)) % len(receptionFormats) {
+			case 0:
+				r.DisablePPR()
+			case 1:
+				r.HalfPPR()
+			default:
+				r.EnablePPR()
+			}
+			return nil
+		},
+	}}
+
+	for _, category := range r.GetScoringCategories() {
+		statTypes, _ := r.GetStatTypesForCategory(category)
+		for _, statType := range statTypes {
+			rule := r.ScoringRules[category][statType]
+			label := category + " / " + statType
+
+			if rule.Type == league.RangeBased {
+				rangeKeys := make([]string, 0, len(rule.Ranges))
+				for rangeKey := range rule.Ranges {
+					rangeKeys = append(rangeKeys, rangeKey)
+				}
+				sort.Slice(rangeKeys, func(i, j int) bool {
+					return rangeStart(rangeKeys[i]) < rangeStart(rangeKeys[j])
+				})
+				for _, rangeKey := range rangeKeys {
+					fields = append(fields, m.pointsField(fmt.Sprintf("%s (%s yd)", label, rangeKey), rule.Ranges[rangeKey], "",
+						func(value float64) error {
+							return r.UpdateRangePointValue(category, statType, rangeKey, value)
+						}))
+				}
+				continue
+			}
+
+			unit := "each"
+			switch {
+			case rule.Type == league.PerUnit:
+				unit = "per unit"
+			case rule.Type == league.Bonus && rule.PerPlay:
+				unit = fmt.Sprintf("per %s play of %g+ yards", rule.Stat, rule.Threshold)
+			case rule.Type == league.Bonus:
+				unit = fmt.Sprintf("at %g+ %s", rule.Threshold, rule.Stat)
+			}
+			fields = append(fields, m.pointsField(label, rule.Value, unit, func(value float64) error {
+				return r.UpdatePointValue(category, statType, value)
+			}))
+		}
+	}
+	return fields
+}
+
+// pointsField builds a field for a point value, stepped by an amount that
+// suits its size (0.01 for per-yard values, 0.5 otherwise)
+func (m *leagueWizard) pointsField(label string, value float64, unit string, update func(float64) error) wizardField {
+	step := 0.5
+	if math.Abs(value) > 0 && math.Abs(value) < 0.5 {
+		step = 0.01
+	}
+	display := strconv.FormatFloat(value, 'f', -1, 64)
+	if unit != "" {
+		display += " " + subtleStyle.Render(unit)
+	}
+
+	return wizardField{
+		label: label,
+		value: display,
+		adjust: func(delta int) error {
+			return update(math.Round((value+float64(delta)*step)*10000) / 10000)
+		},
+		text: strconv.FormatFloat(value, 'f', -1, 64),
+		edit: func(text string) error {
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+			if err != nil {
+				return fmt.Errorf("invalid point value: %s", text)
+			}
+			return update(parsed)
+		},
+	}
+}
+
+// receptionFormat returns the index in receptionFormats of the rules' PPR setting
+func receptionFormat(r *league.LeagueRules) int {
+	switch value := r.ScoringRules["receiving"]["receptions"].Value; {
+	case !r.PPR || value == 0:
+		return 0
+	case value == 0.5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// rangeStart returns the first yard of a range key such as "40-49" or "50+"
+func rangeStart(rangeKey string) int {
+	start, _ := strconv.Atoi(strings.TrimRight(strings.SplitN(rangeKey, "-", 2)[0], "+"))
+	return start
+}
+
+func (m *leagueWizard) playoffFields() []wizardField {
+	r := m.rules
+	return []wizardField{
+		{
+			label: "Playoff teams",
+			value: strconv.Itoa(r.PlayoffTeams),
+			adjust: func(delta int) error {
+				r.PlayoffTeams = min(max(r.PlayoffTeams+delta, 2), r.TeamCount)
+				return nil
+			},
+		},
+		{
+			label: "Playoffs start in week",
+			value: strconv.Itoa(r.PlayoffWeekStart),
+			adjust: func(delta int) error {
+				r.PlayoffWeekStart = min(max(r.PlayoffWeekStart+delta, 10), 17)
+				return nil
+			},
+		},
+		{
+			label: "Divisions",
+			value: divisionsText(r.Divisions),
+			adjust: func(delta int) error {
+				r.Divisions = min(max(r.Divisions+delta, 0), r.TeamCount/2)
+				return nil
+			},
+		},
+	}
+}
+
+// divisionsText describes a division count
+func divisionsText(divisions int) string {
+	if divisions == 0 {
+		return "none"
+	}
+	return strconv.Itoa(divisions)
+}
+
+// setStep moves to a wizard step, refreshing the preview for the review step
+func (m *leagueWizard) setStep(step int) {
+	m.step = min(max(step, 0), len(wizardStepNames)-1)
+	m.cursor, m.offset = 0, 0
+	m.err = nil
+	if m.step == stepReview {
+		m.preview.SetContent(m.rules.PrintScoringRules())
+		m.preview.GotoTop()
+	}
+}
+
+// save validates and saves the league in the background
+func (m *leagueWizard) save() tea.Cmd {
+	if err := m.rules.ValidateRules(); err != nil {
+		m.err = err
+		return nil
+	}
+
+	m.saving = true
+	s := m.session
+	l := &league.League{ID: m.leagueID, Rules: m.rules}
+	return func() tea.Msg {
+		err := league.SaveLeague(s.ctx, s.db, l)
+		return wizardSavedMsg{league: l, err: err}
+	}
+}
+
+func (m *leagueWizard) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.preview.Width = msg.Width
+		m.preview.Height = m.session.bodyHeight() - 6
+		return m, nil
+
+	case wizardSavedMsg:
+		m.saving = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.session.setLeague(msg.league)
+		return m, tea.Batch(goBack, leagueChanged)
+
+	case tea.KeyMsg:
+		if m.editing {
+			return m.updateEditing(msg)
+		}
+		if m.saving {
+			return m, nil
+		}
+
+		switch {
+		case key.Matches(msg, nextStepKey):
+			m.setStep(m.step + 1)
+			return m, nil
+		case key.Matches(msg, prevStepKey):
+			m.setStep(m.step - 1)
+			return m, nil
+		}
+
+		if m.step == stepReview {
+			if key.Matches(msg, saveKey) {
+				return m, m.save()
+			}
+			var cmd tea.Cmd
+			m.preview, cmd = m.preview.Update(msg)
+			return m, cmd
+		}
+		return m.updateField(msg)
+	}
+
+	return m, nil
+}
+
+// updateField handles keys for the selected field
+func (m *leagueWizard) updateField(msg tea.KeyMsg) (Screen, tea.Cmd) {
+	fields := m.fields()
+	if len(fields) == 0 {
+		return m, nil
+	}
+	field := fields[m.cursor]
+
+	switch {
+	case key.Matches(msg, upKey):
+		m.cursor = max(m.cursor-1, 0)
+	case key.Matches(msg, downKey):
+		m.cursor = min(m.cursor+1, len(fields)-1)
+	case key.Matches(msg, adjustKey):
+		if field.adjust != nil {
+			delta := 1
+			if key.Matches(msg, decreaseKey) {
+				delta = -1
+			}
+			m.err = field.adjust(delta)
+		}
+	case key.Matches(msg, editKey):
+		if field.edit != nil {
+			m.editing = true
+			m.input.Prompt = field.label + ": "
+			m.input.SetValue(field.text)
+			m.input.CursorEnd()
+			return m, m.input.Focus()
+		}
+	}
+	return m, nil
+}
+
+// updateEditing handles keys while a value is being typed
+func (m *leagueWizard) updateEditing(msg tea.KeyMsg) (Screen, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.editing = false
+		m.input.Blur()
+		return m, nil
+	case tea.KeyEnter:
+		m.editing = false
+		m.input.Blur()
+		if field := m.fields()[m.cursor]; field.edit != nil {
+			m.err = field.edit(m.input.Value())
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m *leagueWizard) View() string {
+	var b strings.Builder
+
+	// Step breadcrumb
+	steps := make([]string, len(wizardStepNames))
+	for i, name := range wizardStepNames {
+		if i == m.step {
+			steps[i] = selectedStyle.Render(fmt.Sprintf("%d. %s", i+1, name))
+		} else {
+			steps[i] = subtleStyle.Render(fmt.Sprintf("%d. %s", i+1, name))
+		}
+	}
+	b.WriteString(strings.Join(steps, subtleStyle.Render(" › ")))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render(fmt.Sprintf("%s · %d teams · %d roster spots, %d starters",
+		m.rules.Name, m.rules.TeamCount, m.rules.TotalRosterSize(), m.rules.TotalStartingPlayers())))
+	b.WriteString("\n")
+
+	switch {
+	case m.err != nil:
+		b.WriteString(errorStyle.Render(m.err.Error()))
+	case m.saving:
+		b.WriteString("Saving...")
+	}
+	b.WriteString("\n\n")
+
+	if m.step == stepReview {
+		if err := m.rules.ValidateRules(); err != nil {
+			b.WriteString(errorStyle.Render("Fix before saving: " + err.Error()))
+		} else {
+			b.WriteString(goodStyle.Render("Rules are valid. Press enter to save the league."))
+		}
+		b.WriteString("\n\n")
+		b.WriteString(m.preview.View())
+		return b.String()
+	}
+
+	fields := m.fields()
+	labelWidth := 0
+	for _, field := range fields {
+		labelWidth = max(labelWidth, lipgloss.Width(field.label))
+	}
+
+	// Keep the cursor in view on long steps
+	visible := max(m.session.bodyHeight()-6, 1)
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	} else if m.cursor >= m.offset+visible {
+		m.offset = m.cursor - visible + 1
+	}
+
+	end := min(m.offset+visible, len(fields))
+	for i := m.offset; i < end; i++ {
+		field := fields[i]
+		padding := strings.Repeat(" ", labelWidth-lipgloss.Width(field.label))
+		value := field.value
+		if m.editing && i == m.cursor {
+			value = m.input.View()
+		}
+
+		prefix := "  "
+		if i == m.cursor {
+			prefix = selectedStyle.Render("> ")
+			if field.adjust != nil && !m.editing {
+				value = selectedStyle.Render("‹ ") + value + selectedStyle.Render(" ›")
+			}
+		}
+		b.WriteString(prefix + field.label + padding + "  " + value + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,392 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// Screen is a view the app can route to. Screens are stacked: opening a
+// screen pushes it and going back pops it.
+type Screen interface {
+	Init() tea.Cmd
+	Update(msg tea.Msg) (Screen, tea.Cmd)
+	View() string
+	Title() string
+	Keys() []key.Binding // Screen-specific keys shown in the footer and help overlay
+}
+
+// inputScreen is implemented by screens with a focused text input, which
+// receive every key except ctrl+c instead of the global keybindings
+type inputScreen interface {
+	CapturingInput() bool
+}
+
+// session holds the state shared by every screen
+type session struct {
+	ctx    context.Context
+	db     *data.DB
+	league *league.League      // League being viewed (ID 0 until saved)
+	rules  *league.LeagueRules // Rules of the league being viewed
+	season *league.Season      // League's latest drafted season, nil before a draft
+	scorer *league.Scorer
+	width  int
+	height int
+
+	settings   *userSettings
+	theme      *colorTheme
+	teamColors map[string]teamColor // NFL team abbreviation -> colors
+
+	draftRoom *draftScreen // Kept so leaving the draft room doesn't lose the draft
+}
+
+// bodyHeight returns the rows available to a screen between the header and footer
+func (s *session) bodyHeight() int {
+	return max(s.height-2, 1)
+}
+
+// setRules switches the league rules used for fantasy points across screens
+func (s *session) setRules(rules *league.LeagueRules) {
+	s.rules = rules
+	s.scorer = league.NewScorer(s.db, rules)
+}
+
+// setLeague switches the league viewed across screens and loads its latest
+// season. Any draft in progress was for the previous rules, so it is dropped.
+func (s *session) setLeague(l *league.League) {
+	sameLeague := s.league != nil && s.league.ID == l.ID && l.ID != 0
+	s.league = l
+	s.setRules(l.Rules)
+	s.draftRoom = nil
+	if sameLeague {
+		return
+	}
+
+	s.season = nil
+	if l.ID == 0 {
+		return
+	}
+
+	seasons, err := league.LoadSeasons(s.ctx, s.db, l.ID)
+	if err != nil {
+		log.Printf("Error loading seasons for %s: %v", l.Rules.Name, err)
+	} else if len(seasons) > 0 {
+		s.season = seasons[0]
+	}
+}
+
+// setTheme switches the theme used by every screen. NO_COLOR always wins.
+func (s *session) setTheme(theme *colorTheme) {
+	if noColor() {
+		theme = noColorTheme
+	}
+	s.theme = theme
+	applyTheme(theme)
+}
+
+// loadTeamColors loads the NFL team colors used for team badges
+func (s *session) loadTeamColors() error {
+	teams, err := s.db.GetAllNFLTeams(s.ctx)
+	if err != nil {
+		return err
+	}
+	s.teamColors = make(map[string]teamColor, len(teams))
+	for _, team := range teams {
+		s.teamColors[team.Abbreviation] = teamColor{primary: team.PrimaryColor.String, secondary: team.SecondaryColor.String}
+	}
+	return nil
+}
+
+// leagueChangedMsg tells open screens that the session's league changed
+type leagueChangedMsg struct{}
+
+// leagueChanged is a command that notifies open screens of a league change
+func leagueChanged() tea.Msg {
+	return leagueChangedMsg{}
+}
+
+// navigateMsg opens a screen on top of the current one
+type navigateMsg struct {
+	screen Screen
+}
+
+// backMsg closes the current screen
+type backMsg struct{}
+
+// navigate returns a command that opens a screen
+func navigate(screen Screen) tea.Cmd {
+	return func() tea.Msg {
+		return navigateMsg{screen: screen}
+	}
+}
+
+// goBack is a command that closes the current screen
+func goBack() tea.Msg {
+	return backMsg{}
+}
+
+// App is the root Bubble Tea model. It routes messages to the screen stack
+// and handles global keys, the help overlay and terminal resizes.
+type App struct {
+	session  *session
+	stack    []Screen
+	help     help.Model
+	showHelp bool
+}
+
+// NewApp creates the TUI model on top of an open database
+func NewApp(ctx context.Context, db *data.DB) *App {
+	s := &session{ctx: ctx, db: db}
+
+	settings, err := loadSettings()
+	if err != nil {
+		log.Printf("Error loading settings: %v", err)
+	}
+	s.settings = settings
+	s.setTheme(findTheme(settings.Theme))
+	if err := s.loadTeamColors(); err != nil {
+		log.Printf("Error loading team colors: %v", err)
+	}
+
+	s.setLeague(&league.League{Rules: league.DefaultRules()})
+
+	// Open the most recently saved league
+	leagues, err := league.ListLeagues(ctx, db)
+	if err != nil {
+		log.Printf("Error loading saved leagues: %v", err)
+	} else if len(leagues) > 0 {
+		s.setLeague(leagues[0])
+	}
+
+	app := &App{
+		session: s,
+		stack:   []Screen{newMenuScreen(s)},
+		help:    help.New(),
+	}
+	if s.theme.NoColor {
+		app.help.Styles = plainHelpStyles()
+	}
+	return app
+}
+
+// Run starts the TUI and blocks until the user quits or ctx is cancelled.
+// The caller owns db and closes it once Run returns.
+func Run(ctx context.Context, db *data.DB) error {
+	// Log output would corrupt the alternate screen, so send it to a file
+	// when debugging and discard it otherwise
+	if os.Getenv("GRIDIRONGO_DEBUG") != "" {
+		logFile, err := tea.LogToFile("gridirongo-debug.log", "tui")
+		if err != nil {
+			return fmt.Errorf("error opening debug log: %w", err)
+		}
+		defer logFile.Close()
+	} else {
+		log.SetOutput(io.Discard)
+	}
+	defer log.SetOutput(os.Stderr)
+
+	program := tea.NewProgram(NewApp(ctx, db), tea.WithAltScreen(), tea.WithContext(ctx))
+	if _, err := program.Run(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("error running TUI: %w", err)
+	}
+	return nil
+}
+
+// current returns the screen on top of the stack
+func (a *App) current() Screen {
+	return a.stack[len(a.stack)-1]
+}
+
+// Init implements tea.Model
+func (a *App) Init() tea.Cmd {
+	return a.current().Init()
+}
+
+// Update implements tea.Model
+func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		a.session.width = msg.Width
+		a.session.height = msg.Height
+		a.help.Width = msg.Width
+		return a, a.broadcast(msg)
+
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return a, tea.Quit
+		}
+
+		// Any key closes the help overlay
+		if a.showHelp {
+			a.showHelp = false
+			return a, nil
+		}
+
+		if input, ok := a.current().(inputScreen); !ok || !input.CapturingInput() {
+			switch {
+			case key.Matches(msg, globalKeys.Quit):
+				return a, tea.Quit
+			case key.Matches(msg, globalKeys.Help):
+				a.showHelp = true
+				return a, nil
+			case key.Matches(msg, globalKeys.Back):
+				if len(a.stack) > 1 {
+					a.stack = a.stack[:len(a.stack)-1]
+				}
+				return a, nil
+			}
+		}
+
+		// Keys only go to the visible screen
+		screen, cmd := a.current().Update(msg)
+		a.stack[len(a.stack)-1] = screen
+		return a, cmd
+
+	case navigateMsg:
+		a.stack = append(a.stack, msg.screen)
+		return a, msg.screen.Init()
+
+	case backMsg:
+		if len(a.stack) > 1 {
+			a.stack = a.stack[:len(a.stack)-1]
+		}
+		return a, nil
+	}
+
+	// Everything else (loaded data, ticks) goes to every open screen so
+	// results still arrive after the user navigates away and back
+	return a, a.broadcast(msg)
+}
+
+// broadcast sends a message to every screen in the stack
+func (a *App) broadcast(msg tea.Msg) tea.Cmd {
+	cmds := make([]tea.Cmd, 0, len(a.stack))
+	for i, screen := range a.stack {
+		updated, cmd := screen.Update(msg)
+		a.stack[i] = updated
+		cmds = append(cmds, cmd)
+	}
+	return tea.Batch(cmds...)
+}
+
+// View implements tea.Model
+func (a *App) View() string {
+	if a.session.width == 0 {
+		return "Loading..."
+	}
+
+	// Header with a breadcrumb of open screens
+	titles := make([]string, len(a.stack))
+	for i, screen := range a.stack {
+		titles[i] = screen.Title()
+	}
+	header := headerStyle.Width(a.session.width).Render(strings.Join(titles, " › "))
+
+	// Footer with the short help for the current screen
+	bindings := append(append([]key.Binding{}, a.current().Keys()...), globalKeys.bindings()...)
+	footer := a.help.ShortHelpView(bindings)
+
+	body := a.current().View()
+	if a.showHelp {
+		overlay := boxStyle.Render(titleStyle.Render("Keys") + "\n\n" +
+			a.help.FullHelpView([][]key.Binding{a.current().Keys(), globalKeys.bindings()}))
+		body = lipgloss.Place(a.session.width, a.session.bodyHeight(), lipgloss.Center, lipgloss.Center, overlay)
+	}
+
+	body = lipgloss.NewStyle().Height(a.session.bodyHeight()).MaxHeight(a.session.bodyHeight()).MaxWidth(a.session.width).Render(body)
+	return lipgloss.JoinVertical(lipgloss.Left, header, body, footer)
+}
+
+// menuItem is an entry in the main menu
+type menuItem struct {
+	label       string
+	description string
+	open        func(s *session) Screen
+}
+
+// menuScreen is the main menu shown at startup
+type menuScreen struct {
+	session *session
+	items   []menuItem
+	cursor  int
+}
+
+func newMenuScreen(s *session) *menuScreen {
+	return &menuScreen{
+		session: s,
+		items: []menuItem{
+			{label: "League", description: "Set up and manage your fantasy league", open: newLeagueScreen},
+			{label: "Players", description: "Browse NFL players and their fantasy production", open: newPlayerScreen},
+			{label: "Draft", description: "Draft your team against bots using last season's rankings", open: openDraftScreen},
+			{label: "Schedule", description: "View the NFL and fantasy schedules", open: newScheduleScreen},
+			{label: "Standings", description: "Standings, playoff bracket and league history", open: newStandingsScreen},
+			{label: "Settings", description: "Pick a color theme", open: newSettingsScreen},
+		},
+	}
+}
+
+func (m *menuScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (m *menuScreen) Title() string {
+	return "GridironGo"
+}
+
+func (m *menuScreen) Keys() []key.Binding {
+	return []key.Binding{upKey, downKey, enterKey}
+}
+
+func (m *menuScreen) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, upKey):
+		m.cursor = (m.cursor + len(m.items) - 1) % len(m.items)
+	case key.Matches(keyMsg, downKey):
+		m.cursor = (m.cursor + 1) % len(m.items)
+	case key.Matches(keyMsg, enterKey):
+		return m, navigate(m.items[m.cursor].open(m.session))
+	default:
+		// Number keys jump straight to a menu item
+		if n := keyMsg.String(); len(n) == 1 && n[0] >= '1' && int(n[0]-'0') <= len(m.items) {
+			m.cursor = int(n[0] - '1')
+			return m, navigate(m.items[m.cursor].open(m.session))
+		}
+	}
+	return m, nil
+}
+
+func (m *menuScreen) View() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("GridironGo - Fantasy Football"))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render(fmt.Sprintf("League: %s", m.session.rules.Name)))
+	b.WriteString("\n\n")
+
+	for i, item := range m.items {
+		line := fmt.Sprintf("%d. %-10s %s", i+1, item.label, subtleStyle.Render(item.description))
+		if i == m.cursor {
+			line = selectedStyle.Render("> ") + selectedStyle.Render(fmt.Sprintf("%d. %-10s", i+1, item.label)) + " " + subtleStyle.Render(item.description)
+		} else {
+			line = "  " + line
+		}
+		b.WriteString(line + "\n")
+	}
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
@@ -0,0 +1,782 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// regularSeasonWeeks is the number of weeks shown in a game log
+const regularSeasonWeeks = 18
+
+// searchDebounce is how long typing must pause before a search runs
+const searchDebounce = 250 * time.Millisecond
+
+// statColumn is a raw stat shown as a column in player tables
+type statColumn struct {
+	header   string
+	width    int
+	category string
+	statType string
+}
+
+// playerStatColumns are the key stats shown for every player
+var playerStatColumns = []statColumn{
+	{"PaYd", 5, "passing", "passingYards"},
+	{"PaTD", 4, "passing", "passingTouchdowns"},
+	{"Int", 3, "passing", "interceptions"},
+	{"RuYd", 5, "rushing", "rushingYards"},
+	{"RuTD", 4, "rushing", "rushingTouchdowns"},
+	{"Rec", 4, "receiving", "receptions"},
+	{"ReYd", 5, "receiving", "receivingYards"},
+	{"ReTD", 4, "receiving", "receivingTouchdowns"},
+}
+
+// fantasyPositionOrder lists positions first in the position filter
+var fantasyPositionOrder = []string{"QB", "RB", "WR", "TE", "PK", "K"}
+
+// Player browser sort columns ahead of the stat columns
+const (
+	sortByPoints = iota
+	sortByPointsPerGame
+	sortByName
+	sortByStat // sortByStat+i sorts by playerStatColumns[i]
+)
+
+// Player browser keys
+var (
+	searchKey   = key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "search"))
+	positionKey = key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "position"))
+	teamKey     = key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "team"))
+	activeKey   = key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "active only"))
+	prevSeason  = key.NewBinding(key.WithKeys("["), key.WithHelp("[", "prev season"))
+	nextSeason  = key.NewBinding(key.WithKeys("]"), key.WithHelp("]", "next season"))
+	sortKey     = key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "sort"))
+	reverseKey  = key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "reverse"))
+	detailKey   = key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "game log"))
+	profileKey  = key.NewBinding(key.WithKeys("o"), key.WithHelp("o", "profile"))
+)
+
+// playerRow is a player as shown in the browser for the selected season
+type playerRow struct {
+	player  *sqlc.NflPlayer
+	teamID  string
+	summary *league.PlayerSeasonSummary // nil if the player has no stats
+}
+
+// points returns the row's fantasy points (0 without stats)
+func (r playerRow) points() float64 {
+	if r.summary == nil {
+		return 0
+	}
+	return r.summary.Points
+}
+
+// stat returns a season stat total (0 without stats)
+func (r playerRow) stat(column statColumn) float64 {
+	if r.summary == nil {
+		return 0
+	}
+	return r.summary.Stats.Get(column.category, column.statType)
+}
+
+// gameLogWeek is one week of a player's game log
+type gameLogWeek struct {
+	week   int64
+	played bool
+	points float64
+	stats  league.StatLine
+}
+
+// Messages loaded by the player browser
+type (
+	playerDataMsg struct {
+		players []*sqlc.NflPlayer
+		teams   []*sqlc.NflTeam
+		seasons []int64
+		err     error
+	}
+	playerSeasonMsg struct {
+		season      int64
+		seasonTeams map[string]string
+		summaries   map[string]*league.PlayerSeasonSummary
+		injuries    league.Injuries
+		err         error
+	}
+	playerSearchTickMsg struct {
+		id int
+	}
+	playerSearchMsg struct {
+		id      int
+		players []*sqlc.NflPlayer
+		err     error
+	}
+	gameLogMsg struct {
+		playerID string
+		season   int64
+		weeks    []gameLogWeek
+		err      error
+	}
+)
+
+// playerScreen lists NFL players with search, filters and fantasy points
+type playerScreen struct {
+	session *session
+
+	search        textinput.Model
+	searchID      int
+	searchResults []*sqlc.NflPlayer // nil when not searching
+
+	players     []*sqlc.NflPlayer
+	teams       map[string]*sqlc.NflTeam // Team ID -> team
+	teamIDs     []string                 // Team filter options, sorted by abbreviation
+	positions   []string                 // Position filter options
+	seasons     []int64
+	seasonIdx   int
+	seasonTeams map[string]string // Player ID -> team ID in the selected season
+	summaries   map[string]*league.PlayerSeasonSummary
+	injuries    league.Injuries // The selected season's latest injury report
+
+	positionFilter int // Index into positions, -1 for all
+	teamFilter     int // Index into teamIDs, -1 for all
+	activeOnly     bool
+	sortColumn     int
+	sortAscending  bool
+
+	rows   []playerRow
+	cursor int
+
+	showDetail bool
+	detailID   string
+	gameLog    []gameLogWeek
+	detailErr  error
+
+	loading bool
+	err     error
+}
+
+func newPlayerScreen(s *session) Screen {
+	search := textinput.New()
+	search.Prompt = "Search: "
+	search.Placeholder = "press / to search by name"
+	search.PlaceholderStyle = subtleStyle
+	search.CharLimit = 40
+
+	return &playerScreen{
+		session:        s,
+		search:         search,
+		positionFilter: -1,
+		teamFilter:     -1,
+		activeOnly:     true,
+		loading:        true,
+	}
+}
+
+func (m *playerScreen) Init() tea.Cmd {
+	return m.loadPlayers
+}
+
+func (m *playerScreen) Title() string {
+	return "Players"
+}
+
+func (m *playerScreen) Keys() []key.Binding {
+	return []key.Binding{searchKey, positionKey, teamKey, activeKey, prevSeason, nextSeason, sortKey, reverseKey, detailKey, profileKey}
+}
+
+// CapturingInput reports whether the search box has focus
+func (m *playerScreen) CapturingInput() bool {
+	return m.search.Focused()
+}
+
+// season returns the selected season (0 if no games have been scraped)
+func (m *playerScreen) season() int64 {
+	if len(m.seasons) == 0 {
+		return 0
+	}
+	return m.seasons[m.seasonIdx]
+}
+
+// loadPlayers loads every player, team and season
+func (m *playerScreen) loadPlayers() tea.Msg {
+	ctx := m.session.ctx
+	players, err := m.session.db.GetAllNFLPlayers(ctx)
+	if err != nil {
+		return playerDataMsg{err: fmt.Errorf("error loading players: %w", err)}
+	}
+	teams, err := m.session.db.GetAllNFLTeams(ctx)
+	if err != nil {
+		return playerDataMsg{err: fmt.Errorf("error loading teams: %w", err)}
+	}
+	seasons, err := m.session.db.GetSeasons(ctx)
+	if err != nil {
+		return playerDataMsg{err: fmt.Errorf("error loading seasons: %w", err)}
+	}
+	return playerDataMsg{players: players, teams: teams, seasons: seasons}
+}
+
+// loadSeason loads rosters, fantasy points and the latest injury report for
+// a season
+func (m *playerScreen) loadSeason(season int64) tea.Cmd {
+	s := m.session
+	return func() tea.Msg {
+		playerSeasons, err := s.db.GetPlayerSeasonsByYear(s.ctx, season)
+		if err != nil {
+			return playerSeasonMsg{season: season, err: fmt.Errorf("error loading %d rosters: %w", season, err)}
+		}
+		seasonTeams := make(map[string]string, len(playerSeasons))
+		for _, ps := range playerSeasons {
+			seasonTeams[ps.PlayerID] = ps.TeamID.String
+		}
+
+		summaries, err := s.scorer.SeasonSummaries(s.ctx, season)
+		if err != nil {
+			return playerSeasonMsg{season: season, err: err}
+		}
+		injuries, err := league.LoadInjuries(s.ctx, s.db, season, regularSeasonWeeks)
+		if err != nil {
+			return playerSeasonMsg{season: season, err: err}
+		}
+		return playerSeasonMsg{season: season, seasonTeams: seasonTeams, summaries: summaries, injuries: injuries}
+	}
+}
+
+// runSearch searches players by name
+func (m *playerScreen) runSearch(id int, query string) tea.Cmd {
+	s := m.session
+	return func() tea.Msg {
+		players, err := s.db.SearchPlayers(s.ctx, sqlc.SearchPlayersParams{
+			FullName: "%" + query + "%",
+			LastName: query + "%",
+		})
+		return playerSearchMsg{id: id, players: players, err: err}
+	}
+}
+
+// loadGameLog loads a player's week-by-week stats for a season
+func (m *playerScreen) loadGameLog(player *sqlc.NflPlayer, season int64) tea.Cmd {
+	s := m.session
+	return func() tea.Msg {
+		weeks, err := gameLogWeeks(s, player.PlayerID, season)
+		return gameLogMsg{playerID: player.PlayerID, season: season, weeks: weeks, err: err}
+	}
+}
+
+// gameLogWeeks returns a player's game log for every regular season week
+func gameLogWeeks(s *session, playerID string, season int64) ([]gameLogWeek, error) {
+	played, err := s.scorer.WeeklyPoints(s.ctx, playerID, season)
+	if err != nil {
+		return nil, err
+	}
+
+	weeks := make([]gameLogWeek, regularSeasonWeeks)
+	for i := range weeks {
+		weeks[i].week = int64(i + 1)
+	}
+	for _, week := range played {
+		if week.Week < 1 || week.Week > regularSeasonWeeks {
+			continue
+		}
+		weeks[week.Week-1] = gameLogWeek{week: week.Week, played: true, points: week.Points, stats: week.Stats}
+	}
+	return weeks, nil
+}
+
+func (m *playerScreen) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case playerDataMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.setPlayers(msg.players, msg.teams, msg.seasons)
+		m.rebuild()
+		if season := m.season(); season != 0 {
+			m.loading = true
+			return m, m.loadSeason(season)
+		}
+		return m, nil
+
+	case playerSeasonMsg:
+		if msg.season != m.season() {
+			return m, nil
+		}
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.err = nil
+		m.seasonTeams = msg.seasonTeams
+		m.summaries = msg.summaries
+		m.injuries = msg.injuries
+		m.rebuild()
+		return m, m.refreshDetail()
+
+	case playerSearchTickMsg:
+		if msg.id != m.searchID {
+			return m, nil
+		}
+		return m, m.runSearch(msg.id, strings.TrimSpace(m.search.Value()))
+
+	case playerSearchMsg:
+		if msg.id != m.searchID {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.err = fmt.Errorf("error searching players: %w", msg.err)
+			return m, nil
+		}
+		m.searchResults = msg.players
+		m.cursor = 0
+		m.rebuild()
+		return m, nil
+
+	case gameLogMsg:
+		if msg.playerID == m.detailID && msg.season == m.season() {
+			m.gameLog, m.detailErr = msg.weeks, msg.err
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.search.Focused() {
+			return m.updateSearch(msg)
+		}
+		return m.updateKeys(msg)
+	}
+
+	return m, nil
+}
+
+// updateSearch handles keys while the search box has focus
+func (m *playerScreen) updateSearch(msg tea.KeyMsg) (Screen, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter, tea.KeyEsc:
+		m.search.Blur()
+		return m, nil
+	}
+
+	previous := m.search.Value()
+	var cmd tea.Cmd
+	m.search, cmd = m.search.Update(msg)
+	if m.search.Value() == previous {
+		return m, cmd
+	}
+
+	// Debounce searches while the user is typing
+	m.searchID++
+	if strings.TrimSpace(m.search.Value()) == "" {
+		m.searchResults = nil
+		m.rebuild()
+		return m, cmd
+	}
+	id := m.searchID
+	return m, tea.Batch(cmd, tea.Tick(searchDebounce, func(time.Time) tea.Msg {
+		return playerSearchTickMsg{id: id}
+	}))
+}
+
+// updateKeys handles keys while browsing the list
+func (m *playerScreen) updateKeys(msg tea.KeyMsg) (Screen, tea.Cmd) {
+	pageSize := m.pageSize()
+
+	switch {
+	case key.Matches(msg, searchKey):
+		return m, m.search.Focus()
+	case key.Matches(msg, upKey):
+		m.cursor--
+	case key.Matches(msg, downKey):
+		m.cursor++
+	case key.Matches(msg, pageUpKey):
+		m.cursor -= pageSize
+	case key.Matches(msg, pageDnKey):
+		m.cursor += pageSize
+	case msg.String() == "home" || msg.String() == "g":
+		m.cursor = 0
+	case msg.String() == "end" || msg.String() == "G":
+		m.cursor = len(m.rows) - 1
+	case key.Matches(msg, positionKey):
+		m.positionFilter = cycleFilter(m.positionFilter, len(m.positions))
+		m.rebuild()
+	case key.Matches(msg, teamKey):
+		m.teamFilter = cycleFilter(m.teamFilter, len(m.teamIDs))
+		m.rebuild()
+	case key.Matches(msg, activeKey):
+		m.activeOnly = !m.activeOnly
+		m.rebuild()
+	case key.Matches(msg, sortKey):
+		m.sortColumn = (m.sortColumn + 1) % (sortByStat + len(playerStatColumns))
+		m.sortAscending = m.sortColumn == sortByName
+		m.rebuild()
+	case key.Matches(msg, reverseKey):
+		m.sortAscending = !m.sortAscending
+		m.rebuild()
+	case key.Matches(msg, prevSeason), key.Matches(msg, nextSeason):
+		// Seasons are ordered newest first
+		next := m.seasonIdx + 1
+		if key.Matches(msg, nextSeason) {
+			next = m.seasonIdx - 1
+		}
+		if next < 0 || next >= len(m.seasons) {
+			return m, nil
+		}
+		m.seasonIdx = next
+		m.loading = true
+		return m, m.loadSeason(m.season())
+	case key.Matches(msg, detailKey):
+		m.showDetail = !m.showDetail
+		return m, m.refreshDetail()
+	case key.Matches(msg, profileKey):
+		if row, ok := m.selected(); ok {
+			return m, navigate(newPlayerProfileScreen(m.session, row.player, m.season()))
+		}
+		return m, nil
+	default:
+		return m, nil
+	}
+
+	m.clampCursor()
+	if m.showDetail {
+		return m, m.refreshDetail()
+	}
+	return m, nil
+}
+
+// cycleFilter advances a filter index through -1 (all) and each option
+func cycleFilter(current, options int) int {
+	if current+1 >= options {
+		return -1
+	}
+	return current + 1
+}
+
+// selected returns the row under the cursor
+func (m *playerScreen) selected() (playerRow, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.rows) {
+		return playerRow{}, false
+	}
+	return m.rows[m.cursor], true
+}
+
+// refreshDetail loads the game log for the selected player if it isn't shown yet
+func (m *playerScreen) refreshDetail() tea.Cmd {
+	row, ok := m.selected()
+	if !m.showDetail || !ok || m.season() == 0 {
+		return nil
+	}
+	if row.player.PlayerID == m.detailID && m.gameLog != nil {
+		return nil
+	}
+
+	m.detailID = row.player.PlayerID
+	m.gameLog = nil
+	m.detailErr = nil
+	return m.loadGameLog(row.player, m.season())
+}
+
+// setPlayers stores loaded players and teams and builds the filter options
+func (m *playerScreen) setPlayers(players []*sqlc.NflPlayer, teams []*sqlc.NflTeam, seasons []int64) {
+	m.players = players
+	m.seasons = seasons
+	m.seasonIdx = 0
+
+	m.teams = make(map[string]*sqlc.NflTeam, len(teams))
+	m.teamIDs = m.teamIDs[:0]
+	for _, team := range teams {
+		m.teams[team.TeamID] = team
+		m.teamIDs = append(m.teamIDs, team.TeamID)
+	}
+	sort.Slice(m.teamIDs, func(i, j int) bool {
+		return m.teams[m.teamIDs[i]].Abbreviation < m.teams[m.teamIDs[j]].Abbreviation
+	})
+
+	// Fantasy positions first, then any others alphabetically
+	present := make(map[string]bool)
+	for _, player := range players {
+		if player.Position != "" {
+			present[player.Position] = true
+		}
+	}
+	m.positions = m.positions[:0]
+	for _, position := range fantasyPositionOrder {
+		if present[position] {
+			m.positions = append(m.positions, position)
+			delete(present, position)
+		}
+	}
+	others := make([]string, 0, len(present))
+	for position := range present {
+		others = append(others, position)
+	}
+	sort.Strings(others)
+	m.positions = append(m.positions, others...)
+}
+
+// rebuild applies the search, filters and sort to produce the visible rows
+func (m *playerScreen) rebuild() {
+	source := m.players
+	searching := m.searchResults != nil
+	if searching {
+		source = m.searchResults
+	}
+
+	rows := make([]playerRow, 0, len(source))
+	for _, player := range source {
+		row := playerRow{player: player, teamID: player.TeamID.String, summary: m.summaries[player.PlayerID]}
+
+		// Outside of searches, only list players who were on a roster or
+		// recorded stats in the selected season
+		if teamID, ok := m.seasonTeams[player.PlayerID]; ok {
+			row.teamID = teamID
+		} else if !searching && m.seasonTeams != nil && row.summary == nil {
+			continue
+		}
+
+		if m.activeOnly && !player.Active {
+			continue
+		}
+		if m.positionFilter >= 0 && player.Position != m.positions[m.positionFilter] {
+			continue
+		}
+		if m.teamFilter >= 0 && row.teamID != m.teamIDs[m.teamFilter] {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if m.sortAscending {
+			a, b = b, a
+		}
+		switch {
+		case m.sortColumn == sortByName:
+			return a.player.FullName > b.player.FullName
+		case m.sortColumn == sortByPoints:
+			return a.points() > b.points()
+		case m.sortColumn == sortByPointsPerGame:
+			return pointsPerGame(a) > pointsPerGame(b)
+		default:
+			column := playerStatColumns[m.sortColumn-sortByStat]
+			return a.stat(column) > b.stat(column)
+		}
+	})
+
+	m.rows = rows
+	m.clampCursor()
+}
+
+// pointsPerGame returns a row's fantasy points per game (0 without stats)
+func pointsPerGame(row playerRow) float64 {
+	if row.summary == nil {
+		return 0
+	}
+	return row.summary.PointsPerGame()
+}
+
+// clampCursor keeps the cursor on a visible row
+func (m *playerScreen) clampCursor() {
+	m.cursor = min(m.cursor, len(m.rows)-1)
+	m.cursor = max(m.cursor, 0)
+}
+
+// pageSize returns how many rows fit on a page
+func (m *playerScreen) pageSize() int {
+	// Search, filters, blank line, table header and status line
+	return max(m.session.bodyHeight()-5, 1)
+}
+
+// teamAbbreviation returns a team's abbreviation ("FA" for no team)
+func (m *playerScreen) teamAbbreviation(teamID string) string {
+	if team, ok := m.teams[teamID]; ok {
+		return team.Abbreviation
+	}
+	return "FA"
+}
+
+// sortLabel returns the name of the current sort column
+func (m *playerScreen) sortLabel() string {
+	label := ""
+	switch {
+	case m.sortColumn == sortByPoints:
+		label = "FPts"
+	case m.sortColumn == sortByPointsPerGame:
+		label = "FP/G"
+	case m.sortColumn == sortByName:
+		label = "Name"
+	default:
+		label = playerStatColumns[m.sortColumn-sortByStat].header
+	}
+	if m.sortAscending {
+		return label + " ↑"
+	}
+	return label + " ↓"
+}
+
+func (m *playerScreen) View() string {
+	var b strings.Builder
+	b.WriteString(m.search.View())
+	b.WriteString("\n")
+
+	// Current filters
+	season, position, team, active := "none", "All", "All", "All"
+	if s := m.season(); s != 0 {
+		season = fmt.Sprint(s)
+	}
+	if m.positionFilter >= 0 {
+		position = m.positions[m.positionFilter]
+	}
+	if m.teamFilter >= 0 {
+		team = m.teamAbbreviation(m.teamIDs[m.teamFilter])
+	}
+	if m.activeOnly {
+		active = "Yes"
+	}
+	b.WriteString(subtleStyle.Render(fmt.Sprintf("Season: %s  Position: %s  Team: %s  Active only: %s  Sort: %s  Scoring: %s",
+		season, position, team, active, m.sortLabel(), m.session.rules.Name)))
+	b.WriteString("\n\n")
+
+	if m.err != nil {
+		b.WriteString(errorStyle.Render(m.err.Error()))
+		return b.String()
+	}
+
+	list := m.listView()
+	if m.showDetail {
+		detail := m.detailView()
+		if m.session.width >= lipgloss.Width(list)+lipgloss.Width(detail)+2 {
+			list = lipgloss.JoinHorizontal(lipgloss.Top, list, "  ", detail)
+		} else {
+			list = detail
+		}
+	}
+	b.WriteString(list)
+	return b.String()
+}
+
+// listView renders the current page of the player table
+func (m *playerScreen) listView() string {
+	var b strings.Builder
+
+	header := fmt.Sprintf("%4s  %-24s %-4s %-4s %3s %7s %6s", "#", "Name", "Pos", "Team", "GP", "FPts", "FP/G")
+	for _, column := range playerStatColumns {
+		header += fmt.Sprintf(" %*s", column.width, column.header)
+	}
+	b.WriteString(titleStyle.Render(header))
+	b.WriteString("\n")
+
+	pageSize := m.pageSize()
+	start := (m.cursor / pageSize) * pageSize
+	end := min(start+pageSize, len(m.rows))
+
+	for i := start; i < end; i++ {
+		row := m.rows[i]
+		games := 0
+		if row.summary != nil {
+			games = row.summary.Games
+		}
+
+		// Team colors would break up the highlight on the selected row
+		team := m.session.teamBadge(m.teamAbbreviation(row.teamID), 4)
+		if i == m.cursor {
+			team = fmt.Sprintf("%-4s", m.teamAbbreviation(row.teamID))
+		}
+		name := truncate(row.player.FullName, 24)
+		if injury, ok := m.injuries[row.player.PlayerID]; ok {
+			tag := injury.Tag()
+			name = truncate(row.player.FullName, 24-len(tag)-1) + " " + tag
+		}
+		line := fmt.Sprintf("%4d  %-24s %-4s %s %3d %7.1f %6.1f", i+1, name,
+			row.player.Position, team, games, row.points(), pointsPerGame(row))
+		for _, column := range playerStatColumns {
+			line += fmt.Sprintf(" %*.0f", column.width, row.stat(column))
+		}
+
+		if i == m.cursor {
+			line = selectedStyle.Render(line)
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	// Pad short pages so the status line stays at the bottom
+	for i := end - start; i < pageSize; i++ {
+		b.WriteString("\n")
+	}
+
+	pages := max((len(m.rows)+pageSize-1)/pageSize, 1)
+	status := fmt.Sprintf("Page %d/%d · %d players", m.cursor/pageSize+1, pages, len(m.rows))
+	if m.loading {
+		status += " · loading..."
+	}
+	b.WriteString(subtleStyle.Render(status))
+	return b.String()
+}
+
+// detailView renders the selected player's game log
+func (m *playerScreen) detailView() string {
+	row, ok := m.selected()
+	if !ok {
+		return boxStyle.Render("No player selected")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%s (%s, %s)", row.player.FullName, row.player.Position, m.teamAbbreviation(row.teamID))))
+	b.WriteString("\n")
+	b.WriteString(subtleStyle.Render(fmt.Sprintf("%d game log", m.season())))
+	b.WriteString("\n")
+	if injury, ok := m.injuries[row.player.PlayerID]; ok {
+		b.WriteString(errorStyle.Render(fmt.Sprintf("%s (week %d report)", injury, injury.Week)))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	switch {
+	case m.detailErr != nil:
+		b.WriteString(errorStyle.Render(m.detailErr.Error()))
+	case m.gameLog == nil:
+		b.WriteString("Loading...")
+	default:
+		header := fmt.Sprintf("%3s %6s", "Wk", "FPts")
+		for _, column := range playerStatColumns {
+			header += fmt.Sprintf(" %*s", column.width, column.header)
+		}
+		b.WriteString(header)
+		b.WriteString("\n")
+
+		for _, week := range m.gameLog {
+			if !week.played {
+				b.WriteString(subtleStyle.Render(fmt.Sprintf("%3d %6s", week.week, "-")))
+				b.WriteString("\n")
+				continue
+			}
+			line := fmt.Sprintf("%3d %6.1f", week.week, week.points)
+			for _, column := range playerStatColumns {
+				line += fmt.Sprintf(" %*.0f", column.width, week.stats.Get(column.category, column.statType))
+			}
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+	}
+
+	return boxStyle.Padding(0, 1).Render(strings.TrimRight(b.String(), "\n"))
+}
+
+// truncate shortens s to at most width characters
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	return string(runes[:width-1]) + "…"
+}
@@ -0,0 +1,476 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// seasonBarWidth is the width of the fantasy points bars in the career table
+const seasonBarWidth = 30
+
+// headlineStat returns the stat that best summarizes production at a position
+func headlineStat(position string) statColumn {
+	switch strings.ToUpper(position) {
+	case "QB":
+		return statColumn{"PaYd", 5, "passing", "passingYards"}
+	case "RB", "FB":
+		return statColumn{"RuYd", 5, "rushing", "rushingYards"}
+	case "WR", "TE":
+		return statColumn{"ReYd", 5, "receiving", "receivingYards"}
+	case "K", "PK":
+		return statColumn{"FGM", 4, "kicking", "fieldGoalsMade/fieldGoalAttempts"}
+	default:
+		return statColumn{"Tkl", 4, "defensive", "totalTackles"}
+	}
+}
+
+// careerSeason is one season of a player's career
+type careerSeason struct {
+	season   int64
+	teamID   string
+	jersey   string
+	status   string
+	games    int
+	points   float64
+	headline float64 // Season total of the position's headline stat
+}
+
+// Messages loaded by the player profile
+type (
+	profileMsg struct {
+		playerID string
+		teams    map[string]string // Team ID -> abbreviation
+		career   []careerSeason
+		injuries []*sqlc.NflInjury // Newest first
+		err      error
+	}
+	profileWeeksMsg struct {
+		playerID string
+		season   int64
+		weeks    []gameLogWeek
+		headline map[int64]float64 // Week -> headline stat, for every week with games
+		err      error
+	}
+)
+
+// playerProfileScreen shows a player's bio, career and week-by-week production
+type playerProfileScreen struct {
+	session  *session
+	player   *sqlc.NflPlayer
+	headline statColumn
+	viewport viewport.Model
+
+	teams     map[string]string
+	career    []careerSeason    // Newest season first
+	injuries  []*sqlc.NflInjury // Injury report designations, newest first
+	seasonIdx int               // Index into career of the season shown by week
+	weeks     []gameLogWeek
+	weekStat  map[int64]float64
+
+	loading bool
+	err     error
+}
+
+// newPlayerProfileScreen opens a player's profile, showing the given season by week
+func newPlayerProfileScreen(s *session, player *sqlc.NflPlayer, season int64) Screen {
+	m := &playerProfileScreen{
+		session:  s,
+		player:   player,
+		headline: headlineStat(player.Position),
+		viewport: viewport.New(s.width, s.bodyHeight()),
+		loading:  true,
+	}
+	m.career = []careerSeason{{season: season}}
+	m.refresh()
+	return m
+}
+
+func (m *playerProfileScreen) Init() tea.Cmd {
+	return m.loadProfile
+}
+
+func (m *playerProfileScreen) Title() string {
+	return m.player.FullName
+}
+
+func (m *playerProfileScreen) Keys() []key.Binding {
+	return []key.Binding{upKey, downKey, pageUpKey, pageDnKey, prevSeason, nextSeason}
+}
+
+// season returns the season shown by week (0 if the player has no seasons)
+func (m *playerProfileScreen) season() int64 {
+	if m.seasonIdx >= len(m.career) {
+		return 0
+	}
+	return m.career[m.seasonIdx].season
+}
+
+// loadProfile loads the player's team history and fantasy points for each season
+func (m *playerProfileScreen) loadProfile() tea.Msg {
+	s := m.session
+	playerID := m.player.PlayerID
+
+	teams, err := s.db.GetAllNFLTeams(s.ctx)
+	if err != nil {
+		return profileMsg{playerID: playerID, err: fmt.Errorf("error loading teams: %w", err)}
+	}
+	abbreviations := make(map[string]string, len(teams))
+	for _, team := range teams {
+		abbreviations[team.TeamID] = team.Abbreviation
+	}
+
+	history, err := s.db.GetPlayerSeasonsByPlayer(s.ctx, playerID)
+	if err != nil {
+		return profileMsg{playerID: playerID, err: fmt.Errorf("error loading team history: %w", err)}
+	}
+
+	headlines, err := s.db.GetPlayerSeasonalStatsByType(s.ctx, sqlc.GetPlayerSeasonalStatsByTypeParams{
+		PlayerID: playerID,
+		StatType: m.headline.statType,
+	})
+	if err != nil {
+		return profileMsg{playerID: playerID, err: fmt.Errorf("error loading season %s: %w", m.headline.header, err)}
+	}
+	headlineBySeason := make(map[int64]float64, len(headlines))
+	for _, row := range headlines {
+		headlineBySeason[row.Season] = row.TotalValue.Float64
+	}
+
+	career := make([]careerSeason, 0, len(history))
+	for _, ps := range history {
+		weeks, err := s.scorer.WeeklyPoints(s.ctx, playerID, ps.SeasonYear)
+		if err != nil {
+			return profileMsg{playerID: playerID, err: err}
+		}
+		season := careerSeason{
+			season:   ps.SeasonYear,
+			teamID:   ps.TeamID.String,
+			jersey:   ps.Jersey.String,
+			status:   ps.Status.String,
+			games:    len(weeks),
+			headline: headlineBySeason[ps.SeasonYear],
+		}
+		for _, week := range weeks {
+			season.points += week.Points
+		}
+		career = append(career, season)
+	}
+
+	injuries, err := s.db.GetInjuriesByPlayer(s.ctx, playerID)
+	if err != nil {
+		return profileMsg{playerID: playerID, err: fmt.Errorf("error loading injuries: %w", err)}
+	}
+
+	return profileMsg{playerID: playerID, teams: abbreviations, career: career, injuries: injuries}
+}
+
+// loadWeeks loads the player's fantasy points and headline stat for each week of a season
+func (m *playerProfileScreen) loadWeeks(season int64) tea.Cmd {
+	s := m.session
+	playerID := m.player.PlayerID
+	statType := m.headline.statType
+	return func() tea.Msg {
+		weeks, err := gameLogWeeks(s, playerID, season)
+		if err != nil {
+			return profileWeeksMsg{playerID: playerID, season: season, err: err}
+		}
+
+		rows, err := s.db.GetPlayerWeeklyStatByType(s.ctx, sqlc.GetPlayerWeeklyStatByTypeParams{
+			PlayerID: playerID,
+			StatType: statType,
+			Season:   season,
+		})
+		if err != nil {
+			return profileWeeksMsg{playerID: playerID, season: season, err: fmt.Errorf("error loading weekly stats: %w", err)}
+		}
+		headline := make(map[int64]float64, len(rows))
+		for _, row := range rows {
+			headline[row.Week] = numericValue(row.StatValue)
+		}
+
+		return profileWeeksMsg{playerID: playerID, season: season, weeks: weeks, headline: headline}
+	}
+}
+
+func (m *playerProfileScreen) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.viewport.Width = msg.Width
+		m.viewport.Height = m.session.bodyHeight()
+		m.refresh()
+		return m, nil
+
+	case profileMsg:
+		if msg.playerID != m.player.PlayerID {
+			return m, nil
+		}
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			m.refresh()
+			return m, nil
+		}
+
+		// Keep showing the season the profile was opened from
+		selected := m.season()
+		m.teams = msg.teams
+		m.career = msg.career
+		m.injuries = msg.injuries
+		m.seasonIdx = 0
+		for i, season := range m.career {
+			if season.season == selected {
+				m.seasonIdx = i
+			}
+		}
+		m.refresh()
+		if season := m.season(); season != 0 {
+			return m, m.loadWeeks(season)
+		}
+		return m, nil
+
+	case profileWeeksMsg:
+		if msg.playerID != m.player.PlayerID || msg.season != m.season() {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.err = msg.err
+		} else {
+			m.weeks, m.weekStat = msg.weeks, msg.headline
+		}
+		m.refresh()
+		return m, nil
+
+	case tea.KeyMsg:
+		if key.Matches(msg, prevSeason) || key.Matches(msg, nextSeason) {
+			// Seasons are ordered newest first
+			next := m.seasonIdx + 1
+			if key.Matches(msg, nextSeason) {
+				next = m.seasonIdx - 1
+			}
+			if m.loading || next < 0 || next >= len(m.career) {
+				return m, nil
+			}
+			m.seasonIdx = next
+			m.weeks, m.weekStat = nil, nil
+			m.refresh()
+			return m, m.loadWeeks(m.season())
+		}
+
+		var cmd tea.Cmd
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m *playerProfileScreen) View() string {
+	return m.viewport.View()
+}
+
+// refresh re-renders the profile into the viewport
+func (m *playerProfileScreen) refresh() {
+	var b strings.Builder
+	m.writeBio(&b)
+	b.WriteString("\n")
+
+	switch {
+	case m.err != nil:
+		b.WriteString(errorStyle.Render(m.err.Error()))
+	case m.loading:
+		b.WriteString("Loading...")
+	case len(m.career) == 0:
+		b.WriteString(subtleStyle.Render("No seasons have been scraped for this player"))
+	default:
+		m.writeCareer(&b)
+		b.WriteString("\n")
+		m.writeWeeks(&b)
+	}
+
+	m.viewport.SetContent(b.String())
+}
+
+// team returns a team's abbreviation ("FA" for no team)
+func (m *playerProfileScreen) team(teamID string) string {
+	if abbreviation, ok := m.teams[teamID]; ok {
+		return abbreviation
+	}
+	return "FA"
+}
+
+// writeBio writes the player's name line and bio details
+func (m *playerProfileScreen) writeBio(b *strings.Builder) {
+	p := m.player
+
+	name := p.FullName
+	if p.Jersey.Valid && p.Jersey.String != "" {
+		name += " #" + p.Jersey.String
+	}
+	status := "Inactive"
+	if p.Active {
+		status = "Active"
+	}
+	if p.Status.Valid && p.Status.String != "" {
+		status = p.Status.String
+	}
+	b.WriteString(titleStyle.Render(name))
+	b.WriteString(subtleStyle.Render(fmt.Sprintf("  %s · %s · %s", p.Position, m.team(p.TeamID.String), status)))
+	b.WriteString("\n")
+
+	details := []string{}
+	if p.Height.Valid && p.Height.Int64 > 0 {
+		details = append(details, fmt.Sprintf("Height %d'%d\"", p.Height.Int64/12, p.Height.Int64%12))
+	}
+	if p.Weight.Valid && p.Weight.Int64 > 0 {
+		details = append(details, fmt.Sprintf("Weight %d lbs", p.Weight.Int64))
+	}
+	if p.College.Valid && p.College.String != "" {
+		details = append(details, "College "+p.College.String)
+	}
+	if p.Experience.Valid {
+		details = append(details, fmt.Sprintf("Experience %d yrs", p.Experience.Int64))
+	}
+	if p.DraftYear.Valid && p.DraftYear.Int64 > 0 {
+		details = append(details, fmt.Sprintf("Drafted %d, round %d, pick %d", p.DraftYear.Int64, p.DraftRound.Int64, p.DraftPick.Int64))
+	} else {
+		details = append(details, "Undrafted")
+	}
+	b.WriteString(strings.Join(details, "  ·  "))
+	b.WriteString("\n")
+
+	if len(m.injuries) > 0 {
+		latest := m.injuries[0]
+		injury := league.InjuryFromRow(latest)
+		line := fmt.Sprintf("Injury: %s, %d week %d report", injury, latest.Season, latest.Week)
+		if injury.Detail != "" {
+			line += " · " + injury.Detail
+		}
+		b.WriteString(errorStyle.Render(line))
+		b.WriteString("\n")
+	}
+}
+
+// weekInjuries returns the player's designations on the selected season's
+// injury reports, keyed by week
+func (m *playerProfileScreen) weekInjuries() map[int64]league.Injury {
+	injuries := make(map[int64]league.Injury)
+	for _, row := range m.injuries {
+		if row.Season == m.season() {
+			injuries[row.Week] = league.InjuryFromRow(row)
+		}
+	}
+	return injuries
+}
+
+// writeCareer writes the team history with fantasy points for each season
+func (m *playerProfileScreen) writeCareer(b *strings.Builder) {
+	b.WriteString(titleStyle.Render("Career"))
+	b.WriteString(subtleStyle.Render("  scoring: " + m.session.rules.Name))
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("%-6s %-4s %3s  %-10s %3s %7s %6s %*s\n", "Season", "Team", "#", "Status", "GP", "FPts", "FP/G", m.headline.width, m.headline.header))
+
+	highest := 0.0
+	for _, season := range m.career {
+		highest = max(highest, season.points)
+	}
+	for i, season := range m.career {
+		perGame := 0.0
+		if season.games > 0 {
+			perGame = season.points / float64(season.games)
+		}
+		team := m.session.teamBadge(m.team(season.teamID), 4)
+		if i == m.seasonIdx {
+			team = fmt.Sprintf("%-4s", m.team(season.teamID))
+		}
+		line := fmt.Sprintf("%-6d %s %3s  %-10s %3d %7.1f %6.1f %*.0f", season.season, team, season.jersey,
+			truncate(season.status, 10), season.games, season.points, perGame, m.headline.width, season.headline)
+		if i == m.seasonIdx {
+			line = selectedStyle.Render(line)
+		}
+		b.WriteString(line)
+		b.WriteString("  ")
+		b.WriteString(goodStyle.Render(bar(season.points, highest, seasonBarWidth)))
+		b.WriteString("\n")
+	}
+}
+
+// writeWeeks writes the selected season's weekly fantasy points and a sparkline of them
+func (m *playerProfileScreen) writeWeeks(b *strings.Builder) {
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%d by week", m.season())))
+	b.WriteString(subtleStyle.Render("  [ ] change season"))
+	b.WriteString("\n")
+
+	if m.weeks == nil {
+		b.WriteString("Loading...\n")
+		return
+	}
+
+	// Only list weeks with games, which drops weeks the season hasn't reached
+	injuries := m.weekInjuries()
+	weeks := make([]gameLogWeek, 0, len(m.weeks))
+	for _, week := range m.weeks {
+		_, injured := injuries[week.week]
+		if _, ok := m.weekStat[week.week]; ok || week.played || injured {
+			weeks = append(weeks, week)
+		}
+	}
+
+	points := make([]float64, len(weeks))
+	for i, week := range weeks {
+		points[i] = week.points
+	}
+	b.WriteString(goodStyle.Render(sparkline(points)))
+	b.WriteString("\n\n")
+
+	header := fmt.Sprintf("%3s %6s %*s", "Wk", "FPts", m.headline.width, m.headline.header)
+	for _, column := range playerStatColumns {
+		if column.statType != m.headline.statType {
+			header += fmt.Sprintf(" %*s", column.width, column.header)
+		}
+	}
+	b.WriteString(header)
+	b.WriteString("\n")
+
+	for _, week := range weeks {
+		injury, injured := injuries[week.week]
+		if !week.played {
+			b.WriteString(subtleStyle.Render(fmt.Sprintf("%3d %6s", week.week, "-")))
+			if injured {
+				b.WriteString("  " + errorStyle.Render(injury.String()))
+			}
+			b.WriteString("\n")
+			continue
+		}
+		line := fmt.Sprintf("%3d %6.1f %*.0f", week.week, week.points, m.headline.width, m.weekStat[week.week])
+		for _, column := range playerStatColumns {
+			if column.statType != m.headline.statType {
+				line += fmt.Sprintf(" %*.0f", column.width, week.stats.Get(column.category, column.statType))
+			}
+		}
+		b.WriteString(line)
+		if injured {
+			b.WriteString("  " + errorStyle.Render(injury.Tag()))
+		}
+		b.WriteString("\n")
+	}
+}
+
+// numericValue converts a computed SQLite column, which may be scanned as an
+// integer or a float, to a float64
+func numericValue(v any) float64 {
+	switch v := v.(type) {
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,611 @@
+package tui
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Mclazy108/GridironGo/internals/data/sqlc"
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// scoreboardRefresh is how often fantasy totals are reloaded while the
+// scoreboard is open, so stats scraped mid-week show up
+const scoreboardRefresh = 30 * time.Second
+
+// Schedule tabs
+const (
+	nflScheduleTab = iota
+	fantasyScheduleTab
+)
+
+// Schedule keys
+var (
+	scheduleTabKey = key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "nfl/fantasy"))
+	prevWeekKey    = key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←", "prev week"))
+	nextWeekKey    = key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→", "next week"))
+	matchupKey     = key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "matchup"))
+)
+
+// Messages loaded by the schedule screens
+type (
+	scheduleDataMsg struct {
+		seasons []int64
+		teams   []*sqlc.NflTeam
+		err     error
+	}
+	scheduleWeeksMsg struct {
+		season int64
+		weeks  []int64
+		err    error
+	}
+	scheduleGamesMsg struct {
+		season int64
+		week   int64
+		games  []*sqlc.NflGame
+		err    error
+	}
+	weekScoresMsg struct {
+		season int64
+		week   int64
+		scores map[string]*league.PlayerWeek
+		err    error
+	}
+	scoreboardTickMsg struct {
+		id int
+	}
+)
+
+// loadWeekScores is a command that scores every player for a week
+func loadWeekScores(s *session, season, week int64) tea.Cmd {
+	return func() tea.Msg {
+		scores, err := s.scorer.WeekScores(s.ctx, season, week)
+		return weekScoresMsg{season: season, week: week, scores: scores, err: err}
+	}
+}
+
+// fantasySeason returns the session's drafted teams and the NFL season their
+// games are played in, or false if no draft has been completed
+func (s *session) fantasySeason() ([]*league.Team, int64, bool) {
+	if s.season == nil {
+		return nil, 0, false
+	}
+	return s.season.Teams, s.season.Year, true
+}
+
+// scheduleScreen shows the week-by-week NFL schedule and the fantasy scoreboard
+type scheduleScreen struct {
+	session *session
+	tab     int
+
+	// NFL schedule
+	seasons   []int64
+	seasonIdx int
+	weeks     []int64
+	week      int64
+	teams     map[string]*sqlc.NflTeam // Display name -> team
+	games     []*sqlc.NflGame
+
+	// Fantasy scoreboard
+	fantasyWeek int64
+	scores      map[string]*league.PlayerWeek
+	scoresWeek  int64 // Week the scores were loaded for
+	cursor      int
+	tickID      int
+
+	loading bool
+	err     error
+}
+
+func newScheduleScreen(s *session) Screen {
+	return &scheduleScreen{session: s, fantasyWeek: 1, loading: true}
+}
+
+func (m *scheduleScreen) Init() tea.Cmd {
+	s := m.session
+	return func() tea.Msg {
+		seasons, err := s.db.GetSeasons(s.ctx)
+		if err != nil {
+			return scheduleDataMsg{err: fmt.Errorf("error loading seasons: %w", err)}
+		}
+		teams, err := s.db.GetAllNFLTeams(s.ctx)
+		if err != nil {
+			return scheduleDataMsg{err: fmt.Errorf("error loading teams: %w", err)}
+		}
+		return scheduleDataMsg{seasons: seasons, teams: teams}
+	}
+}
+
+func (m *scheduleScreen) Title() string {
+	return "Schedule"
+}
+
+func (m *scheduleScreen) Keys() []key.Binding {
+	if m.tab == fantasyScheduleTab {
+		return []key.Binding{scheduleTabKey, prevWeekKey, nextWeekKey, upKey, downKey, matchupKey}
+	}
+	return []key.Binding{scheduleTabKey, prevWeekKey, nextWeekKey, prevSeason, nextSeason}
+}
+
+// season returns the selected NFL season (0 if no games have been scraped)
+func (m *scheduleScreen) season() int64 {
+	if len(m.seasons) == 0 {
+		return 0
+	}
+	return m.seasons[m.seasonIdx]
+}
+
+// loadWeeks loads the weeks with games in a season
+func (m *scheduleScreen) loadWeeks(season int64) tea.Cmd {
+	s := m.session
+	return func() tea.Msg {
+		weeks, err := s.db.GetWeeksBySeason(s.ctx, season)
+		if err != nil {
+			err = fmt.Errorf("error loading %d weeks: %w", season, err)
+		}
+		return scheduleWeeksMsg{season: season, weeks: weeks, err: err}
+	}
+}
+
+// loadGames loads the NFL games of a week
+func (m *scheduleScreen) loadGames(season, week int64) tea.Cmd {
+	s := m.session
+	return func() tea.Msg {
+		games, err := s.db.GetAllGamesBySeasonAndWeek(s.ctx, sqlc.GetAllGamesBySeasonAndWeekParams{
+			Season: season,
+			Week:   week,
+		})
+		if err != nil {
+			err = fmt.Errorf("error loading %d week %d games: %w", season, week, err)
+		}
+		return scheduleGamesMsg{season: season, week: week, games: games, err: err}
+	}
+}
+
+// refreshScores reloads the fantasy scoreboard and schedules the next refresh
+func (m *scheduleScreen) refreshScores() tea.Cmd {
+	_, season, ok := m.session.fantasySeason()
+	if !ok {
+		return nil
+	}
+	m.tickID++
+	id := m.tickID
+	return tea.Batch(
+		loadWeekScores(m.session, season, m.fantasyWeek),
+		tea.Tick(scoreboardRefresh, func(time.Time) tea.Msg { return scoreboardTickMsg{id: id} }),
+	)
+}
+
+// matchups returns the fantasy matchups of the selected week
+func (m *scheduleScreen) matchups() []league.Matchup {
+	teams, _, ok := m.session.fantasySeason()
+	if !ok {
+		return nil
+	}
+	schedule := league.GenerateSchedule(teams, m.session.rules.RegularSeasonWeeks())
+	return league.WeekMatchups(schedule, int(m.fantasyWeek))
+}
+
+func (m *scheduleScreen) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case scheduleDataMsg:
+		m.seasons, m.err = msg.seasons, msg.err
+		m.teams = make(map[string]*sqlc.NflTeam, len(msg.teams))
+		for _, team := range msg.teams {
+			m.teams[team.DisplayName] = team
+		}
+		if m.err != nil || len(m.seasons) == 0 {
+			m.loading = false
+			return m, nil
+		}
+		return m, m.loadWeeks(m.season())
+
+	case scheduleWeeksMsg:
+		if msg.season != m.season() {
+			return m, nil
+		}
+		m.weeks, m.err = msg.weeks, msg.err
+		if m.err != nil || len(m.weeks) == 0 {
+			m.loading = false
+			m.games = nil
+			return m, nil
+		}
+		m.week = m.weeks[0]
+		return m, m.loadGames(m.season(), m.week)
+
+	case scheduleGamesMsg:
+		if msg.season != m.season() || msg.week != m.week {
+			return m, nil
+		}
+		m.loading = false
+		m.games, m.err = msg.games, msg.err
+		return m, nil
+
+	case weekScoresMsg:
+		if _, season, ok := m.session.fantasySeason(); !ok || msg.season != season || msg.week != m.fantasyWeek {
+			return m, nil
+		}
+		m.scores, m.scoresWeek, m.err = msg.scores, msg.week, msg.err
+		return m, nil
+
+	case scoreboardTickMsg:
+		if msg.id != m.tickID || m.tab != fantasyScheduleTab {
+			return m, nil
+		}
+		return m, m.refreshScores()
+
+	case leagueChangedMsg:
+		// A new league drops the draft, and with it the fantasy schedule
+		m.scores, m.scoresWeek, m.cursor = nil, 0, 0
+		return m, nil
+
+	case tea.KeyMsg:
+		if key.Matches(msg, scheduleTabKey) {
+			m.err = nil
+			if m.tab == nflScheduleTab {
+				m.tab = fantasyScheduleTab
+				return m, m.refreshScores()
+			}
+			m.tab = nflScheduleTab
+			return m, nil
+		}
+		if m.tab == fantasyScheduleTab {
+			return m.updateScoreboard(msg)
+		}
+		return m.updateNFL(msg)
+	}
+	return m, nil
+}
+
+// updateNFL handles keys on the NFL schedule tab
+func (m *scheduleScreen) updateNFL(msg tea.KeyMsg) (Screen, tea.Cmd) {
+	switch {
+	case key.Matches(msg, prevWeekKey), key.Matches(msg, nextWeekKey):
+		i := sort.Search(len(m.weeks), func(i int) bool { return m.weeks[i] >= m.week })
+		if key.Matches(msg, nextWeekKey) {
+			i++
+		} else {
+			i--
+		}
+		if i < 0 || i >= len(m.weeks) {
+			return m, nil
+		}
+		m.week = m.weeks[i]
+		m.loading = true
+		return m, m.loadGames(m.season(), m.week)
+
+	case key.Matches(msg, prevSeason), key.Matches(msg, nextSeason):
+		// Seasons are ordered newest first
+		next := m.seasonIdx + 1
+		if key.Matches(msg, nextSeason) {
+			next = m.seasonIdx - 1
+		}
+		if next < 0 || next >= len(m.seasons) {
+			return m, nil
+		}
+		m.seasonIdx = next
+		m.loading = true
+		return m, m.loadWeeks(m.season())
+	}
+	return m, nil
+}
+
+// updateScoreboard handles keys on the fantasy scoreboard tab
+func (m *scheduleScreen) updateScoreboard(msg tea.KeyMsg) (Screen, tea.Cmd) {
+	matchups := m.matchups()
+
+	switch {
+	case key.Matches(msg, prevWeekKey), key.Matches(msg, nextWeekKey):
+		week := m.fantasyWeek - 1
+		if key.Matches(msg, nextWeekKey) {
+			week = m.fantasyWeek + 1
+		}
+		if week < 1 || week > int64(m.session.rules.RegularSeasonWeeks()) {
+			return m, nil
+		}
+		m.fantasyWeek = week
+		m.cursor = 0
+		return m, m.refreshScores()
+	case key.Matches(msg, upKey):
+		m.cursor = max(m.cursor-1, 0)
+	case key.Matches(msg, downKey):
+		m.cursor = max(min(m.cursor+1, len(matchups)-1), 0)
+	case key.Matches(msg, matchupKey):
+		if m.cursor < len(matchups) {
+			_, season, _ := m.session.fantasySeason()
+			return m, navigate(newMatchupScreen(m.session, matchups[m.cursor], season, m.fantasyWeek))
+		}
+	}
+	return m, nil
+}
+
+func (m *scheduleScreen) View() string {
+	var b strings.Builder
+
+	tabs := []string{"NFL Schedule", "Fantasy Scoreboard"}
+	for i, tab := range tabs {
+		if i == m.tab {
+			tabs[i] = selectedStyle.Render("[" + tab + "]")
+		} else {
+			tabs[i] = subtleStyle.Render(" " + tab + " ")
+		}
+	}
+	b.WriteString(strings.Join(tabs, " "))
+	b.WriteString("\n\n")
+
+	if m.err != nil {
+		b.WriteString(errorStyle.Render(m.err.Error()))
+		b.WriteString("\n\n")
+	}
+
+	if m.tab == fantasyScheduleTab {
+		b.WriteString(m.scoreboardView())
+	} else {
+		b.WriteString(m.nflView())
+	}
+	return b.String()
+}
+
+// badge renders a team's abbreviation badge from its display name
+func (m *scheduleScreen) badge(name string) string {
+	if team, ok := m.teams[name]; ok {
+		return m.session.teamBadge(team.Abbreviation, 3)
+	}
+	return "   "
+}
+
+// nflView lists the selected week's NFL games with scores and bye teams
+func (m *scheduleScreen) nflView() string {
+	switch {
+	case len(m.seasons) == 0 && !m.loading:
+		return subtleStyle.Render("No games have been scraped yet. Run gridirongo -scrape-games to load the NFL schedule.")
+	case len(m.weeks) == 0:
+		return "Loading schedule..."
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%d season · Week %d", m.season(), m.week)))
+	if m.loading {
+		b.WriteString(subtleStyle.Render("  loading..."))
+	}
+	b.WriteString("\n\n")
+
+	playing := make(map[string]bool)
+	for _, game := range m.games {
+		playing[game.AwayTeam] = true
+		playing[game.HomeTeam] = true
+
+		away := fmt.Sprintf("%-24s %3s", truncate(game.AwayTeam, 24), scoreText(game.AwayScore))
+		home := fmt.Sprintf("%-24s %3s", truncate(game.HomeTeam, 24), scoreText(game.HomeScore))
+		if game.Completed && game.AwayScore.Int64 > game.HomeScore.Int64 {
+			away = goodStyle.Render(away)
+		} else if game.Completed && game.HomeScore.Int64 > game.AwayScore.Int64 {
+			home = goodStyle.Render(home)
+		}
+		away = m.badge(game.AwayTeam) + " " + away
+		home = m.badge(game.HomeTeam) + " " + home
+
+		b.WriteString(fmt.Sprintf("%-11s %s  @  %s  %s\n",
+			gameDate(game.Date), away, home, subtleStyle.Render(gameStatus(game))))
+	}
+
+	// Teams without a game this week are on bye
+	var byes []string
+	for name, team := range m.teams {
+		if !playing[name] {
+			byes = append(byes, team.Abbreviation)
+		}
+	}
+	if len(m.games) > 0 && len(byes) > 0 {
+		sort.Strings(byes)
+		for i, abbreviation := range byes {
+			byes[i] = m.session.teamBadge(abbreviation, 0)
+		}
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render("Bye: ") + strings.Join(byes, " "))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// scoreText formats a game score, blank before kickoff
+func scoreText(score sql.NullInt64) string {
+	if !score.Valid {
+		return ""
+	}
+	return fmt.Sprintf("%d", score.Int64)
+}
+
+// gameStatus describes whether a game is final, in progress or upcoming
+func gameStatus(game *sqlc.NflGame) string {
+	switch {
+	case game.Completed:
+		return "Final"
+	case game.AwayScore.Valid || game.HomeScore.Valid:
+		return "In progress"
+	default:
+		return "Scheduled"
+	}
+}
+
+// gameDate formats a game's YYYY-MM-DD date as e.g. "Sun Sep 8"
+func gameDate(date string) string {
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+	return parsed.Format("Mon Jan 2")
+}
+
+// scoreboardView lists the selected week's fantasy matchups with live totals
+func (m *scheduleScreen) scoreboardView() string {
+	_, season, ok := m.session.fantasySeason()
+	if !ok {
+		return subtleStyle.Render("Complete a draft to see the fantasy schedule and scoreboard.")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%s · Week %d of %d", m.session.rules.Name, m.fantasyWeek, m.session.rules.RegularSeasonWeeks())))
+	b.WriteString(subtleStyle.Render(fmt.Sprintf("  %d NFL stats · totals refresh every %s", season, scoreboardRefresh)))
+	b.WriteString("\n\n")
+
+	matchups := m.matchups()
+	if len(matchups) == 0 {
+		b.WriteString(subtleStyle.Render("No matchups this week."))
+		return b.String()
+	}
+
+	for i, matchup := range matchups {
+		home, away := m.teamTotal(matchup.Home), m.teamTotal(matchup.Away)
+		homeName, awayName := fmt.Sprintf("%-22s", truncate(matchup.Home.Name, 22)), fmt.Sprintf("%22s", truncate(matchup.Away.Name, 22))
+		if !matchup.Home.Bot {
+			homeName = titleStyle.Render(homeName)
+		}
+		if !matchup.Away.Bot {
+			awayName = titleStyle.Render(awayName)
+		}
+
+		line := fmt.Sprintf("%s %7s  vs  %-7s %s", homeName, home, away, awayName)
+		if i == m.cursor {
+			line = selectedStyle.Render("> ") + line
+		} else {
+			line = "  " + line
+		}
+		b.WriteString(line + "\n")
+	}
+	return b.String()
+}
+
+// teamTotal formats a team's starting lineup points for the selected week
+func (m *scheduleScreen) teamTotal(team *league.Team) string {
+	if m.scores == nil || m.scoresWeek != m.fantasyWeek {
+		return "-"
+	}
+	score := league.ScoreTeam(team.ForWeek(int(m.fantasyWeek)), m.session.rules.RosterPositions, m.scores)
+	return fmt.Sprintf("%.2f", score.Total)
+}
+
+// matchupScreen shows both lineups of a fantasy matchup side by side
+type matchupScreen struct {
+	session  *session
+	matchup  league.Matchup
+	season   int64
+	week     int64
+	scores   map[string]*league.PlayerWeek
+	viewport viewport.Model
+	loading  bool
+	err      error
+}
+
+func newMatchupScreen(s *session, matchup league.Matchup, season, week int64) Screen {
+	m := &matchupScreen{
+		session:  s,
+		matchup:  matchup,
+		season:   season,
+		week:     week,
+		viewport: viewport.New(s.width, s.bodyHeight()-3),
+		loading:  true,
+	}
+	m.refresh()
+	return m
+}
+
+func (m *matchupScreen) Init() tea.Cmd {
+	return loadWeekScores(m.session, m.season, m.week)
+}
+
+func (m *matchupScreen) Title() string {
+	return fmt.Sprintf("Week %d Matchup", m.week)
+}
+
+func (m *matchupScreen) Keys() []key.Binding {
+	return []key.Binding{upKey, downKey, pageUpKey, pageDnKey}
+}
+
+func (m *matchupScreen) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.viewport.Width = msg.Width
+		m.viewport.Height = m.session.bodyHeight() - 3
+		m.refresh()
+		return m, nil
+
+	case weekScoresMsg:
+		// Scoreboard refreshes land here too, keeping the matchup live
+		if msg.season != m.season || msg.week != m.week {
+			return m, nil
+		}
+		m.loading = false
+		m.scores, m.err = msg.scores, msg.err
+		m.refresh()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// refresh lays out both lineups
+func (m *matchupScreen) refresh() {
+	roster := m.session.rules.RosterPositions
+	home := league.ScoreTeam(m.matchup.Home.ForWeek(m.matchup.Week), roster, m.scores)
+	away := league.ScoreTeam(m.matchup.Away.ForWeek(m.matchup.Week), roster, m.scores)
+
+	content := lipgloss.JoinHorizontal(lipgloss.Top, lineupView(home), "    ", lineupView(away))
+	m.viewport.SetContent(content)
+}
+
+// lineupView renders a team's scored lineup as a column
+func lineupView(score *league.TeamScore) string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%-30s %7.2f", truncate(score.Team.Name, 30), score.Total)))
+	b.WriteString("\n\n")
+
+	row := func(slot league.ScoredSlot) string {
+		if slot.Player == nil {
+			return subtleStyle.Render(fmt.Sprintf("%-9s %-24s", slot.Slot.Slot, "(empty)")) + "\n"
+		}
+		points := subtleStyle.Render(fmt.Sprintf("%7s", "-"))
+		if slot.Played {
+			points = fmt.Sprintf("%7.2f", slot.Points)
+		}
+		return fmt.Sprintf("%-9s %-24s %-4s %s\n", slot.Slot.Slot, truncate(slot.Player.Name, 24), slot.Player.Position, points)
+	}
+
+	for _, slot := range score.Starters {
+		b.WriteString(row(slot))
+	}
+	if len(score.Bench) > 0 {
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render("Bench"))
+		b.WriteString("\n")
+		for _, slot := range score.Bench {
+			b.WriteString(row(slot))
+		}
+	}
+	return b.String()
+}
+
+func (m *matchupScreen) View() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%s vs %s", m.matchup.Home.Name, m.matchup.Away.Name)))
+	b.WriteString(subtleStyle.Render(fmt.Sprintf("  week %d · %d NFL stats", m.week, m.season)))
+	b.WriteString("\n")
+	switch {
+	case m.err != nil:
+		b.WriteString(errorStyle.Render(m.err.Error()))
+	case m.loading:
+		b.WriteString("Loading scores...")
+	}
+	b.WriteString("\n\n")
+	b.WriteString(m.viewport.View())
+	return b.String()
+}
@@ -0,0 +1,73 @@
+package tui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// userSettings are the user's TUI preferences, saved between runs
+type userSettings struct {
+	Theme string `json:"theme"`
+}
+
+// settingsPath returns where settings are saved: $GRIDIRONGO_SETTINGS if
+// set, otherwise gridirongo/settings.json in the user's config directory
+func settingsPath() (string, error) {
+	if path := os.Getenv("GRIDIRONGO_SETTINGS"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("error finding config directory: %w", err)
+	}
+	return filepath.Join(dir, "gridirongo", "settings.json"), nil
+}
+
+// loadSettings reads the saved settings. A missing file gives the defaults.
+func loadSettings() (*userSettings, error) {
+	settings := &userSettings{Theme: themes[0].Name}
+
+	path, err := settingsPath()
+	if err != nil {
+		return settings, err
+	}
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return settings, nil
+	} else if err != nil {
+		return settings, fmt.Errorf("error reading settings: %w", err)
+	}
+
+	if err := json.Unmarshal(contents, settings); err != nil {
+		return &userSettings{Theme: themes[0].Name}, fmt.Errorf("error parsing settings %s: %w", path, err)
+	}
+	if findTheme(settings.Theme) == nil {
+		name := settings.Theme
+		settings.Theme = themes[0].Name
+		return settings, fmt.Errorf("unknown theme %q in %s", name, path)
+	}
+	return settings, nil
+}
+
+// saveSettings writes the settings, creating the config directory if needed
+func saveSettings(settings *userSettings) error {
+	path, err := settingsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("error creating settings directory: %w", err)
+	}
+
+	contents, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding settings: %w", err)
+	}
+	if err := os.WriteFile(path, append(contents, '\n'), 0o644); err != nil {
+		return fmt.Errorf("error writing settings: %w", err)
+	}
+	return nil
+}
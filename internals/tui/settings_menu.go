@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var useThemeKey = key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "use theme"))
+
+// settingsSavedMsg reports the result of saving the settings file
+type settingsSavedMsg struct {
+	err error
+}
+
+// settingsScreen lets the user pick a theme, previewing the highlighted one
+type settingsScreen struct {
+	session *session
+	cursor  int
+	saved   bool
+	err     error
+}
+
+func newSettingsScreen(s *session) Screen {
+	m := &settingsScreen{session: s}
+	for i, theme := range themes {
+		if theme.Name == s.settings.Theme {
+			m.cursor = i
+		}
+	}
+	return m
+}
+
+func (m *settingsScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (m *settingsScreen) Title() string {
+	return "Settings"
+}
+
+func (m *settingsScreen) Keys() []key.Binding {
+	return []key.Binding{upKey, downKey, useThemeKey}
+}
+
+func (m *settingsScreen) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case settingsSavedMsg:
+		m.saved, m.err = msg.err == nil, msg.err
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, upKey):
+			m.cursor = (m.cursor + len(themes) - 1) % len(themes)
+		case key.Matches(msg, downKey):
+			m.cursor = (m.cursor + 1) % len(themes)
+		case key.Matches(msg, useThemeKey):
+			s := m.session
+			s.setTheme(themes[m.cursor])
+			s.settings.Theme = themes[m.cursor].Name
+			settings := *s.settings
+			return m, func() tea.Msg {
+				return settingsSavedMsg{err: saveSettings(&settings)}
+			}
+		}
+		m.saved, m.err = false, nil
+	}
+	return m, nil
+}
+
+func (m *settingsScreen) View() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Theme"))
+	b.WriteString("\n")
+	if noColor() {
+		b.WriteString(subtleStyle.Render("NO_COLOR is set, so colors stay off whichever theme is picked"))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	for i, theme := range themes {
+		line := fmt.Sprintf("%-14s %s", theme.Name, theme.Description)
+		marker := "  "
+		if theme.Name == m.session.settings.Theme {
+			marker = "* "
+		}
+		if i == m.cursor {
+			line = selectedStyle.Render(line)
+		}
+		b.WriteString(marker + line + "\n")
+	}
+
+	switch {
+	case m.err != nil:
+		b.WriteString("\n" + errorStyle.Render(m.err.Error()) + "\n")
+	case m.saved:
+		path, _ := settingsPath()
+		b.WriteString("\n" + goodStyle.Render("Saved to "+path) + "\n")
+	}
+
+	// Render the preview in the highlighted theme, then switch back
+	current := m.session.theme
+	m.session.setTheme(themes[m.cursor])
+	preview := m.preview()
+	m.session.setTheme(current)
+
+	b.WriteString("\n")
+	b.WriteString(preview)
+	return b.String()
+}
+
+// preview renders a sample of each style in the current theme
+func (m *settingsScreen) preview() string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Width(40).Render("GridironGo › Preview"))
+	b.WriteString("\n")
+	b.WriteString(titleStyle.Render("Title") + "  " + selectedStyle.Render("Selected") + "  " + subtleStyle.Render("Subtle"))
+	b.WriteString("\n")
+	b.WriteString(goodStyle.Render("Win") + "  " + errorStyle.Render("Error"))
+	b.WriteString("\n\n")
+
+	abbreviations := make([]string, 0, len(m.session.teamColors))
+	for abbreviation := range m.session.teamColors {
+		abbreviations = append(abbreviations, abbreviation)
+	}
+	sort.Strings(abbreviations)
+	if len(abbreviations) == 0 {
+		b.WriteString(subtleStyle.Render("Scrape teams to see team colors"))
+	}
+	for i, abbreviation := range abbreviations {
+		if i > 0 && i%8 == 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(m.session.teamBadge(abbreviation, 3) + " ")
+	}
+
+	return boxStyle.Render(strings.TrimRight(b.String(), " "))
+}
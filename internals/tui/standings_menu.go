@@ -0,0 +1,493 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// Standings tabs
+const (
+	standingsTab = iota
+	bracketTab
+	historyTab
+)
+
+var standingsTabNames = []string{"Standings", "Playoffs", "History"}
+
+var (
+	standingsTabKey = key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "standings/playoffs/history"))
+	advanceWeekKey  = key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "play next week"))
+)
+
+// Messages loaded by the standings screen
+type (
+	seasonReportMsg struct {
+		report *league.SeasonReport
+		err    error
+	}
+	historyMsg struct {
+		history *league.History
+		err     error
+	}
+	completedWeeksMsg struct {
+		weeks    []int
+		injuries league.Injuries // The next week's injury report
+		err      error
+	}
+	seasonSavedMsg struct {
+		err error
+	}
+)
+
+// standingsScreen shows the current season's standings and playoff bracket
+// and the league's history
+type standingsScreen struct {
+	session  *session
+	tab      int
+	viewport viewport.Model
+
+	report  *league.SeasonReport
+	history *league.History
+
+	loading        bool
+	loadingHistory bool
+	err            error
+}
+
+func newStandingsScreen(s *session) Screen {
+	return &standingsScreen{
+		session:  s,
+		viewport: viewport.New(s.width, s.bodyHeight()-3),
+		loading:  s.season != nil,
+	}
+}
+
+func (m *standingsScreen) Init() tea.Cmd {
+	return m.loadReport()
+}
+
+func (m *standingsScreen) Title() string {
+	return "Standings"
+}
+
+func (m *standingsScreen) Keys() []key.Binding {
+	if m.session.season != nil {
+		return []key.Binding{standingsTabKey, advanceWeekKey, upKey, downKey, pageUpKey, pageDnKey}
+	}
+	return []key.Binding{standingsTabKey, upKey, downKey, pageUpKey, pageDnKey}
+}
+
+// loadCompletedWeeks finds which weeks of the season's NFL games are final
+// and the next week's injuries, so the season can advance
+func (m *standingsScreen) loadCompletedWeeks() tea.Cmd {
+	s := m.session
+	year, next := s.season.Year, int64(s.season.Week+1)
+	return func() tea.Msg {
+		weeks, err := league.CompletedWeeks(s.ctx, s.db, year, time.Now())
+		if err != nil {
+			return completedWeeksMsg{err: err}
+		}
+		injuries, err := league.LoadInjuries(s.ctx, s.db, year, next)
+		return completedWeeksMsg{weeks: weeks, injuries: injuries, err: err}
+	}
+}
+
+// advance plays the season's next week, then saves and rescores it
+func (m *standingsScreen) advance(completed []int, injuries league.Injuries) tea.Cmd {
+	s := m.session
+	if _, err := s.season.Advance(s.rules, completed, injuries); err != nil {
+		m.err = err
+		return nil
+	}
+
+	m.loading, m.err = true, nil
+	m.history = nil
+	cmds := []tea.Cmd{m.loadReport()}
+	if season := s.season; season.LeagueID != 0 {
+		cmds = append(cmds, func() tea.Msg {
+			return seasonSavedMsg{err: league.SaveSeason(s.ctx, s.db, season)}
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+// loadReport plays the session's season through its completed weeks
+func (m *standingsScreen) loadReport() tea.Cmd {
+	s := m.session
+	season := s.season
+	if season == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		report, err := s.scorer.PlaySeason(s.ctx, season)
+		return seasonReportMsg{report: report, err: err}
+	}
+}
+
+// loadHistory plays every saved season of the league
+func (m *standingsScreen) loadHistory() tea.Cmd {
+	s := m.session
+	m.loadingHistory = true
+	return func() tea.Msg {
+		seasons := []*league.Season{}
+		if s.league.ID != 0 {
+			saved, err := league.LoadSeasons(s.ctx, s.db, s.league.ID)
+			if err != nil {
+				return historyMsg{err: err}
+			}
+			seasons = saved
+		} else if s.season != nil {
+			seasons = append(seasons, s.season)
+		}
+
+		reports := make([]*league.SeasonReport, 0, len(seasons))
+		for _, season := range seasons {
+			report, err := s.scorer.PlaySeason(s.ctx, season)
+			if err != nil {
+				return historyMsg{err: err}
+			}
+			reports = append(reports, report)
+		}
+		return historyMsg{history: league.LeagueHistory(reports)}
+	}
+}
+
+func (m *standingsScreen) Update(msg tea.Msg) (Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.viewport.Width = msg.Width
+		m.viewport.Height = m.session.bodyHeight() - 3
+		m.refresh()
+		return m, nil
+
+	case seasonReportMsg:
+		m.loading = false
+		m.report, m.err = msg.report, msg.err
+		m.refresh()
+		return m, nil
+
+	case historyMsg:
+		m.loadingHistory = false
+		m.history, m.err = msg.history, msg.err
+		m.refresh()
+		return m, nil
+
+	case completedWeeksMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		cmd := m.advance(msg.weeks, msg.injuries)
+		m.refresh()
+		return m, cmd
+
+	case seasonSavedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+		}
+		return m, nil
+
+	case leagueChangedMsg:
+		m.report, m.history = nil, nil
+		m.loading = m.session.season != nil
+		m.refresh()
+		return m, m.loadReport()
+
+	case tea.KeyMsg:
+		if key.Matches(msg, advanceWeekKey) && m.session.season != nil && !m.loading {
+			return m, m.loadCompletedWeeks()
+		}
+		if key.Matches(msg, standingsTabKey) {
+			m.tab = (m.tab + 1) % len(standingsTabNames)
+			m.refresh()
+			m.viewport.GotoTop()
+			if m.tab == historyTab && m.history == nil && !m.loadingHistory {
+				return m, m.loadHistory()
+			}
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// refresh renders the selected tab into the viewport
+func (m *standingsScreen) refresh() {
+	var content string
+	switch {
+	case m.session.season == nil && m.tab != historyTab:
+		content = subtleStyle.Render("Complete a draft to start the season.")
+	case m.tab == historyTab:
+		content = m.historyView()
+	case m.loading || m.report == nil:
+		content = "Scoring the season..."
+	case m.tab == bracketTab:
+		content = bracketView(m.report)
+	default:
+		content = standingsView(m.report)
+	}
+	m.viewport.SetContent(content)
+}
+
+func (m *standingsScreen) View() string {
+	var b strings.Builder
+	tabs := make([]string, len(standingsTabNames))
+	for i, name := range standingsTabNames {
+		if i == m.tab {
+			tabs[i] = selectedStyle.Render("[" + name + "]")
+		} else {
+			tabs[i] = subtleStyle.Render(" " + name + " ")
+		}
+	}
+	b.WriteString(strings.Join(tabs, " "))
+	b.WriteString("\n")
+	if m.err != nil {
+		b.WriteString(errorStyle.Render(m.err.Error()))
+	}
+	b.WriteString("\n\n")
+	b.WriteString(m.viewport.View())
+	return b.String()
+}
+
+// weeksPlayed returns how many regular season weeks have results
+func weeksPlayed(report *league.SeasonReport) int {
+	weeks := 0
+	for _, result := range report.Results {
+		weeks = max(weeks, result.Week)
+	}
+	return weeks
+}
+
+// standingsView renders the standings table with each team's division and
+// playoff seed
+func standingsView(report *league.SeasonReport) string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%d season standings", report.Season.Year)))
+	if report.Season.Week == 0 {
+		b.WriteString(subtleStyle.Render("  not started, press a to play week 1"))
+	} else {
+		b.WriteString(subtleStyle.Render(fmt.Sprintf("  through week %d", weeksPlayed(report))))
+	}
+	b.WriteString("\n\n")
+
+	seeds := make(map[*league.Team]int)
+	for _, round := range report.Bracket.Rounds[:min(1, len(report.Bracket.Rounds))] {
+		for _, game := range round {
+			seeds[game.High] = game.HighSeed
+			if game.Low != nil {
+				seeds[game.Low] = game.LowSeed
+			}
+		}
+	}
+
+	header := fmt.Sprintf("%-3s %-24s %-8s %8s %8s %-6s %-8s %s", "#", "Team", "W-L-T", "PF", "PA", "Strk", "Div", "Seed")
+	b.WriteString(subtleStyle.Render(header))
+	b.WriteString("\n")
+	for i, standing := range report.Standings {
+		seed := ""
+		if n, ok := seeds[standing.Team]; ok {
+			seed = fmt.Sprintf("%d", n)
+		}
+		division := standing.Team.Division
+		if division == "" {
+			division = "-"
+		}
+		line := fmt.Sprintf("%-3d %-24s %-8s %8.2f %8.2f %-6s %-8s %s", i+1, truncate(standing.Team.Name, 24),
+			standing.Record(), standing.PointsFor, standing.PointsAgainst, standing.StreakText(), truncate(division, 8), seed)
+		if !standing.Team.Bot {
+			line = titleStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+
+	if !report.RegularSeasonDone {
+		b.WriteString("\n")
+		b.WriteString(subtleStyle.Render("Seeds are projected until the regular season ends."))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// bracketGameHeight is the rows a bracket game box takes, borders included
+const bracketGameHeight = 4
+
+// bracketView renders the playoff bracket as one column per round
+func bracketView(report *league.SeasonReport) string {
+	bracket := report.Bracket
+	if len(bracket.Rounds) == 0 {
+		return subtleStyle.Render("Not enough teams for a playoff.")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%d playoffs", report.Season.Year)))
+	if !report.RegularSeasonDone {
+		b.WriteString(subtleStyle.Render("  projected from the current standings"))
+	}
+	b.WriteString("\n\n")
+
+	gameBox := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(subtleColor).Width(30)
+	columns := make([]string, len(bracket.Rounds))
+	for r, round := range bracket.Rounds {
+		// Each game is centred against the two games that feed it
+		slot := (bracketGameHeight + 1) << r
+		games := make([]string, len(round))
+		for i, game := range round {
+			games[i] = lipgloss.PlaceVertical(slot, lipgloss.Center, gameBox.Render(bracketGameView(game)))
+		}
+		header := titleStyle.Render(bracket.RoundName(r)) + subtleStyle.Render(fmt.Sprintf(" · week %d", round[0].Week))
+		columns[r] = lipgloss.JoinVertical(lipgloss.Left, header, lipgloss.JoinVertical(lipgloss.Left, games...))
+		if r < len(bracket.Rounds)-1 {
+			columns[r] = lipgloss.NewStyle().MarginRight(2).Render(columns[r])
+		}
+	}
+	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, columns...))
+	b.WriteString("\n")
+
+	if bracket.Champion != nil {
+		b.WriteString("\n")
+		b.WriteString(goodStyle.Render("Champion: " + bracket.Champion.Name))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// bracketGameView renders the two lines of a bracket game
+func bracketGameView(game *league.BracketGame) string {
+	line := func(team *league.Team, seed int, score float64) string {
+		if team == nil {
+			return subtleStyle.Render("TBD")
+		}
+		text := fmt.Sprintf("(%d) %-18s", seed, truncate(team.Name, 18))
+		switch {
+		case game.Bye:
+			text += subtleStyle.Render("   bye")
+		case game.Played:
+			text += fmt.Sprintf(" %6.2f", score)
+		}
+		if game.Played && game.Winner == team {
+			return goodStyle.Render(text)
+		}
+		return text
+	}
+
+	low := line(game.Low, game.LowSeed, game.LowScore)
+	if game.Bye {
+		low = ""
+	}
+	return line(game.High, game.HighSeed, game.HighScore) + "\n" + low
+}
+
+// historyView renders past champions, league records and each team's
+// results season by season
+func (m *standingsScreen) historyView() string {
+	history := m.history
+	switch {
+	case m.loadingHistory || (history == nil && m.err == nil):
+		return "Loading league history..."
+	case history == nil:
+		return ""
+	case len(history.Teams) == 0:
+		return subtleStyle.Render("No seasons have been played in this league yet.")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Champions"))
+	b.WriteString("\n")
+	if len(history.Champions) == 0 {
+		b.WriteString(subtleStyle.Render("No season has finished yet."))
+		b.WriteString("\n")
+	}
+	for _, champion := range history.Champions {
+		b.WriteString(fmt.Sprintf("%d  %-24s %s\n", champion.Year, champion.Team.Name,
+			subtleStyle.Render(fmt.Sprintf("%d-%d-%d", champion.Wins, champion.Losses, champion.Ties))))
+	}
+
+	b.WriteString("\n")
+	b.WriteString(titleStyle.Render("Records"))
+	b.WriteString("\n")
+	if record := history.HighScore; record != nil {
+		b.WriteString(fmt.Sprintf("Highest single-week score  %.2f by %s (%d week %d)\n",
+			record.Points, record.Team.Name, record.Year, record.Week))
+	}
+	if record := history.LongestStreak; record != nil {
+		b.WriteString(fmt.Sprintf("Longest win streak         %d in a row by %s (%d)\n",
+			record.Length, record.Team.Name, record.Year))
+	}
+
+	// One column per season, newest first
+	var years []int64
+	seen := make(map[int64]bool)
+	for _, name := range history.Teams {
+		for _, season := range history.TeamSeasons[name] {
+			if !seen[season.Year] {
+				seen[season.Year] = true
+				years = append(years, season.Year)
+			}
+		}
+	}
+	sort.Slice(years, func(i, j int) bool { return years[i] > years[j] })
+
+	b.WriteString("\n")
+	b.WriteString(titleStyle.Render("Seasons"))
+	b.WriteString("\n")
+	header := fmt.Sprintf("%-24s", "Team")
+	for _, year := range years {
+		header += fmt.Sprintf(" %-14d", year)
+	}
+	b.WriteString(subtleStyle.Render(header))
+	b.WriteString("\n")
+
+	for _, name := range history.Teams {
+		byYear := make(map[int64]league.TeamSeason)
+		for _, season := range history.TeamSeasons[name] {
+			byYear[season.Year] = season
+		}
+
+		b.WriteString(fmt.Sprintf("%-24s", truncate(name, 24)))
+		for _, year := range years {
+			season, ok := byYear[year]
+			if !ok {
+				b.WriteString(fmt.Sprintf(" %-14s", "-"))
+				continue
+			}
+			cell := fmt.Sprintf("%d-%d-%d (%s)", season.Wins, season.Losses, season.Ties, ordinal(season.Rank))
+			if season.Champion {
+				b.WriteString(" " + goodStyle.Render(fmt.Sprintf("%-14s", cell+" *")))
+			} else {
+				b.WriteString(fmt.Sprintf(" %-14s", cell))
+			}
+		}
+		b.WriteString("\n")
+	}
+	if len(history.Champions) > 0 {
+		b.WriteString(subtleStyle.Render("* champion"))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// ordinal formats a finishing position, e.g. "1st" or "12th"
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -0,0 +1,73 @@
+package tui
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// Colors shared by every screen, set from the active theme by applyTheme
+var (
+	accentColor lipgloss.TerminalColor
+	subtleColor lipgloss.TerminalColor
+	errorColor  lipgloss.TerminalColor
+	goodColor   lipgloss.TerminalColor
+)
+
+// Styles shared by every screen, set from the active theme by applyTheme
+var (
+	headerStyle   lipgloss.Style
+	titleStyle    lipgloss.Style
+	selectedStyle lipgloss.Style
+	subtleStyle   lipgloss.Style
+	errorStyle    lipgloss.Style
+	goodStyle     lipgloss.Style
+	boxStyle      lipgloss.Style
+)
+
+func init() {
+	applyTheme(defaultTheme())
+}
+
+// applyTheme rebuilds the shared colors and styles from a theme
+func applyTheme(theme *colorTheme) {
+	accentColor = theme.Accent
+	subtleColor = theme.Subtle
+	errorColor = theme.Error
+	goodColor = theme.Good
+
+	headerStyle = lipgloss.NewStyle().Bold(true).Foreground(theme.HeaderText).Background(theme.Header).Padding(0, 1)
+	titleStyle = lipgloss.NewStyle().Bold(true).Foreground(accentColor)
+	selectedStyle = lipgloss.NewStyle().Bold(true).Foreground(accentColor)
+	subtleStyle = lipgloss.NewStyle().Foreground(subtleColor)
+	errorStyle = lipgloss.NewStyle().Foreground(errorColor)
+	goodStyle = lipgloss.NewStyle().Foreground(goodColor)
+	boxStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(accentColor).Padding(1, 2)
+
+	// Without color, emphasis has to come from text attributes alone. Lipgloss
+	// strips those too under NO_COLOR, so drop to a profile that keeps them;
+	// the theme's colors are all NoColor and render nothing.
+	if theme.NoColor {
+		lipgloss.SetColorProfile(termenv.ANSI)
+		headerStyle = headerStyle.Reverse(true)
+		selectedStyle = selectedStyle.Reverse(true)
+		errorStyle = errorStyle.Bold(true)
+		goodStyle = goodStyle.Underline(true)
+		subtleStyle = subtleStyle.Faint(true)
+	}
+}
+
+// plainHelpStyles are footer and help overlay styles without the help
+// package's built-in colors, for when NO_COLOR is set
+func plainHelpStyles() help.Styles {
+	faint := lipgloss.NewStyle().Faint(true)
+	return help.Styles{
+		Ellipsis:       faint,
+		ShortKey:       lipgloss.NewStyle().Bold(true),
+		ShortDesc:      faint,
+		ShortSeparator: faint,
+		FullKey:        lipgloss.NewStyle().Bold(true),
+		FullDesc:       faint,
+		FullSeparator:  faint,
+	}
+}
@@ -0,0 +1,159 @@
+package tui
+
+import (
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// colorTheme is a set of colors for the TUI
+type colorTheme struct {
+	Name        string
+	Description string
+
+	Accent     lipgloss.TerminalColor
+	Subtle     lipgloss.TerminalColor
+	Error      lipgloss.TerminalColor
+	Good       lipgloss.TerminalColor
+	Header     lipgloss.TerminalColor // Header bar background
+	HeaderText lipgloss.TerminalColor
+
+	TeamColors bool // Draw NFL team abbreviations in their team colors
+	NoColor    bool // Use bold, faint and reversed text in place of color
+}
+
+// themes are the themes users can pick from, the first being the default
+var themes = []*colorTheme{
+	{
+		Name:        "auto",
+		Description: "Follows the terminal's light or dark background",
+		Accent:      lipgloss.AdaptiveColor{Light: "#1D4ED8", Dark: "#60A5FA"},
+		Subtle:      lipgloss.AdaptiveColor{Light: "#6B7280", Dark: "#9CA3AF"},
+		Error:       lipgloss.AdaptiveColor{Light: "#B91C1C", Dark: "#F87171"},
+		Good:        lipgloss.AdaptiveColor{Light: "#15803D", Dark: "#4ADE80"},
+		Header:      lipgloss.Color("#1E3A8A"),
+		HeaderText:  lipgloss.Color("#FFFFFF"),
+		TeamColors:  true,
+	},
+	{
+		Name:        "dark",
+		Description: "Bright colors for dark terminals",
+		Accent:      lipgloss.Color("#60A5FA"),
+		Subtle:      lipgloss.Color("#9CA3AF"),
+		Error:       lipgloss.Color("#F87171"),
+		Good:        lipgloss.Color("#4ADE80"),
+		Header:      lipgloss.Color("#1E3A8A"),
+		HeaderText:  lipgloss.Color("#FFFFFF"),
+		TeamColors:  true,
+	},
+	{
+		Name:        "light",
+		Description: "Deep colors for light terminals",
+		Accent:      lipgloss.Color("#1D4ED8"),
+		Subtle:      lipgloss.Color("#6B7280"),
+		Error:       lipgloss.Color("#B91C1C"),
+		Good:        lipgloss.Color("#15803D"),
+		Header:      lipgloss.Color("#DBEAFE"),
+		HeaderText:  lipgloss.Color("#1E3A8A"),
+		TeamColors:  true,
+	},
+	{
+		Name:        "high-contrast",
+		Description: "The terminal's own bright colors, without team colors",
+		Accent:      lipgloss.AdaptiveColor{Light: "4", Dark: "14"},
+		Subtle:      lipgloss.AdaptiveColor{Light: "0", Dark: "15"},
+		Error:       lipgloss.AdaptiveColor{Light: "1", Dark: "9"},
+		Good:        lipgloss.AdaptiveColor{Light: "2", Dark: "10"},
+		Header:      lipgloss.AdaptiveColor{Light: "0", Dark: "15"},
+		HeaderText:  lipgloss.AdaptiveColor{Light: "15", Dark: "0"},
+	},
+}
+
+// noColorTheme is used whenever NO_COLOR is set, whatever the settings say
+var noColorTheme = &colorTheme{
+	Name:        "no-color",
+	Description: "Plain text, used when NO_COLOR is set",
+	Accent:      lipgloss.NoColor{},
+	Subtle:      lipgloss.NoColor{},
+	Error:       lipgloss.NoColor{},
+	Good:        lipgloss.NoColor{},
+	Header:      lipgloss.NoColor{},
+	HeaderText:  lipgloss.NoColor{},
+	NoColor:     true,
+}
+
+// defaultTheme returns the theme used before any settings are loaded
+func defaultTheme() *colorTheme {
+	if noColor() {
+		return noColorTheme
+	}
+	return themes[0]
+}
+
+// findTheme returns the theme with a name, or nil if there isn't one
+func findTheme(name string) *colorTheme {
+	for _, theme := range themes {
+		if theme.Name == name {
+			return theme
+		}
+	}
+	return nil
+}
+
+// noColor reports whether the user has asked for no color (https://no-color.org)
+func noColor() bool {
+	return os.Getenv("NO_COLOR") != ""
+}
+
+// teamColor is an NFL team's colors from the ESPN scrape
+type teamColor struct {
+	primary   string // Hex, without the leading #
+	secondary string
+}
+
+// teamBadge renders a team abbreviation padded to width. With team colors
+// on, it is drawn on the team's primary color in its secondary color, or in
+// black or white when the two are too close to read. Unknown teams and
+// themes without team colors get plain text.
+func (s *session) teamBadge(abbreviation string, width int) string {
+	padding := strings.Repeat(" ", max(width-lipgloss.Width(abbreviation), 0))
+
+	colors, ok := s.teamColors[abbreviation]
+	if !ok || !s.theme.TeamColors || !validHex(colors.primary) {
+		return abbreviation + padding
+	}
+
+	background := luminance(colors.primary)
+	foreground := "#FFFFFF"
+	switch {
+	case validHex(colors.secondary) && math.Abs(luminance(colors.secondary)-background) >= 0.4:
+		foreground = "#" + colors.secondary
+	case background > 0.5:
+		foreground = "#000000"
+	}
+	return lipgloss.NewStyle().
+		Foreground(lipgloss.Color(foreground)).
+		Background(lipgloss.Color("#"+colors.primary)).
+		Render(abbreviation) + padding
+}
+
+// validHex reports whether s is a six digit hex color without the #
+func validHex(s string) bool {
+	if len(s) != 6 {
+		return false
+	}
+	_, err := strconv.ParseUint(s, 16, 32)
+	return err == nil
+}
+
+// luminance returns the relative luminance of a hex color from 0 (black) to 1 (white)
+func luminance(hex string) float64 {
+	value, _ := strconv.ParseUint(hex, 16, 32)
+	r := float64(value>>16&0xFF) / 255
+	g := float64(value>>8&0xFF) / 255
+	b := float64(value&0xFF) / 255
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
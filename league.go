@@ -0,0 +1,157 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// leagueCommand builds the league command and its subcommands
+func leagueCommand() *command {
+	var presetFormat string
+	return &command{
+		name:    "league",
+		summary: "Manage fantasy leagues, their seasons and scoring presets",
+		subcommands: []*command{
+			{
+				name:    "list",
+				usage:   "[flags]",
+				summary: "List saved leagues",
+				run:     runLeagueList,
+			},
+			leagueCreateCommand(),
+			leagueDraftCommand(),
+			leagueTeamsCommand(),
+			leagueLineupCommand(),
+			leagueAdvanceCommand(),
+			leagueStandingsCommand(),
+			leagueScoreboardCommand(),
+			{
+				name:    "presets",
+				summary: "List the built-in scoring presets",
+				run: func(env *env, args []string) error {
+					if err := requireArgs(args, 0, "no arguments"); err != nil {
+						return err
+					}
+					printPresets(env.out)
+					return nil
+				},
+			},
+			{
+				name:    "preset",
+				usage:   "[flags] <name>",
+				summary: "Print a preset's scoring rules and how they differ from standard scoring",
+				flags: func(fs *flag.FlagSet) {
+					fs.StringVar(&presetFormat, "format", formatTable, "Output format: table, or json for a rules file 'league create -rules' accepts")
+				},
+				run: func(env *env, args []string) error {
+					if err := requireArgs(args, 1, "a preset name"); err != nil {
+						return err
+					}
+					if err := checkFormat(presetFormat); err != nil {
+						return err
+					}
+					if presetFormat == formatJSON {
+						return printPresetJSON(env.out, args[0])
+					}
+					return printPreset(env.out, args[0])
+				},
+			},
+		},
+	}
+}
+
+// runLeagueList prints every saved league, most recently updated first
+func runLeagueList(env *env, args []string) error {
+	if err := requireArgs(args, 0, "no arguments"); err != nil {
+		return err
+	}
+	db, err := env.openDB()
+	if err != nil {
+		return err
+	}
+	leagues, err := league.ListLeagues(env.ctx, db)
+	if err != nil {
+		return err
+	}
+	if len(leagues) == 0 {
+		fmt.Fprintln(env.out, "No leagues have been saved yet")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(env.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tName\tTeams\tDescription")
+	for _, l := range leagues {
+		fmt.Fprintf(w, "%d\t%s\t%d\t%s\n", l.ID, l.Rules.Name, l.Rules.TeamCount, l.Rules.Description)
+	}
+	return w.Flush()
+}
+
+// leagueFlags adds the flags for picking the rules a command scores with
+func leagueFlags(fs *flag.FlagSet, id *int64, preset *string) {
+	fs.Int64Var(id, "league", 0, "ID of a saved league whose rules to use (see 'league list')")
+	fs.StringVar(preset, "preset", "standard", "Scoring preset to use when no -league is given (see 'league presets')")
+}
+
+// leagueRules returns a saved league's rules, or a preset's when id is 0
+func leagueRules(env *env, id int64, preset string) (*league.LeagueRules, error) {
+	if id == 0 {
+		return league.PresetRules(preset)
+	}
+	db, err := env.openDB()
+	if err != nil {
+		return nil, err
+	}
+	l, err := league.LoadLeague(env.ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	return l.Rules, nil
+}
+
+// printPresets lists every registered scoring preset
+func printPresets(w io.Writer) {
+	fmt.Fprintln(w, "Available scoring presets:")
+	for _, p := range league.Presets() {
+		fmt.Fprintf(w, "  %-16s %s\n", p.Name, p.Description)
+	}
+}
+
+// printPreset prints the scoring rules of a preset and how it differs from standard scoring
+func printPreset(w io.Writer, name string) error {
+	rules, err := league.PresetRules(name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(w, rules.PrintScoringRules())
+
+	diffs, err := rules.DiffFromPreset("standard")
+	if err != nil {
+		return err
+	}
+	if len(diffs) > 0 {
+		fmt.Fprintln(w, "Differences from standard:")
+		for _, diff := range diffs {
+			fmt.Fprintf(w, "  %s\n", diff)
+		}
+	}
+	return nil
+}
+
+// printPresetJSON prints a preset's rules as JSON
+func printPresetJSON(w io.Writer, name string) error {
+	rules, err := league.PresetRules(name)
+	if err != nil {
+		return err
+	}
+	rulesJSON, err := rules.ToJSON()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, rulesJSON)
+	return nil
+}
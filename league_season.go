@@ -0,0 +1,658 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// Output formats for the commands that print league data
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// formatFlag adds the -format flag
+func formatFlag(fs *flag.FlagSet, format *string) {
+	fs.StringVar(format, "format", formatTable, "Output format: table or json")
+}
+
+// checkFormat rejects an unknown -format value
+func checkFormat(format string) error {
+	if format != formatTable && format != formatJSON {
+		return fmt.Errorf("unknown format %q; use table or json", format)
+	}
+	return nil
+}
+
+// writeJSON prints v as indented JSON
+func writeJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("error encoding JSON: %w", err)
+	}
+	return nil
+}
+
+// loadLeague loads the saved league named by a league ID argument
+func loadLeague(env *env, arg string) (*league.League, error) {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || id <= 0 {
+		return nil, fmt.Errorf("invalid league ID %q; see 'gridirongo league list'", arg)
+	}
+	db, err := env.openDB()
+	if err != nil {
+		return nil, err
+	}
+	return league.LoadLeague(env.ctx, db, id)
+}
+
+// loadSeason loads a league's drafted season for a year, or its latest
+// season when year is 0
+func loadSeason(env *env, l *league.League, year int64) (*league.Season, error) {
+	seasons, err := league.LoadSeasons(env.ctx, env.db, l.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, season := range seasons {
+		if year == 0 || season.Year == year {
+			return season, nil
+		}
+	}
+	if year != 0 {
+		return nil, fmt.Errorf("league %d hasn't drafted a %d season; run 'gridirongo league draft -season %d %d' first", l.ID, year, year, l.ID)
+	}
+	return nil, fmt.Errorf("league %d hasn't drafted a season; run 'gridirongo league draft %d' first", l.ID, l.ID)
+}
+
+// playSeason scores a season's completed weeks with its league's rules
+func playSeason(env *env, l *league.League, season *league.Season) (*league.SeasonReport, error) {
+	return league.NewScorer(env.db, l.Rules).PlaySeason(env.ctx, season)
+}
+
+// findTeam finds a team by ID or case-insensitive name
+func findTeam(season *league.Season, arg string) (*league.Team, error) {
+	id, err := strconv.Atoi(arg)
+	for _, team := range season.Teams {
+		if (err == nil && team.ID == id) || strings.EqualFold(team.Name, arg) {
+			return team, nil
+		}
+	}
+	return nil, fmt.Errorf("no team %q in the %d season; see 'gridirongo league teams'", arg, season.Year)
+}
+
+// leagueCreateCommand saves a new league from a rules file or a preset
+func leagueCreateCommand() *command {
+	var rulesPath, preset, name string
+	return &command{
+		name:    "create",
+		usage:   "[flags]",
+		summary: "Create a league from a rules JSON file or a preset and print its ID",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&rulesPath, "rules", "", "Rules JSON file ('-' for stdin)")
+			fs.StringVar(&preset, "preset", "standard", "Scoring preset to use when no -rules file is given")
+			fs.StringVar(&name, "name", "", "League name (default: the name in the rules)")
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 0, "no arguments"); err != nil {
+				return err
+			}
+
+			var rules *league.LeagueRules
+			var err error
+			if rulesPath != "" {
+				contents, readErr := readInput(rulesPath)
+				if readErr != nil {
+					return readErr
+				}
+				rules, err = league.FromJSON(string(contents))
+			} else {
+				rules, err = league.PresetRules(preset)
+			}
+			if err != nil {
+				return err
+			}
+			if name != "" {
+				rules.Name = name
+			}
+
+			db, err := env.openDB()
+			if err != nil {
+				return err
+			}
+			l := &league.League{Rules: rules}
+			if err := league.SaveLeague(env.ctx, db, l); err != nil {
+				return err
+			}
+			fmt.Fprintln(env.out, l.ID)
+			return nil
+		},
+	}
+}
+
+// readInput reads a file, or stdin when path is "-"
+func readInput(path string) ([]byte, error) {
+	var contents []byte
+	var err error
+	if path == "-" {
+		contents, err = io.ReadAll(os.Stdin)
+	} else {
+		contents, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", path, err)
+	}
+	return contents, nil
+}
+
+// leagueDraftCommand runs a draft with every pick made automatically
+func leagueDraftCommand() *command {
+	var (
+		year    int64
+		names   string
+		replace bool
+	)
+	return &command{
+		name:    "draft",
+		usage:   "[flags] <league-id>",
+		summary: "Run a draft to completion with every pick made automatically",
+		flags: func(fs *flag.FlagSet) {
+			fs.Int64Var(&year, "season", 0, "NFL season to draft and play (default: the latest scraped season)")
+			fs.StringVar(&names, "teams", "", "Comma-separated team names; teams without a name are bots")
+			fs.BoolVar(&replace, "replace", false, "Replace the league's existing draft for the season")
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 1, "a league ID"); err != nil {
+				return err
+			}
+			l, err := loadLeague(env, args[0])
+			if err != nil {
+				return err
+			}
+			rules := l.Rules
+
+			if year == 0 {
+				seasons, err := env.db.GetSeasons(env.ctx)
+				if err != nil {
+					return fmt.Errorf("error getting seasons: %w", err)
+				}
+				if len(seasons) == 0 {
+					return fmt.Errorf("no games have been scraped yet; run 'gridirongo scrape all' first")
+				}
+				year = seasons[0]
+			}
+			if !replace {
+				if _, err := loadSeason(env, l, year); err == nil {
+					return fmt.Errorf("league %d has already drafted its %d season; pass -replace to draft it again", l.ID, year)
+				}
+			}
+
+			var owners []string
+			if names != "" {
+				owners = strings.Split(names, ",")
+			}
+			if len(owners) > rules.TeamCount {
+				return fmt.Errorf("got %d team names but the league has %d teams", len(owners), rules.TeamCount)
+			}
+			teams := make([]*league.Team, rules.TeamCount)
+			for i := range teams {
+				teams[i] = &league.Team{ID: i + 1, Name: fmt.Sprintf("Team %d", i+1), Bot: true}
+				if i < len(owners) && strings.TrimSpace(owners[i]) != "" {
+					teams[i].Name, teams[i].Bot = strings.TrimSpace(owners[i]), false
+				}
+			}
+			league.AssignDivisions(teams, rules.Divisions)
+
+			pool, err := league.NewScorer(env.db, rules).Rankings(env.ctx, year)
+			if err != nil {
+				return err
+			}
+			// Players on injured reserve for week 1 can be drafted into IR slots
+			injuries, err := league.LoadInjuries(env.ctx, env.db, int64(year), 1)
+			if err != nil {
+				return err
+			}
+			draft, err := league.NewDraft(rules, teams, pool, injuries)
+			if err != nil {
+				return fmt.Errorf("error creating draft: %w", err)
+			}
+			for !draft.Done() {
+				if _, err := draft.AutoPick(); err != nil {
+					return err
+				}
+			}
+
+			season := &league.Season{LeagueID: l.ID, Year: year, Teams: draft.Teams()}
+			if err := league.SaveSeason(env.ctx, env.db, season); err != nil {
+				return err
+			}
+			fmt.Fprintf(env.out, "Drafted the %d season: %d teams, %d picks\n", year, len(teams), draft.TotalPicks())
+			return nil
+		},
+	}
+}
+
+// leagueTeamsCommand prints a season's teams and rosters
+func leagueTeamsCommand() *command {
+	var (
+		year   int64
+		format string
+	)
+	return &command{
+		name:    "teams",
+		usage:   "[flags] <league-id>",
+		summary: "Print a season's teams, rosters and starters",
+		flags: func(fs *flag.FlagSet) {
+			fs.Int64Var(&year, "season", 0, "Drafted season to print (default: the league's latest)")
+			formatFlag(fs, &format)
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 1, "a league ID"); err != nil {
+				return err
+			}
+			if err := checkFormat(format); err != nil {
+				return err
+			}
+			l, err := loadLeague(env, args[0])
+			if err != nil {
+				return err
+			}
+			season, err := loadSeason(env, l, year)
+			if err != nil {
+				return err
+			}
+			if format == formatJSON {
+				return writeJSON(env.out, season.Teams)
+			}
+			injuries, err := league.LoadInjuries(env.ctx, env.db, season.Year, int64(season.Week+1))
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(env.out, 0, 0, 2, ' ', 0)
+			for i, team := range season.Teams {
+				if i > 0 {
+					fmt.Fprintln(w)
+				}
+				fmt.Fprintf(w, "%d\t%s", team.ID, team.Name)
+				if team.Division != "" {
+					fmt.Fprintf(w, " (%s)", team.Division)
+				}
+				if team.Bot {
+					fmt.Fprint(w, " [bot]")
+				}
+				fmt.Fprintln(w)
+
+				starters := team.StarterIDs(l.Rules.RosterPositions, injuries)
+				for _, player := range team.Roster {
+					status := "bench"
+					if slices.Contains(starters, player.ID) {
+						status = "start"
+					}
+					injury := ""
+					if i, ok := injuries[player.ID]; ok {
+						injury = i.String()
+					}
+					fmt.Fprintf(w, "\t%s\t%s\t%s\t%s\t%s\t%s\n", status, player.Position, player.Name, player.NFLTeam, player.ID, injury)
+				}
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// leagueLineupCommand sets a team's starters from a file
+func leagueLineupCommand() *command {
+	var (
+		year  int64
+		force bool
+	)
+	return &command{
+		name:  "lineup",
+		usage: "[flags] <league-id> <team> <file|->",
+		summary: "Set a team's starters from the next week on. The file lists one player ID or name per line;\n" +
+			"lines starting with # are ignored, and an empty file switches the team to automatic lineups.",
+		flags: func(fs *flag.FlagSet) {
+			fs.Int64Var(&year, "season", 0, "Drafted season to set the lineup in (default: the league's latest)")
+			fs.BoolVar(&force, "force", false, "Start players who are out or on injured reserve")
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 3, "a league ID, a team ID or name and a lineup file"); err != nil {
+				return err
+			}
+			l, err := loadLeague(env, args[0])
+			if err != nil {
+				return err
+			}
+			season, err := loadSeason(env, l, year)
+			if err != nil {
+				return err
+			}
+			team, err := findTeam(season, args[1])
+			if err != nil {
+				return err
+			}
+			contents, err := readInput(args[2])
+			if err != nil {
+				return err
+			}
+
+			var starters []string
+			scanner := bufio.NewScanner(strings.NewReader(string(contents)))
+			for scanner.Scan() {
+				line := strings.TrimSpace(scanner.Text())
+				if line == "" || strings.HasPrefix(line, "#") {
+					continue
+				}
+				i := slices.IndexFunc(team.Roster, func(p league.Player) bool {
+					return p.ID == line || strings.EqualFold(p.Name, line)
+				})
+				if i < 0 {
+					return fmt.Errorf("%q isn't on %s's roster", line, team.Name)
+				}
+				starters = append(starters, team.Roster[i].ID)
+			}
+
+			if err := league.SetLineup(team, l.Rules.RosterPositions, starters); err != nil {
+				return err
+			}
+			injuries, err := league.LoadInjuries(env.ctx, env.db, season.Year, int64(season.Week+1))
+			if err != nil {
+				return err
+			}
+			warnings, err := league.ValidateLineup(team, starters, injuries)
+			if err != nil && !force {
+				return fmt.Errorf("%w (use -force to start them anyway)", err)
+			}
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("%v; starting them anyway", err))
+			}
+			for _, warning := range warnings {
+				fmt.Fprintf(env.out, "Warning: %s\n", warning)
+			}
+			if err := league.SaveSeason(env.ctx, env.db, season); err != nil {
+				return err
+			}
+			if len(starters) == 0 {
+				fmt.Fprintf(env.out, "%s now sets its lineup automatically\n", team.Name)
+			} else {
+				fmt.Fprintf(env.out, "Set %s's lineup for week %d on\n", team.Name, season.Week+1)
+			}
+			return nil
+		},
+	}
+}
+
+// leagueAdvanceCommand plays a season's next week
+func leagueAdvanceCommand() *command {
+	var (
+		year   int64
+		format string
+	)
+	return &command{
+		name:    "advance",
+		usage:   "[flags] <league-id>",
+		summary: "Play the season's next week, locking in lineups, and print its scoreboard",
+		flags: func(fs *flag.FlagSet) {
+			fs.Int64Var(&year, "season", 0, "Drafted season to advance (default: the league's latest)")
+			formatFlag(fs, &format)
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 1, "a league ID"); err != nil {
+				return err
+			}
+			if err := checkFormat(format); err != nil {
+				return err
+			}
+			l, err := loadLeague(env, args[0])
+			if err != nil {
+				return err
+			}
+			season, err := loadSeason(env, l, year)
+			if err != nil {
+				return err
+			}
+
+			completed, err := league.CompletedWeeks(env.ctx, env.db, season.Year, time.Now())
+			if err != nil {
+				return err
+			}
+			injuries, err := league.LoadInjuries(env.ctx, env.db, season.Year, int64(season.Week+1))
+			if err != nil {
+				return err
+			}
+			week, err := season.Advance(l.Rules, completed, injuries)
+			if err != nil {
+				return err
+			}
+			if err := league.SaveSeason(env.ctx, env.db, season); err != nil {
+				return err
+			}
+
+			report, err := playSeason(env, l, season)
+			if err != nil {
+				return err
+			}
+			return printScoreboard(env.out, format, report, week)
+		},
+	}
+}
+
+// standingRow is a standing as printed by 'league standings -format json'
+type standingRow struct {
+	Rank          int     `json:"rank"`
+	TeamID        int     `json:"team_id"`
+	Team          string  `json:"team"`
+	Division      string  `json:"division,omitempty"`
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	Ties          int     `json:"ties"`
+	PointsFor     float64 `json:"points_for"`
+	PointsAgainst float64 `json:"points_against"`
+	Streak        int     `json:"streak"`
+}
+
+// leagueStandingsCommand prints a season's standings
+func leagueStandingsCommand() *command {
+	var (
+		year   int64
+		format string
+	)
+	return &command{
+		name:    "standings",
+		usage:   "[flags] <league-id>",
+		summary: "Print a season's standings through the last week played",
+		flags: func(fs *flag.FlagSet) {
+			fs.Int64Var(&year, "season", 0, "Drafted season to print (default: the league's latest)")
+			formatFlag(fs, &format)
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 1, "a league ID"); err != nil {
+				return err
+			}
+			if err := checkFormat(format); err != nil {
+				return err
+			}
+			l, err := loadLeague(env, args[0])
+			if err != nil {
+				return err
+			}
+			season, err := loadSeason(env, l, year)
+			if err != nil {
+				return err
+			}
+			report, err := playSeason(env, l, season)
+			if err != nil {
+				return err
+			}
+
+			rows := make([]standingRow, len(report.Standings))
+			for i, standing := range report.Standings {
+				rows[i] = standingRow{
+					Rank:          i + 1,
+					TeamID:        standing.Team.ID,
+					Team:          standing.Team.Name,
+					Division:      standing.Team.Division,
+					Wins:          standing.Wins,
+					Losses:        standing.Losses,
+					Ties:          standing.Ties,
+					PointsFor:     standing.PointsFor,
+					PointsAgainst: standing.PointsAgainst,
+					Streak:        standing.Streak,
+				}
+			}
+			if format == formatJSON {
+				return writeJSON(env.out, rows)
+			}
+
+			fmt.Fprintf(env.out, "%s, %d season through week %d\n\n", l.Rules.Name, season.Year, season.Week)
+			w := tabwriter.NewWriter(env.out, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "#\tTeam\tW-L-T\tPF\tPA\tStrk\tDiv")
+			for i, standing := range report.Standings {
+				division := standing.Team.Division
+				if division == "" {
+					division = "-"
+				}
+				fmt.Fprintf(w, "%d\t%s\t%s\t%.2f\t%.2f\t%s\t%s\n", rows[i].Rank, standing.Team.Name, standing.Record(),
+					standing.PointsFor, standing.PointsAgainst, standing.StreakText(), division)
+			}
+			if report.Bracket.Champion != nil {
+				fmt.Fprintf(w, "\nChampion: %s\n", report.Bracket.Champion.Name)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// leagueScoreboardCommand prints a played week's results
+func leagueScoreboardCommand() *command {
+	var (
+		year   int64
+		week   int
+		format string
+	)
+	return &command{
+		name:    "scoreboard",
+		usage:   "[flags] <league-id>",
+		summary: "Print the results of a played week, regular season or playoffs",
+		flags: func(fs *flag.FlagSet) {
+			fs.Int64Var(&year, "season", 0, "Drafted season to print (default: the league's latest)")
+			fs.IntVar(&week, "week", 0, "Week to print (default: the last week played)")
+			formatFlag(fs, &format)
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 1, "a league ID"); err != nil {
+				return err
+			}
+			if err := checkFormat(format); err != nil {
+				return err
+			}
+			l, err := loadLeague(env, args[0])
+			if err != nil {
+				return err
+			}
+			season, err := loadSeason(env, l, year)
+			if err != nil {
+				return err
+			}
+			if week == 0 {
+				week = season.Week
+			}
+			if week < 1 || week > season.Week {
+				if season.Week == 0 {
+					return fmt.Errorf("the %d season hasn't started; run 'gridirongo league advance %d' to play week 1", season.Year, l.ID)
+				}
+				return fmt.Errorf("week %d hasn't been played; the %d season is through week %d", week, season.Year, season.Week)
+			}
+
+			report, err := playSeason(env, l, season)
+			if err != nil {
+				return err
+			}
+			return printScoreboard(env.out, format, report, week)
+		},
+	}
+}
+
+// gameRow is a scored game as printed by -format json
+type gameRow struct {
+	Week      int     `json:"week"`
+	Round     string  `json:"round,omitempty"` // Playoff round, empty in the regular season
+	Home      string  `json:"home"`
+	HomeScore float64 `json:"home_score"`
+	Away      string  `json:"away"`
+	AwayScore float64 `json:"away_score"`
+	Winner    string  `json:"winner,omitempty"` // Empty for a tie
+}
+
+// weekGames returns a week's scored games, from the regular season results
+// or the playoff bracket
+func weekGames(report *league.SeasonReport, week int) []gameRow {
+	var games []gameRow
+	for _, result := range report.Results {
+		if result.Week != week {
+			continue
+		}
+		game := gameRow{Week: week, Home: result.Home.Name, HomeScore: result.HomeScore, Away: result.Away.Name, AwayScore: result.AwayScore}
+		if winner := result.Winner(); winner != nil {
+			game.Winner = winner.Name
+		}
+		games = append(games, game)
+	}
+
+	for round, bracketGames := range report.Bracket.Rounds {
+		for _, bracketGame := range bracketGames {
+			if bracketGame.Week != week || bracketGame.Bye || !bracketGame.Played {
+				continue
+			}
+			games = append(games, gameRow{
+				Week:      week,
+				Round:     report.Bracket.RoundName(round),
+				Home:      bracketGame.High.Name,
+				HomeScore: bracketGame.HighScore,
+				Away:      bracketGame.Low.Name,
+				AwayScore: bracketGame.LowScore,
+				Winner:    bracketGame.Winner.Name,
+			})
+		}
+	}
+	return games
+}
+
+// printScoreboard prints a week's scored games as a table or JSON
+func printScoreboard(w io.Writer, format string, report *league.SeasonReport, week int) error {
+	games := weekGames(report, week)
+	if format == formatJSON {
+		if games == nil {
+			games = []gameRow{}
+		}
+		return writeJSON(w, games)
+	}
+
+	fmt.Fprintf(w, "Week %d, %d season\n\n", week, report.Season.Year)
+	if len(games) == 0 {
+		fmt.Fprintln(w, "No games were played")
+		return nil
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Round\tHome\tScore\tAway\tScore")
+	for _, game := range games {
+		round := game.Round
+		if round == "" {
+			round = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%.2f\t%s\t%.2f\n", round, game.Home, game.HomeScore, game.Away, game.AwayScore)
+	}
+	return tw.Flush()
+}
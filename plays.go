@@ -0,0 +1,159 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// playsOutput is the plays command's JSON output
+type playsOutput struct {
+	*league.PlaySplits
+	Name        string              `json:"name"`
+	TargetShare float64             `json:"target_share"`
+	PlayList    []league.PlayerPlay `json:"play_list,omitempty"`
+}
+
+// playsCommand builds the plays command, which prints a player's
+// play-by-play splits for a season
+func playsCommand() *command {
+	var (
+		season int64
+		list   bool
+		format string
+	)
+	return &command{
+		name:    "plays",
+		usage:   "[flags] <player ID>",
+		summary: "Print a player's play-by-play splits for a season",
+		flags: func(fs *flag.FlagSet) {
+			fs.Int64Var(&season, "season", 0, "Season to split (default: the latest scraped season)")
+			fs.BoolVar(&list, "list", false, "Also list every play the player was in")
+			formatFlag(fs, &format)
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 1, "a player ID"); err != nil {
+				return err
+			}
+			if err := checkFormat(format); err != nil {
+				return err
+			}
+			db, err := env.openDB()
+			if err != nil {
+				return err
+			}
+
+			player, err := db.Queries.GetNFLPlayer(env.ctx, args[0])
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("no NFL player %q", args[0])
+			} else if err != nil {
+				return fmt.Errorf("error getting player %s: %w", args[0], err)
+			}
+			if season == 0 {
+				seasons, err := db.GetSeasons(env.ctx)
+				if err != nil {
+					return fmt.Errorf("error getting seasons: %w", err)
+				}
+				if len(seasons) == 0 {
+					return fmt.Errorf("no games have been scraped yet; run 'gridirongo scrape all' first")
+				}
+				season = seasons[0]
+			}
+
+			splits, err := league.LoadPlaySplits(env.ctx, db.Queries, player.PlayerID, season)
+			if err != nil {
+				return err
+			}
+			var plays []league.PlayerPlay
+			if list {
+				if plays, err = league.LoadPlayerPlays(env.ctx, db.Queries, player.PlayerID, season); err != nil {
+					return err
+				}
+			}
+			if format == formatJSON {
+				return writeJSON(env.out, playsOutput{PlaySplits: splits, Name: player.FullName, TargetShare: splits.TargetShare(), PlayList: plays})
+			}
+
+			fmt.Fprintf(env.out, "%s, %d: %d plays in %d games\n", player.FullName, season, splits.Plays, splits.Games)
+			if splits.Plays == 0 {
+				fmt.Fprintln(env.out, "\nNo plays have been scraped for them; run 'gridirongo scrape stats' first")
+				return nil
+			}
+			printSplits(env, splits)
+			if list {
+				return printPlays(env, plays)
+			}
+			return nil
+		},
+	}
+}
+
+// printSplits prints the passing, rushing, receiving and kicking splits the
+// player has plays for
+func printSplits(env *env, splits *league.PlaySplits) {
+	w := tabwriter.NewWriter(env.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(env.out)
+	if splits.PassAttempts > 0 || splits.Sacked > 0 {
+		fmt.Fprintln(w, "Passing\tAtt\tCmp\tYds\tTD\tInt\tSacked\tRZ att")
+		fmt.Fprintf(w, "\t%d\t%d\t%d\t%d\t%d\t%d\t%d\n", splits.PassAttempts, splits.Completions, splits.PassingYards,
+			splits.PassingTouchdowns, splits.Interceptions, splits.Sacked, splits.RedZonePassAttempts)
+	}
+	if splits.Rushes > 0 {
+		fmt.Fprintln(w, "Rushing\tAtt\tYds\tTD\tRZ att")
+		fmt.Fprintf(w, "\t%d\t%d\t%d\t%d\n", splits.Rushes, splits.RushingYards, splits.RushingTouchdowns, splits.RedZoneRushes)
+	}
+	if splits.Targets > 0 {
+		fmt.Fprintln(w, "Receiving\tTgt\tRec\tYds\tTD\tRZ tgt\tTgt share")
+		fmt.Fprintf(w, "\t%d\t%d\t%d\t%d\t%d\t%.1f%%\n", splits.Targets, splits.Receptions, splits.ReceivingYards,
+			splits.ReceivingTouchdowns, splits.RedZoneTargets, splits.TargetShare()*100)
+	}
+	w.Flush()
+
+	if len(splits.TouchdownYards) > 0 {
+		fmt.Fprintf(env.out, "\nTouchdowns: %s yards (%d of 40+)\n", joinInts(splits.TouchdownYards), splits.LongTouchdowns(40))
+	}
+	if len(splits.PassingTouchdownYards) > 0 {
+		fmt.Fprintf(env.out, "\nTouchdown passes: %s yards\n", joinInts(splits.PassingTouchdownYards))
+	}
+	if len(splits.FieldGoals) > 0 {
+		kicks := make([]string, len(splits.FieldGoals))
+		for i, fg := range splits.FieldGoals {
+			kicks[i] = fmt.Sprintf("%d (%s)", fg.Distance, fg.Result)
+		}
+		fmt.Fprintf(env.out, "\nField goals: %s\n", strings.Join(kicks, ", "))
+	}
+}
+
+// printPlays lists plays with their down, distance and field position
+func printPlays(env *env, plays []league.PlayerPlay) error {
+	fmt.Fprintln(env.out)
+	w := tabwriter.NewWriter(env.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Wk\tRole\tType\tResult\tDown\tTo go\tYds\tTD\tPlay")
+	for _, play := range plays {
+		down := "-"
+		if play.Down > 0 {
+			down = fmt.Sprintf("%d & %d", play.Down, play.Distance)
+		}
+		td := ""
+		if play.Scoring {
+			td = "*"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%d\t%d\t%s\t%s\n", play.Week, play.Role, play.Type, play.Result, down,
+			play.YardsToEndzone, play.Yards, td, play.Description)
+	}
+	return w.Flush()
+}
+
+// joinInts joins numbers with commas
+func joinInts(numbers []int) string {
+	parts := make([]string, len(numbers))
+	for i, n := range numbers {
+		parts[i] = fmt.Sprint(n)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Mclazy108/GridironGo/internals/league"
+)
+
+// rankingsCommand builds the rankings command, which prints the same
+// rankings the draft room drafts from
+func rankingsCommand() *command {
+	var (
+		season   int64
+		position string
+		limit    int
+		leagueID int64
+		preset   string
+	)
+	return &command{
+		name:    "rankings",
+		usage:   "[flags]",
+		summary: "Print players ranked by a season's fantasy points",
+		flags: func(fs *flag.FlagSet) {
+			fs.Int64Var(&season, "season", 0, "Season to rank (default: the latest scraped season)")
+			fs.StringVar(&position, "position", "", "Only rank players at this position, e.g. RB")
+			fs.IntVar(&limit, "limit", 50, "Number of players to print (0 for all)")
+			leagueFlags(fs, &leagueID, &preset)
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 0, "no arguments"); err != nil {
+				return err
+			}
+			rules, err := leagueRules(env, leagueID, preset)
+			if err != nil {
+				return err
+			}
+			db, err := env.openDB()
+			if err != nil {
+				return err
+			}
+
+			if season == 0 {
+				seasons, err := db.GetSeasons(env.ctx)
+				if err != nil {
+					return fmt.Errorf("error getting seasons: %w", err)
+				}
+				if len(seasons) == 0 {
+					return fmt.Errorf("no games have been scraped yet; run 'gridirongo scrape all' first")
+				}
+				season = seasons[0]
+			}
+
+			ranked, err := league.NewScorer(db, rules).Rankings(env.ctx, season)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(env.out, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(env.out, "%d rankings, %s scoring\n\n", season, rules.Name)
+			fmt.Fprintln(w, "Rk\tName\tPos\tTeam\tDepth\tGP\tFPts\tFP/G\tProj")
+			printed := 0
+			for _, player := range ranked {
+				if position != "" && !strings.EqualFold(player.Position, position) {
+					continue
+				}
+				if limit > 0 && printed == limit {
+					break
+				}
+				depth := "-"
+				if player.Depth > 0 {
+					depth = fmt.Sprintf("%s%d", player.Position, player.Depth)
+				}
+				fmt.Fprintf(w, "%d\t%s\t%s%d\t%s\t%s\t%d\t%.1f\t%.1f\t%.1f\n", player.Rank, player.Name, player.Position,
+					player.PositionRank, player.NFLTeam, depth, player.Games, player.Points, player.PointsPerGame, player.Projected)
+				printed++
+			}
+			return w.Flush()
+		},
+	}
+}
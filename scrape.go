@@ -0,0 +1,669 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/scraper"
+	"github.com/Mclazy108/GridironGo/internals/espn"
+)
+
+// scrapeTarget is a kind of data the scrape command can load
+type scrapeTarget struct {
+	name       string
+	summary    string
+	run        func(ctx context.Context, db *data.DB, client *espn.Client, seasons string) error
+	count      func(ctx context.Context, db *data.DB) string // Record counts for the summary
+	current    bool                                          // Scrapes the current week rather than seasons, so "all" skips it
+	unseasoned bool                                          // Ignores seasons, so its jobs are kept under the current one
+}
+
+// scrapeTargets in the order they run: teams and players refer to games,
+// stats refer to all three, and injuries and depth charts to teams and
+// players
+var scrapeTargets = []scrapeTarget{
+	{
+		name:    "games",
+		summary: "Scrape NFL games and scores",
+		run:     runGameScraper,
+		count: func(ctx context.Context, db *data.DB) string {
+			count, _ := getGameCount(ctx, db)
+			return fmt.Sprintf("Total records: %d", count)
+		},
+	},
+	{
+		name:    "teams",
+		summary: "Scrape NFL teams",
+		run: func(ctx context.Context, db *data.DB, client *espn.Client, _ string) error {
+			return runTeamScraper(ctx, db, client)
+		},
+		count: func(ctx context.Context, db *data.DB) string {
+			count, _ := getTeamCount(ctx, db)
+			return fmt.Sprintf("Total records: %d", count)
+		},
+		unseasoned: true,
+	},
+	{
+		name:    "players",
+		summary: "Scrape NFL players and their teams by season",
+		run:     runPlayerScraper,
+		count: func(ctx context.Context, db *data.DB) string {
+			players, _ := getPlayerCount(ctx, db)
+			seasons, _ := getPlayerSeasonCount(ctx, db)
+			return fmt.Sprintf("Total players: %d, Total player-seasons: %d", players, seasons)
+		},
+	},
+	{
+		name:    "stats",
+		summary: "Scrape NFL game statistics",
+		run:     runStatScraper,
+		count: func(ctx context.Context, db *data.DB) string {
+			count, _ := getStatCount(ctx, db)
+			return fmt.Sprintf("Total records: %d", count)
+		},
+	},
+	{
+		name:    "totals",
+		summary: "Scrape players' season totals, to reconcile with their box scores",
+		run:     runSeasonStatScraper,
+		count: func(ctx context.Context, db *data.DB) string {
+			count, _ := getSeasonStatCount(ctx, db)
+			return fmt.Sprintf("Total records: %d", count)
+		},
+	},
+	{
+		name:    "injuries",
+		summary: "Scrape NFL teams' current injury reports",
+		run: func(ctx context.Context, db *data.DB, client *espn.Client, _ string) error {
+			return runInjuryScraper(ctx, db, client)
+		},
+		count: func(ctx context.Context, db *data.DB) string {
+			count, _ := getInjuryCount(ctx, db)
+			return fmt.Sprintf("Total records: %d", count)
+		},
+		current: true,
+	},
+	{
+		name:    "depth",
+		summary: "Scrape NFL teams' depth charts",
+		run:     runDepthChartScraper,
+		count: func(ctx context.Context, db *data.DB) string {
+			count, _ := getDepthChartCount(ctx, db)
+			return fmt.Sprintf("Total records: %d", count)
+		},
+	},
+}
+
+// scrapeCommand builds the scrape command, with a subcommand per target
+// plus "all"
+func scrapeCommand() *command {
+	options := &scrapeOptions{config: espn.DefaultConfig(), cache: true}
+	cmd := &command{
+		name:    "scrape",
+		summary: "Scrape NFL data from ESPN into the database",
+	}
+	for _, target := range scrapeTargets {
+		cmd.subcommands = append(cmd.subcommands, &command{
+			name:    target.name,
+			usage:   "[flags]",
+			summary: target.summary,
+			flags:   options.scrapeFlags,
+			seasons: !target.current,
+			run: func(env *env, args []string) error {
+				return runScrape(env, args, options, target)
+			},
+		})
+	}
+	var seasonTargets []scrapeTarget
+	for _, target := range scrapeTargets {
+		if !target.current {
+			seasonTargets = append(seasonTargets, target)
+		}
+	}
+	cmd.subcommands = append(cmd.subcommands, &command{
+		name:    "all",
+		usage:   "[flags]",
+		summary: "Scrape games, teams, players, stats, season totals and depth charts, in that order",
+		flags:   options.scrapeFlags,
+		seasons: true,
+		run: func(env *env, args []string) error {
+			return runScrape(env, args, options, seasonTargets...)
+		},
+	})
+	cmd.subcommands = append(cmd.subcommands, scrapeStatusCommand(), scrapeReconcileCommand())
+	return cmd
+}
+
+// scrapeOptions are the flags shared by the scrape subcommands and update
+type scrapeOptions struct {
+	config    espn.Config
+	replayDir string
+	cache     bool
+	fresh     bool // Only for scrape, which otherwise resumes from the job ledger
+}
+
+// flags adds the flags that tune the ESPN client the scrapers share
+func (o *scrapeOptions) flags(fs *flag.FlagSet) {
+	fs.Float64Var(&o.config.RateLimit, "rate", o.config.RateLimit, "Most ESPN requests per second, across all scrapers")
+	fs.DurationVar(&o.config.Timeout, "timeout", o.config.Timeout, "Timeout for each ESPN request")
+	fs.IntVar(&o.config.MaxRetries, "retries", o.config.MaxRetries, "Times to retry a throttled or failed ESPN request")
+	fs.StringVar(&o.config.RecordDir, "record", "", "Save every ESPN response as a fixture in this directory")
+	fs.StringVar(&o.replayDir, "replay", "", "Scrape fixtures saved with -record from this directory instead of ESPN")
+	fs.BoolVar(&o.cache, "cache", o.cache, "Reuse ESPN responses cached in the database, only downloading what changed")
+	fs.BoolVar(&o.config.Revalidate, "revalidate", false, "Check every cached response with ESPN, even ones that haven't expired")
+}
+
+// scrapeFlags adds the client flags plus the ones only scrapes take
+func (o *scrapeOptions) scrapeFlags(fs *flag.FlagSet) {
+	o.flags(fs)
+	fs.BoolVar(&o.fresh, "fresh", false, "Scrape everything again, not just what earlier scrapes didn't finish")
+}
+
+// client creates the ESPN client for a scrape, caching responses in db.
+// When replaying, it skips the cache and starts the fixture server, which
+// the returned func stops.
+func (o *scrapeOptions) client(db *data.DB) (*espn.Client, func(), error) {
+	if o.replayDir == "" {
+		config := o.config
+		if o.cache {
+			config.Cache = data.NewResponseCache(db)
+		}
+		return espn.NewClient(config), func() {}, nil
+	}
+	if o.config.RecordDir != "" {
+		return nil, nil, fmt.Errorf("-record and -replay can't be used together")
+	}
+	if _, err := os.Stat(o.replayDir); err != nil {
+		return nil, nil, fmt.Errorf("error opening fixtures: %w", err)
+	}
+
+	server := espn.NewFixtureServer(o.replayDir)
+	config := server.Config()
+	config.Timeout, config.MaxRetries = o.config.Timeout, o.config.MaxRetries
+	log.Printf("Replaying ESPN responses from %s", o.replayDir)
+	return espn.NewClient(config), func() {
+		if misses := server.Misses(); len(misses) > 0 {
+			log.Printf("%d requests had no fixture, e.g. %s", len(misses), misses[0])
+		}
+		server.Close()
+	}, nil
+}
+
+// runScrape runs scrapers in order, carrying on past failures, and logs
+// a summary of how long each took and the requests they made. Scrapers skip
+// work the job ledger has as done, unless -fresh resets it.
+func runScrape(env *env, args []string, options *scrapeOptions, targets ...scrapeTarget) error {
+	if err := requireArgs(args, 0, "no arguments"); err != nil {
+		return err
+	}
+	log.Printf("Using database path: %s", env.dbPath)
+	db, err := env.openDB()
+	if err != nil {
+		return err
+	}
+
+	client, stop, err := options.client(db)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	name := "scrape " + targets[0].name
+	if len(targets) > 1 {
+		name = "scrape all"
+	}
+	seasons := parseSeasons(env.seasons)
+	if targets[0].current {
+		seasons = []int{scraper.SeasonAt(time.Now())}
+	}
+	if options.fresh {
+		// Targets are named after the scrapers whose jobs they record
+		for _, target := range targets {
+			jobSeasons := seasons
+			if target.unseasoned {
+				jobSeasons = []int{scraper.SeasonAt(time.Now())}
+			}
+			if _, err := db.ResetJobs(env.ctx, target.name, jobSeasons); err != nil {
+				return err
+			}
+		}
+	}
+	run, err := db.StartScrapeRun(env.ctx, name, seasons, 0)
+	if err != nil {
+		return err
+	}
+
+	durations := make([]time.Duration, len(targets))
+	failed := 0
+	for i, target := range targets {
+		start := time.Now()
+		err := target.run(env.ctx, db, client, env.seasons)
+		durations[i] = time.Since(start)
+		if err != nil {
+			log.Printf("Error during %s scraping: %v", target.name, err)
+			failed++
+		}
+		if env.ctx.Err() != nil {
+			break
+		}
+	}
+
+	log.Println("------------------------------------------------")
+	log.Println("🏁 Scraping Summary:")
+	counts := make([]string, len(targets))
+	for i, target := range targets {
+		count := target.count(env.ctx, db)
+		counts[i] = fmt.Sprintf("%s: %s", target.name, count)
+		log.Printf("⏱  %-8s scraped in: %s (%s)", capitalize(target.name), durations[i], count)
+	}
+	log.Printf("🌐 ESPN: %s", client.Metrics())
+	log.Println("------------------------------------------------")
+
+	err = env.ctx.Err()
+	if failed > 0 {
+		err = fmt.Errorf("%d of %d scrapers failed", failed, len(targets))
+	}
+	run.Finish(env.ctx, strings.Join(counts, "; "), err)
+	return err
+}
+
+// capitalize upper-cases the first letter of an ASCII word
+func capitalize(word string) string {
+	if word == "" || word[0] < 'a' || word[0] > 'z' {
+		return word
+	}
+	return string(word[0]-'a'+'A') + word[1:]
+}
+
+// Get count of records in the nfl_games table
+func getGameCount(ctx context.Context, db *data.DB) (int, error) {
+	var count int
+	err := db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM nfl_games").Scan(&count)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Get count of records in the nfl_teams table
+func getTeamCount(ctx context.Context, db *data.DB) (int, error) {
+	var count int
+	err := db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM nfl_teams").Scan(&count)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Get count of records in the nfl_players table
+func getPlayerCount(ctx context.Context, db *data.DB) (int, error) {
+	var count int
+	err := db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM nfl_players").Scan(&count)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Get count of records in the nfl_player_seasons table
+func getPlayerSeasonCount(ctx context.Context, db *data.DB) (int, error) {
+	var count int
+	err := db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM nfl_player_seasons").Scan(&count)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Get count of records in the nfl_stats table
+func getStatCount(ctx context.Context, db *data.DB) (int, error) {
+	var count int
+	err := db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM nfl_stats").Scan(&count)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Get count of records in the nfl_injuries table
+func getInjuryCount(ctx context.Context, db *data.DB) (int, error) {
+	var count int
+	err := db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM nfl_injuries").Scan(&count)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Get count of records in the nfl_season_stats table
+func getSeasonStatCount(ctx context.Context, db *data.DB) (int, error) {
+	var count int
+	err := db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM nfl_season_stats").Scan(&count)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Get count of records in the nfl_depth_charts table
+func getDepthChartCount(ctx context.Context, db *data.DB) (int, error) {
+	var count int
+	err := db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM nfl_depth_charts").Scan(&count)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Parse comma-separated seasons string into slice of integers
+func parseSeasons(seasonsStr string) []int {
+	var seasonsInt []int
+	var currentNum int
+
+	for i := 0; i < len(seasonsStr); i++ {
+		c := seasonsStr[i]
+
+		// If we find a digit, process it
+		if c >= '0' && c <= '9' {
+			currentNum = currentNum*10 + int(c-'0')
+		} else if c == ',' {
+			// Add the current number to our list and reset
+			if currentNum > 0 {
+				seasonsInt = append(seasonsInt, currentNum)
+				currentNum = 0
+			}
+		}
+	}
+
+	// Don't forget the last number if there is one
+	if currentNum > 0 {
+		seasonsInt = append(seasonsInt, currentNum)
+	}
+
+	// Default to 2022-2025 if no valid seasons were provided
+	if len(seasonsInt) == 0 {
+		return parseSeasons(defaultSeasons)
+	}
+
+	return seasonsInt
+}
+
+// runGameScraper handles the game scraping process
+func runGameScraper(ctx context.Context, db *data.DB, client *espn.Client, seasonsStr string) error {
+	log.Println("Starting NFL game data scraping...")
+	log.Println("Press Ctrl+C for graceful cancellation")
+
+	seasons := parseSeasons(seasonsStr)
+	log.Printf("Will scrape games for seasons: %v", seasons)
+
+	scraperInstance := scraper.NewScraper(db, client)
+
+	// Count games before scraping
+	gameCount, err := getGameCount(ctx, db)
+	if err != nil {
+		log.Printf("Warning: Could not get existing game count: %v", err)
+	} else {
+		log.Printf("Found %d existing games in database before scraping", gameCount)
+	}
+
+	// Perform scraping with cancellable context
+	err = scraperInstance.ScrapeNFLGames(ctx, seasons)
+
+	// Check if the operation was cancelled by the user
+	if ctx.Err() != nil {
+		log.Println("Scraping was cancelled by the user")
+		return ctx.Err()
+	}
+
+	if err != nil {
+		return fmt.Errorf("error scraping NFL games: %w", err)
+	}
+
+	// Count games after scraping
+	gameCount, err = getGameCount(ctx, db)
+	if err != nil {
+		log.Printf("Warning: Could not get updated game count: %v", err)
+	} else {
+		log.Printf("Database now contains %d games after scraping", gameCount)
+	}
+
+	// Report success
+	log.Println("NFL game data scraping completed successfully")
+	return nil
+}
+
+// runTeamScraper handles the team scraping process
+func runTeamScraper(ctx context.Context, db *data.DB, client *espn.Client) error {
+	log.Println("Starting NFL team data scraping...")
+	log.Println("Press Ctrl+C for graceful cancellation")
+
+	teamScraperInstance := scraper.NewTeamScraper(db, client)
+
+	// Count teams before scraping
+	teamCount, err := getTeamCount(ctx, db)
+	if err != nil {
+		log.Printf("Warning: Could not get existing team count: %v", err)
+	} else {
+		log.Printf("Found %d existing teams in database before scraping", teamCount)
+	}
+
+	// Perform scraping with cancellable context
+	err = teamScraperInstance.ScrapeNFLTeams(ctx)
+
+	// Check if the operation was cancelled by the user
+	if ctx.Err() != nil {
+		log.Println("Team scraping was cancelled by the user")
+		return ctx.Err()
+	}
+
+	if err != nil {
+		return fmt.Errorf("error scraping NFL teams: %w", err)
+	}
+
+	// Count teams after scraping
+	teamCount, err = getTeamCount(ctx, db)
+	if err != nil {
+		log.Printf("Warning: Could not get updated team count: %v", err)
+	} else {
+		log.Printf("Database now contains %d teams after scraping", teamCount)
+	}
+
+	// Report success
+	log.Println("NFL team data scraping completed successfully")
+	return nil
+}
+
+// runPlayerScraper handles the player scraping process
+func runPlayerScraper(ctx context.Context, db *data.DB, client *espn.Client, seasonsStr string) error {
+	log.Println("Starting NFL player data scraping...")
+	log.Println("Press Ctrl+C for graceful cancellation")
+
+	// Parse seasons
+	seasons := parseSeasons(seasonsStr)
+	log.Printf("Will scrape players for seasons: %v", seasons)
+
+	playerScraperInstance := scraper.NewPlayerScraper(db, client)
+
+	// Count players before scraping
+	playerCount, err := getPlayerCount(ctx, db)
+	if err != nil {
+		log.Printf("Warning: Could not get existing player count: %v", err)
+	} else {
+		log.Printf("Found %d existing players in database before scraping", playerCount)
+	}
+
+	// Count player seasons before scraping
+	seasonCount, err := getPlayerSeasonCount(ctx, db)
+	if err != nil {
+		log.Printf("Warning: Could not get existing player season count: %v", err)
+	} else {
+		log.Printf("Found %d existing player-season records in database before scraping", seasonCount)
+	}
+
+	// Perform scraping with cancellable context and specified seasons
+	err = playerScraperInstance.ScrapeNFLPlayers(ctx, seasons)
+
+	// Check if the operation was cancelled by the user
+	if ctx.Err() != nil {
+		log.Println("Player scraping was cancelled by the user")
+		return ctx.Err()
+	}
+
+	if err != nil {
+		return fmt.Errorf("error scraping NFL players: %w", err)
+	}
+
+	// Count players after scraping
+	playerCount, err = getPlayerCount(ctx, db)
+	if err != nil {
+		log.Printf("Warning: Could not get updated player count: %v", err)
+	} else {
+		log.Printf("Database now contains %d unique players after scraping", playerCount)
+	}
+
+	// Count player seasons after scraping
+	seasonCount, err = getPlayerSeasonCount(ctx, db)
+	if err != nil {
+		log.Printf("Warning: Could not get updated player season count: %v", err)
+	} else {
+		log.Printf("Database now contains %d player-season records after scraping", seasonCount)
+	}
+
+	// Report success
+	log.Println("NFL player data scraping completed successfully")
+	return nil
+}
+
+// runStatScraper handles the game statistics scraping process
+func runStatScraper(ctx context.Context, db *data.DB, client *espn.Client, seasonsStr string) error {
+	log.Println("Starting NFL game statistics scraping...")
+	log.Println("Press Ctrl+C for graceful cancellation")
+
+	seasons := parseSeasons(seasonsStr)
+	log.Printf("Will scrape statistics for seasons: %v", seasons)
+
+	statScraperInstance := scraper.NewStatScraper(db, client)
+
+	// Count stats before scraping
+	statCount, err := getStatCount(ctx, db)
+	if err != nil {
+		log.Printf("Warning: Could not get existing stats count: %v", err)
+	} else {
+		log.Printf("Found %d existing statistics in database before scraping", statCount)
+	}
+
+	// Perform scraping with cancellable context
+	err = statScraperInstance.ScrapeNFLGameStats(ctx, seasons)
+
+	// Check if the operation was cancelled by the user
+	if ctx.Err() != nil {
+		log.Println("Scraping was cancelled by the user")
+		return ctx.Err()
+	}
+
+	if err != nil {
+		return fmt.Errorf("error scraping NFL game statistics: %w", err)
+	}
+
+	// Count stats after scraping
+	statCount, err = getStatCount(ctx, db)
+	if err != nil {
+		log.Printf("Warning: Could not get updated stats count: %v", err)
+	} else {
+		log.Printf("Database now contains %d statistics after scraping", statCount)
+	}
+	if backfilled := statScraperInstance.Backfilled(); backfilled > 0 {
+		log.Printf("Backfilled %d players who were in box scores but not on a scraped roster", backfilled)
+	}
+	if unfetched := statScraperInstance.Unfetched(); unfetched > 0 {
+		log.Printf("Warning: %d players in box scores couldn't be fetched, so their games were left failed to retry on the next run", unfetched)
+	}
+
+	// Report success
+	log.Println("NFL game statistics scraping completed successfully")
+	return nil
+}
+
+// runSeasonStatScraper saves players' season totals for each season, then
+// reports how many players' totals don't match their box scores
+func runSeasonStatScraper(ctx context.Context, db *data.DB, client *espn.Client, seasonsStr string) error {
+	log.Println("Starting NFL season totals scraping...")
+
+	seasons := parseSeasons(seasonsStr)
+	log.Printf("Will scrape season totals for seasons: %v", seasons)
+
+	err := scraper.NewSeasonStatScraper(db, client).ScrapeNFLSeasonStats(ctx, seasons)
+	if ctx.Err() != nil {
+		log.Println("Season totals scraping was cancelled by the user")
+		return ctx.Err()
+	}
+	if err != nil {
+		return fmt.Errorf("error scraping NFL season totals: %w", err)
+	}
+
+	for _, season := range seasons {
+		report, err := scraper.ReconcileSeasonStats(ctx, db, season)
+		if err != nil {
+			log.Printf("Warning: Could not reconcile %d season totals: %v", season, err)
+		} else if len(report) > 0 {
+			log.Printf("%d players' %d season totals don't match their box scores; see 'gridirongo scrape reconcile'", len(report), season)
+		}
+	}
+	return nil
+}
+
+// runInjuryScraper saves every team's current injury report as the report
+// for the week whose games it's for
+func runInjuryScraper(ctx context.Context, db *data.DB, client *espn.Client) error {
+	log.Println("Starting NFL injury report scraping...")
+
+	week, err := scraper.NewUpdater(db, client).ReportWeek(ctx, 0)
+	if err != nil {
+		return fmt.Errorf("error finding the current week: %w", err)
+	}
+
+	saved, err := scraper.NewInjuryScraper(db, client).ScrapeInjuries(ctx, week.Season, week.Week)
+	if ctx.Err() != nil {
+		log.Println("Injury scraping was cancelled by the user")
+		return ctx.Err()
+	}
+	if err != nil {
+		return fmt.Errorf("error scraping NFL injuries: %w", err)
+	}
+
+	log.Printf("Saved %d injury designations for %d week %d", saved, week.Season, week.Week)
+	return nil
+}
+
+// runDepthChartScraper saves every team's depth charts in each season as the
+// charts for the week whose games they're for
+func runDepthChartScraper(ctx context.Context, db *data.DB, client *espn.Client, seasonsStr string) error {
+	log.Println("Starting NFL depth chart scraping...")
+
+	seasons := parseSeasons(seasonsStr)
+	log.Printf("Will scrape depth charts for seasons: %v", seasons)
+
+	err := scraper.NewDepthChartScraper(db, client).ScrapeNFLDepthCharts(ctx, seasons)
+	if ctx.Err() != nil {
+		log.Println("Depth chart scraping was cancelled by the user")
+		return ctx.Err()
+	}
+	if err != nil {
+		return fmt.Errorf("error scraping NFL depth charts: %w", err)
+	}
+
+	count, err := getDepthChartCount(ctx, db)
+	if err != nil {
+		log.Printf("Warning: Could not get updated depth chart count: %v", err)
+	} else {
+		log.Printf("Database now contains %d depth chart entries after scraping", count)
+	}
+	return nil
+}
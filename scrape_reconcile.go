@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/Mclazy108/GridironGo/internals/data/scraper"
+)
+
+// reconcileRow is a player whose totals don't match in reconcile output
+type reconcileRow struct {
+	Season int `json:"season"`
+	*scraper.Reconciliation
+	MissingGames bool `json:"missing_games"`
+}
+
+func scrapeReconcileCommand() *command {
+	var format string
+	return &command{
+		name:    "reconcile",
+		usage:   "[flags]",
+		summary: "Report players whose season totals don't match their box scores",
+		flags: func(fs *flag.FlagSet) {
+			formatFlag(fs, &format)
+		},
+		seasons: true,
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 0, "no arguments"); err != nil {
+				return err
+			}
+			if err := checkFormat(format); err != nil {
+				return err
+			}
+			db, err := env.openDB()
+			if err != nil {
+				return err
+			}
+
+			rows := []reconcileRow{}
+			for _, season := range parseSeasons(env.seasons) {
+				report, err := scraper.ReconcileSeasonStats(env.ctx, db, season)
+				if err != nil {
+					return err
+				}
+				for _, r := range report {
+					rows = append(rows, reconcileRow{Season: season, Reconciliation: r, MissingGames: r.MissingGames()})
+				}
+			}
+			if format == formatJSON {
+				return writeJSON(env.out, rows)
+			}
+
+			if len(rows) == 0 {
+				fmt.Fprintln(env.out, "Every scraped season total matches its box scores")
+				return nil
+			}
+			w := tabwriter.NewWriter(env.out, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "Season\tPlayer\tPos\tGP\tBox scores\tStat\tSeason total\tBox score total")
+			missing := 0
+			for _, row := range rows {
+				if row.MissingGames {
+					missing++
+				}
+				for i, mismatch := range row.Mismatches {
+					// Only a player's first mismatch names them
+					player := "\t\t\t\t"
+					if i == 0 {
+						player = fmt.Sprintf("%d\t%s\t%s\t%d\t%d", row.Season, row.Name, row.Position, row.GamesPlayed, row.BoxScores)
+					}
+					fmt.Fprintf(w, "%s\t%s.%s\t%g\t%g\n", player, mismatch.Category, mismatch.StatType, mismatch.Season, mismatch.Games)
+				}
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			fmt.Fprintf(env.out, "\n%d players with mismatched totals, %d of them missing box scores; run 'gridirongo scrape stats' to fill in missing games\n",
+				len(rows), missing)
+			return nil
+		},
+	}
+}
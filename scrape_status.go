@@ -0,0 +1,151 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Mclazy108/GridironGo/internals/data"
+	"github.com/Mclazy108/GridironGo/internals/data/scraper"
+)
+
+// maxFailedJobs is how many failed jobs the status table lists
+const maxFailedJobs = 10
+
+// jobRow is a scraper's progress through a season in status output
+type jobRow struct {
+	Season     int         `json:"season"`
+	Scraper    string      `json:"scraper"`
+	Units      int         `json:"units"`
+	Done       int         `json:"done"`
+	Pending    int         `json:"pending"`
+	Failed     int         `json:"failed"`
+	NotStarted int         `json:"not_started"`
+	Unfinished []jobDetail `json:"unfinished"`
+}
+
+// jobDetail is a job that isn't done in status output
+type jobDetail struct {
+	Unit     string `json:"unit"`
+	Status   string `json:"status"` // pending, failed or not started
+	Error    string `json:"error,omitempty"`
+	Attempts int64  `json:"attempts"`
+}
+
+func scrapeStatusCommand() *command {
+	var format string
+	return &command{
+		name:    "status",
+		usage:   "[flags]",
+		summary: "Report which weeks, teams, rosters, games, season totals, injury reports and depth charts haven't been scraped",
+		flags: func(fs *flag.FlagSet) {
+			formatFlag(fs, &format)
+		},
+		seasons: true,
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 0, "no arguments"); err != nil {
+				return err
+			}
+			if err := checkFormat(format); err != nil {
+				return err
+			}
+			db, err := env.openDB()
+			if err != nil {
+				return err
+			}
+
+			seasons := parseSeasons(env.seasons)
+			jobs, err := scraper.Jobs(env.ctx, db, seasons)
+			if err != nil {
+				return err
+			}
+			statuses, err := db.JobStatuses(env.ctx, jobs)
+			if err != nil {
+				return err
+			}
+
+			rows := []*jobRow{}
+			index := make(map[string]*jobRow)
+			for _, job := range jobs {
+				key := fmt.Sprintf("%d %s", job.Season, job.Scraper)
+				row := index[key]
+				if row == nil {
+					row = &jobRow{Season: job.Season, Scraper: job.Scraper, Unfinished: []jobDetail{}}
+					index[key] = row
+					rows = append(rows, row)
+				}
+				row.Units++
+
+				status := statuses[job]
+				if status == nil {
+					row.NotStarted++
+					row.Unfinished = append(row.Unfinished, jobDetail{Unit: job.Unit, Status: "not started"})
+					continue
+				}
+				switch status.Status {
+				case data.JobDone:
+					row.Done++
+					continue
+				case data.JobFailed:
+					row.Failed++
+				default:
+					row.Pending++
+				}
+				row.Unfinished = append(row.Unfinished, jobDetail{
+					Unit:     job.Unit,
+					Status:   status.Status,
+					Error:    status.Error.String,
+					Attempts: status.Attempts,
+				})
+			}
+			if format == formatJSON {
+				return writeJSON(env.out, rows)
+			}
+			return printScrapeStatus(env, rows, seasons)
+		},
+	}
+}
+
+// printScrapeStatus prints the status table, the first failed jobs and how
+// to resume
+func printScrapeStatus(env *env, rows []*jobRow, seasons []int) error {
+	w := tabwriter.NewWriter(env.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Season\tScraper\tUnits\tDone\tPending\tFailed\tNot started")
+	var failed []string
+	unfinished := 0
+	for _, row := range rows {
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%d\t%d\t%d\n", row.Season, row.Scraper, row.Units, row.Done, row.Pending, row.Failed, row.NotStarted)
+		for _, job := range row.Unfinished {
+			if job.Status == data.JobFailed {
+				failed = append(failed, fmt.Sprintf("%d %s %s: %s (%d attempts)", row.Season, row.Scraper, job.Unit, job.Error, job.Attempts))
+			}
+		}
+		unfinished += len(row.Unfinished)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(failed) > 0 {
+		fmt.Fprintln(env.out, "\nFailed:")
+		for _, line := range failed[:min(len(failed), maxFailedJobs)] {
+			fmt.Fprintf(env.out, "  %s\n", line)
+		}
+		if len(failed) > maxFailedJobs {
+			fmt.Fprintf(env.out, "  ...and %d more; see -format json\n", len(failed)-maxFailedJobs)
+		}
+	}
+
+	if unfinished == 0 {
+		fmt.Fprintln(env.out, "\nEverything has been scraped")
+		return nil
+	}
+	years := make([]string, len(seasons))
+	for i, season := range seasons {
+		years[i] = strconv.Itoa(season)
+	}
+	fmt.Fprintf(env.out, "\n%d units left; run 'gridirongo scrape all -seasons %s' to resume\n", unfinished, strings.Join(years, ","))
+	return nil
+}
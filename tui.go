@@ -0,0 +1,26 @@
+package main
+
+import (
+	"github.com/Mclazy108/GridironGo/internals/tui"
+)
+
+// tuiCommand builds the tui command, which is also what runs when no
+// command is given
+func tuiCommand() *command {
+	return &command{
+		name:    "tui",
+		usage:   "[flags]",
+		summary: "Start the terminal user interface",
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 0, "no arguments"); err != nil {
+				return err
+			}
+			db, err := env.openDB()
+			if err != nil {
+				return err
+			}
+			// The caller closes db once Run returns
+			return tui.Run(env.ctx, db)
+		},
+	}
+}
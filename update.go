@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Mclazy108/GridironGo/internals/data/scraper"
+	"github.com/Mclazy108/GridironGo/internals/espn"
+)
+
+// updateCommand builds the update command, which keeps the current week up
+// to date during the season
+func updateCommand() *command {
+	options := &scrapeOptions{config: espn.DefaultConfig(), cache: true}
+	var season, week int
+	return &command{
+		name:    "update",
+		usage:   "[flags]",
+		summary: "Refresh the current week's games, injury report and depth charts, and the stats and players of games that went final",
+		flags: func(fs *flag.FlagSet) {
+			fs.IntVar(&season, "season", 0, "Season to update (default: the one being played, from the game dates)")
+			fs.IntVar(&week, "week", 0, "Week to update (default: the week of the latest game played)")
+			options.flags(fs)
+		},
+		run: func(env *env, args []string) error {
+			if err := requireArgs(args, 0, "no arguments"); err != nil {
+				return err
+			}
+			db, err := env.openDB()
+			if err != nil {
+				return err
+			}
+			client, stop, err := options.client(db)
+			if err != nil {
+				return err
+			}
+			defer stop()
+
+			result, err := scraper.NewUpdater(db, client).Update(env.ctx, scraper.UpdateOptions{Season: season, Week: week})
+			log.Printf("🌐 ESPN: %s", client.Metrics())
+			if err != nil {
+				return fmt.Errorf("error updating: %w", err)
+			}
+
+			weeks := make([]string, len(result.Weeks))
+			for i, w := range result.Weeks {
+				weeks[i] = strconv.Itoa(w)
+			}
+			lastSync := result.LastSync
+			if lastSync == "" {
+				lastSync = "never"
+			}
+			fmt.Fprintf(env.out, "Updated %d week %d (last update: %s)\n", result.Season, result.Week, lastSync)
+			fmt.Fprintf(env.out, "  Scoreboards refreshed: week %s, %d games\n", strings.Join(weeks, ", "), result.Games)
+			fmt.Fprintf(env.out, "  Final games with new stats: %d\n", result.FinalGames)
+			fmt.Fprintf(env.out, "  Players refreshed: %d\n", result.Players)
+			if result.ReportWeek > 0 {
+				fmt.Fprintf(env.out, "  Week %d injury designations: %d\n", result.ReportWeek, result.Injuries)
+				fmt.Fprintf(env.out, "  Week %d depth chart entries: %d\n", result.ReportWeek, result.DepthCharts)
+			}
+			return nil
+		},
+	}
+}